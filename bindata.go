@@ -68,7 +68,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _configYaml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x5b\xdd\x8f\x1b\x37\x92\x7f\xd7\x5f\x51\x91\xcf\x38\x1b\x98\x55\x3c\xbe\xcd\xee\x42\xf0\x3a\x98\xd8\xbe\x8b\x0f\x76\x1c\xd8\xce\x02\xfb\xd4\xa0\xba\xab\xd5\xdc\xe9\x26\x7b\xf9\x21\x8d\xf2\xd7\x1f\xaa\xf8\xd1\x2d\xb5\x66\x24\x8d\x03\xdc\xbc\xc4\x62\x17\xab\xc8\xfa\xe2\xaf\x8a\xcc\x13\xf8\xe8\xbb\x55\x8b\x6f\xff\x77\xf6\x04\x7e\xda\xc1\x47\xe1\x5c\x23\xd1\xc3\xff\x18\x89\x6b\x34\xb3\x27\xf0\x46\xf7\x3b\x23\xd7\x8d\x83\x67\xe5\x73\x78\xf9\xe2\xfa\x2f\x13\x2a\x78\xf6\xf1\xfd\x57\xf8\x20\x4b\x54\x16\x9f\xcf\x9e\x40\xa9\x55\x2d\xd7\x8b\x9d\xe8\xda\xd9\x4c\xf4\xb2\xb8\xc5\x9d\x5d\xce\x66\x00\x00\x4f\xe0\x9f\xda\x7f\xf5\x2b\x84\x9b\x5f\xdf\xc3\x2d\xee\x16\x3c\xbc\xd3\xde\xf9\x15\x2e\x61\x3e\x4f\x74\x5f\xb4\x57\xd5\x9b\x56\xfb\x6a\x9f\xf4\x09\xfc\xf2\xe9\xeb\xbb\x25\x7c\x6d\x32\x0f\x90\x96\x38\x18\x28\x5b\x89\xca\xc1\xfb\xb7\x81\xd4\x12\x8b\x92\x58\x04\xc6\xb3\x0a\x6b\xe1\x5b\x37\x2c\xe6\x6d\x18\x80\x52\x77\x1d\xcd\x74\x1a\x56\x08\xa2\xef\x5b\x89\x15\xff\xd2\x6e\x5f\xec\xfb\x9a\x44\x41\xa5\x41\x69\x07\x5b\xa1\x1c\x88\x3c\x7d\xb5\x83\x28\xe2\x0a\x2c\x32\x3b\xec\x7a\xb7\x03\xeb\x8c\x54\x6b\x78\x36\x9f\x3f\x0f\xec\xe2\x8c\x25\xcc\x7f\xc6\xb6\xd5\xdf\xc1\x7b\x10\x1d\x08\x96\x07\x5f\x77\x3d\xc2\x77\x0d\xb6\x3d\xd4\xda\x80\x80\x56\x5a\x07\xba\xe6\x59\x42\x55\x76\x31\x9f\x6c\xa0\x11\x4a\x61\xcb\xf4\xae\x41\xe2\xc3\xd2\x95\x43\x03\xbe\xd7\x8a\xac\xa2\xb0\x74\x52\xab\xa3\x1b\xda\x4a\xdb\x1c\xce\x8e\x53\xe8\x9f\x34\x6a\xb4\xce\x82\x4e\xee\x2f\x90\x8d\x0d\xfa\x26\x2c\x9e\x26\x79\x8b\xf4\x9f\xbe\x15\x3b\x10\xbe\x92\x1a\x6a\xd9\xa2\x5d\xb0\x51\xdd\x56\x83\xf5\x7d\xaf\x8d\xc3\x0a\xca\x46\xcb\x12\x2d\x08\x83\x30\xaf\xeb\xae\xc7\xf5\x1c\x88\xcd\x5c\x6c\x4a\xad\x36\xf3\x20\x8f\x58\xa1\x29\xa2\x82\x96\x99\x74\x36\x9b\xfd\xdb\xa3\xc7\x6c\xf1\xcf\xc2\x49\xda\x8e\x70\xd0\x79\xeb\xc8\xdc\x1d\x3a\xd0\x06\xf0\xae\x44\xac\x82\xd9\x9d\x91\x6b\x72\x6d\x01\xce\x88\xf2\x16\xec\xad\xec\x83\x20\xfe\x5d\xd0\xef\xc2\x10\xab\x25\xbc\x58\xfc\xf0\x58\xe6\xb4\x6a\xb6\xed\xc0\x3f\x0d\xdd\x27\xe2\xa3\xb8\x93\x9d\xef\xe2\xba\x2a\xcf\x14\x0a\xa4\x02\x8b\xa5\x26\xdf\x80\x2f\xc1\x32\x2f\xd8\x9c\x5e\x19\x24\xeb\x94\xa4\xcc\x44\x1e\x44\x75\xe2\xae\x08\xdb\x49\xe3\x4b\x78\x71\x54\x8e\x85\x1e\x4d\x5e\xda\x43\x12\x12\x8d\x3d\x10\x61\x8b\x1e\x4d\x91\xbe\x2e\xe1\x87\x2c\xe8\xbd\x05\xdb\xf8\xba\x6e\xc9\x81\x50\x89\x55\x8b\x15\x6c\x1b\x54\xd9\x13\xad\x13\xc6\xd9\x1f\x99\x5e\x78\xa7\x3b\xe1\x64\x59\x84\x49\x58\xd0\xaa\x6b\xd1\x5a\x4c\x0c\x6f\x94\xd2\x5e\x95\x18\x55\x24\x55\xad\x4d\x17\xb4\x24\x5c\x60\x8a\x6b\xa9\x14\xc9\xd3\x75\xf4\x3f\x5a\xd9\x4a\x94\xb7\x51\x4a\x64\x51\x28\xdc\xc6\xf5\x2f\xc1\x19\x8f\xb3\xd9\x6c\x88\xa3\xec\x53\x37\x55\x65\xd0\xda\xb0\xd8\x46\xfb\xb6\x02\xe1\x1c\x45\xc6\x7e\x14\x05\x9d\x88\x40\xbd\x84\xf9\xf5\xcb\xbf\x2e\x5e\x2c\x5e\x2c\xae\x73\x8c\xfc\xaa\x8d\x3b\x93\x0d\xc5\xc7\x12\xe6\x7f\xf9\xf3\x5f\xff\xeb\x6f\xc3\x7c\x61\xed\x56\x9b\x8a\x0d\x93\x56\xaa\xd6\x34\xdf\xa2\xd9\xa0\x99\xc4\xbe\xd2\xd0\xc7\x49\xa7\x62\x3a\xd1\x8d\x83\xfa\x37\x8b\x46\x89\x0e\x59\x60\x3a\x4d\x02\xb9\x8f\x9f\x96\x30\x4f\x1f\xf2\xb4\xff\x96\x2d\xf6\xc2\x35\x31\x19\x18\xe8\xaf\x5f\x72\x0e\x08\x09\xcf\xbb\x06\x95\x93\xa5\xe0\xc5\x0b\x0b\x02\x0c\xae\xa5\x75\x68\xb0\xe2\x09\x47\xf7\x91\x78\x48\x0b\x8a\xa3\xed\xd4\x8e\x88\x53\xd1\x5f\xbf\xdc\x3b\x77\x82\xe6\x93\xf3\x25\x0b\x08\x8a\x31\x8b\xa5\x37\x38\x4a\xa5\x3f\x66\x9f\x3b\xf6\x15\x2a\x8d\x96\xcf\x89\x0d\x1a\x59\xef\x98\x69\x89\xc6\xc9\x9a\xf6\x86\xe4\x7e\x34\x14\x4c\x43\x5b\x8f\xec\x4a\xad\x2c\xed\x56\x95\xbb\x05\xbc\x77\xb4\xa1\x15\x5a\xde\x49\x8b\x62\x83\xe0\x1a\x69\x41\xab\x2b\x58\x79\x07\x95\xb4\x14\x35\x20\x1d\xc8\x90\xcc\x29\x59\x36\x62\x23\xd5\x3a\x32\x94\xd6\x7a\xb4\x07\x1e\x21\x92\x60\x52\xb9\x41\x30\x3e\x04\x45\xe7\x5b\x27\x7b\x62\xa8\xac\x13\x8a\xb2\xaf\xae\x0f\x8c\x9b\x76\x7b\x10\x7b\x63\xbb\x8e\x37\x4a\x66\x39\x66\xb2\x43\x9a\xf3\x4d\x47\x33\xc7\x66\xbb\x4f\x32\xc1\x83\xfb\xa4\x47\xe8\x70\x9e\xc0\x5b\xdc\x8d\xe5\xdd\x94\x25\x85\xbc\xd3\xb7\xa8\xe8\x3f\x20\x95\x74\x52\xb4\xf2\x77\xcc\xbe\xb3\x95\xae\x21\xb6\xbd\x30\x82\x92\xe3\x6a\x17\x4e\x70\x7b\x6c\x31\x62\x8f\x21\xd9\xe3\xbc\x75\x85\x79\x45\x98\xf7\x90\x23\xa7\xcc\x29\xda\x76\x37\x4e\x2c\x06\x9d\xd9\x8d\xbd\x76\xec\x1a\xa2\x26\x00\x51\x49\x3b\xb8\x4e\xf0\x79\x9e\x55\xc4\x7c\x9d\x92\x63\x10\xfc\xb3\xde\x42\x27\xd4\x0e\x9c\xec\xd0\xa6\x54\x76\x18\x50\x2c\xf9\x00\x61\x04\xa1\x63\x01\x91\xda\x2e\xe1\xfa\xc5\x84\x7f\x3c\xf1\x0e\x25\x6c\x05\x45\x82\xfa\xd3\x0a\xdd\x16\x71\x8c\x7c\xe2\x5e\x13\xd3\xb1\x20\x49\x48\x69\x23\xda\x25\xfc\x40\x49\x5e\x94\xcd\x80\x19\xde\xd0\x2f\xb0\x5a\xad\x2d\x25\x23\xd7\xe0\x8e\x0d\x54\xe9\xad\x6a\xb5\xa8\xb0\x0a\x9c\xb2\x36\xf6\x62\x22\x9f\xa4\xda\x89\x36\x78\xb9\x25\x2f\x21\x3c\xc7\x8c\x2b\x69\xb0\x74\xda\xec\xe8\x14\xff\x28\x7f\xca\x47\x27\x4d\x2b\x88\x76\x09\x3f\x5c\xbf\xcc\x39\x1e\x8d\xd4\x15\xe7\x0e\xd9\x61\x40\x1b\x51\x03\xd8\x8a\xde\xd2\xf9\x56\x6b\x83\x64\x44\xad\xd6\xe4\xe1\x65\x8b\x82\x32\x67\x6d\x74\x17\x92\x10\x09\xbe\x22\x79\x8d\xf6\x26\xfa\x23\xde\xf5\xd2\x60\x41\x5c\x97\xf0\xf2\xcf\xf7\xc8\x4b\x5a\x45\x51\x36\x50\x36\x58\xde\xa6\x34\x16\x76\x53\x33\xda\x21\x4e\x15\x48\x87\x9d\x65\x31\x9d\x54\xde\xa1\x4d\xe0\x10\xcb\xdb\x7d\x8d\x47\x34\x9b\x35\x41\x07\x96\xa3\x4d\x30\xd3\xc8\x69\x01\xef\xd4\x46\x1a\xad\x18\x6c\x6f\x84\x91\xa4\xef\x10\x2c\x9c\x01\x03\x7c\xf7\x16\x2b\x68\xd0\xc4\x98\xcf\xea\x5d\xc2\xfc\x3f\x7e\xfe\xf4\xf1\xdd\xf7\x0b\x66\xfa\x7d\xc7\x19\xad\xfa\x17\x81\xc4\x8d\x6e\x7d\x87\x93\xba\x20\x0c\x47\x3e\x61\x8c\xd0\x58\xb6\xc5\x07\xbd\xa5\xbc\x1c\xc8\x40\xb4\xad\xde\x62\x15\xc8\x5b\xfe\x44\xd4\x2f\xae\xb3\xe7\xca\x75\x73\x1f\x7d\x13\xbe\xd1\x84\xbf\xcd\x66\x33\x51\x75\x52\x0d\x85\xca\x3b\x76\x2d\x08\xa3\x3f\x1e\xa6\x0f\x3e\x0e\xa4\x4d\x99\x82\xdd\xef\x0a\x28\x44\x22\x20\x86\x52\x28\x52\x0d\xde\x61\xe9\x63\x2a\xa2\xcf\xc3\x51\x7a\x34\x92\x3f\xc4\xba\x83\xc5\x02\x1d\xe6\x87\xa9\x8b\xcf\x26\x8a\x63\x2a\x67\x18\xdf\x36\x11\x64\x31\x35\x99\x9e\x17\xc7\x28\x97\x0f\x99\xe1\x1c\xd7\x0c\xf1\x22\xbf\x98\x6f\x6c\x84\xcf\xb2\xeb\x35\x91\x59\x5a\x39\x9d\xa0\x71\xe5\x71\x29\xb9\x10\xe2\xd9\x2c\x6a\xc9\xff\xa4\xbf\x3f\xc1\xfc\x8b\xef\xd1\x10\x36\x99\x33\x62\x0b\xc4\x43\x3c\x37\xc2\x88\x92\x2b\x23\x1b\x20\x79\x85\x56\xae\x15\x9d\x17\x89\x38\xc4\x8a\x22\xe8\xd8\x82\xc3\x3b\x07\x1d\x5a\x2b\xd6\x87\x1a\xf8\xa4\xda\x1d\x68\x85\x54\xf1\x44\xa6\xcf\x68\xfb\xb5\x34\xd6\x3d\x27\xed\x90\x8c\x08\xa0\x0c\xd6\xf2\x6e\x09\xf3\xef\x62\x92\x26\x61\x5a\x15\x89\xf3\xb0\x05\xa5\x13\x6c\x46\x63\xb4\x59\xc2\xfc\x2b\x39\x74\x38\x16\x74\x02\xe5\x32\x60\x64\x2e\x70\x16\xf3\x3c\x99\xbc\x5b\xaa\x75\x11\x71\x41\x95\x79\xbc\x09\x1f\x38\x23\x78\x63\x50\xb9\x76\x97\xd0\x43\x35\xd4\x94\x3f\x61\xab\xb7\x44\x34\x14\x9e\x1c\xde\x49\x33\x43\x71\xb6\xda\x0d\xb0\x00\xde\x71\x42\x88\xfe\xd6\x08\x1b\xb9\xb9\xc6\x20\xc6\x9e\x80\x37\xec\xc5\xba\xa7\x64\x1c\xb7\xfb\x04\x44\x2b\x85\x45\xbb\x84\x9b\x2c\x2f\x38\x0f\x7b\x42\xf4\xdc\x64\xa9\xe4\x07\xa3\x15\x2d\x32\xc8\x29\xd8\x3b\x82\x0f\xc3\xdf\x41\x93\x6d\x42\xc8\x30\x9b\x23\x73\xaf\x42\xb0\xc0\xdf\x29\x1c\xd8\x8c\xc7\xe9\x92\x8c\x0a\x6d\x69\x64\x1f\x8a\xa5\xb7\xc3\x0f\xca\xc0\x5b\x95\x0b\xe8\xa4\x86\x5c\xc7\x70\x31\x9f\x46\xa5\xcd\x81\x98\xf8\x66\x17\x80\x7f\x08\x23\xb5\xb7\x79\x24\x96\x93\x62\x47\x5a\xb0\x94\xf8\x18\x0f\x8f\x5d\x72\x94\xd7\xe3\x6a\x09\x97\xd7\x3e\xb6\x03\x8c\x50\xb6\x65\x28\x1d\x85\x0d\x7f\x01\x4d\x30\x7e\xd1\xae\x41\x03\xad\x50\x6b\xcf\x5e\x0e\x6f\x35\xb9\x38\x18\xec\xf4\x06\x07\x4a\x5a\x0d\x17\x50\x0c\x6f\x60\xfe\x74\x0e\xcf\xac\x2f\x1b\x5a\xd6\xfc\xa9\x9d\x5f\xc1\xfc\x69\x35\xbf\x02\x74\xe5\xe2\xf9\x44\x60\x3a\x3e\xad\x5f\x59\x27\x1d\xe7\x22\xe6\x63\xbc\xe2\xe3\xa5\x12\x4e\x2c\xe0\x33\x09\x65\x98\xda\xa0\x1d\x84\x6f\x65\xdb\x42\x29\xb8\x7d\x30\xb4\x29\x3a\x69\x57\xd8\x88\x0d\xe6\xfa\x6a\x08\xa4\xe4\x5b\xb3\xd1\x1a\x28\x41\x88\xaa\x9a\x4f\xc6\x86\x91\xc1\x95\xc2\x51\x9e\xc6\xf7\xcc\x3f\xbf\xa9\x2a\x9b\x5b\x04\x7a\x28\x90\x83\x3d\x04\x74\x58\x49\x01\x56\x3a\x4c\x00\xe7\x30\x54\xa7\x91\x1f\xa3\xdf\x9b\x36\x87\xed\x0d\xfc\xf6\xf9\x43\x6e\x28\x50\xf4\x71\x77\x8a\xd5\x46\x4c\x45\x55\x65\xc3\xcf\x0f\x19\x6d\x44\x2b\xab\xc3\x64\xf2\x8b\x06\x1e\x4f\x89\x64\x4b\xb9\xa5\xd6\x5e\x8d\xb8\xf6\x46\x6f\x24\x65\xf4\xdf\x3e\x7f\x78\x66\x9f\x1f\x70\x8e\x0c\x9d\xd6\x45\xab\xd5\x3a\x73\xfe\xa7\xf6\x26\x7c\x7c\x66\x9f\x07\xbe\x28\xd9\xb3\x9c\xd6\x40\xa4\x5c\x6d\x28\xe0\x09\xa0\x4b\x4e\x44\x14\x28\x84\x8b\x7a\xa3\x09\xcc\x46\xc3\x77\x0b\xf8\x45\x0f\xcc\xc8\xc2\xb0\x22\xe8\x21\xaa\x0a\x0f\xb7\xaa\x15\xc6\x66\x06\x7f\x5d\xc2\xfc\xd5\xea\xf5\x53\xfb\xea\xfb\xd5\xeb\x40\x0f\xaf\x56\xaf\xaf\xf9\x67\xb0\xd7\xd8\x22\xcb\x57\x2b\xf3\xfa\x95\x64\x7a\xf9\x3a\x98\xef\xa9\xdd\x17\x40\x88\x33\xe9\xf1\x01\x11\x4f\xab\x41\x86\xbd\xcf\xec\x6c\x1b\xdf\x15\x07\x5a\x64\x8e\xe6\xf5\x84\x4b\xc9\xe0\x8e\x4e\xc1\x15\x46\x49\x95\x67\x9f\x8a\x5a\x34\xb0\xc2\x1c\x16\x01\x9b\x26\x75\xa7\xb4\x2e\xaa\x4a\xe1\x9d\x3b\x2b\x32\x88\x70\x1a\x1d\xea\x58\x78\x30\x5a\xf8\xe6\xe8\x08\x59\x01\x48\x2e\x23\xbd\xfb\x4e\xb6\x27\x69\x1b\x74\x1c\x84\x39\x39\x4d\x56\x58\x4b\x85\xa1\xf3\x21\xaa\x6a\x11\x4f\x58\x42\x7a\x0c\xa1\x4f\x6e\x3c\x93\x4e\xb6\x5e\xda\x0b\xb7\xfe\xc9\xbb\xde\xbb\xb0\xc0\x3d\xc0\x3f\xc0\xe4\x00\xf5\xa9\x60\x2f\x87\x53\x39\x82\xb0\x93\x09\x22\x9e\xde\xb1\x36\x20\x6c\x90\x86\x8e\x49\xb2\xec\x97\x8b\x97\x1b\x92\x48\x7e\x95\x7c\x22\xce\x61\x0b\x9d\xa1\x9f\x11\xf5\x54\x45\xe1\x23\x15\x1c\xf7\x7d\xbb\x34\xbb\x26\x25\xee\x35\xf0\x56\xda\x07\x78\x99\xf6\x9b\x9a\x7c\x83\xbf\x90\x4e\xe9\x24\xc7\x3b\xee\x43\x9e\xab\xcb\xa0\x85\x7d\x65\x46\xe6\x16\x72\x6e\xb8\x8a\xf1\xb7\xda\x41\x0e\xfe\xa4\xce\x5a\x9b\x12\xed\xad\xec\x4f\xeb\x32\x93\x4e\x94\x55\x5f\xea\x6b\xef\x3b\x0e\x24\x87\xed\x8e\xdb\xc8\x76\xaa\x9e\x93\x3a\x18\x9a\xda\x3d\xe7\xb5\xa9\x0e\x1a\x61\x43\xee\xa5\x95\xcb\x55\x94\xd5\x9f\xd2\x44\x6e\xf8\x9e\xaf\x91\x34\xe5\x88\x66\xfa\x3f\x54\x35\xb9\x9d\x7d\xc6\x71\x9c\xbb\xf2\x23\x38\x3e\xf5\x12\xca\xd0\xbd\x30\xa1\x64\x3a\xc6\x1f\x0e\x1b\xfc\x53\x75\xe7\x2c\x79\x99\xc6\x09\x5f\x9e\x56\x32\x51\x4d\xf4\xda\x3c\x36\x30\x87\xc2\x6e\xff\x6a\xea\x61\x6d\x26\xc2\xa2\x41\x51\xa1\x19\xce\xbc\x37\xa9\x4c\xa3\x7d\xd1\xd8\xfe\x4a\x79\x61\xc5\xbd\xb3\x6f\xb8\x2e\x3c\xc2\x83\x99\xfc\x4b\x4b\xd5\x9d\x71\x06\x04\xba\x89\x8a\x68\xf8\x42\xdf\xfb\xa8\x37\x68\x73\x75\x04\x52\x39\x1d\xef\x28\xa3\xa1\xd3\x8d\x9d\xac\x83\xdf\xb4\x62\xc7\xcd\x6e\xbe\x8e\x70\x1a\xac\xee\x90\xd3\x58\x6b\x4f\x23\x46\x06\xef\xb6\x10\x06\x8b\x96\x1b\xc7\x72\x84\xc9\xa8\x0e\xe6\x62\x51\xa8\x00\xf2\x93\x68\xc2\x09\x99\x9c\x91\xf4\x21\x48\x91\xaa\xa0\x45\x17\xc3\x75\x1e\xdf\x53\x2a\xaa\x0f\x55\xdc\x4f\xf8\x94\x4a\xda\x5b\xd9\xb6\xa7\xf5\x4c\x54\x13\x2d\xdf\x5e\xa8\xe2\x2f\x4e\xc7\x90\xe6\xbe\xa2\xaa\xb8\xcf\xa5\x2c\x48\x67\x0f\x5b\x6b\x29\x4e\x68\xbb\xf1\x42\xe7\xe4\x22\x07\xda\xc9\x52\xf9\x8a\x4b\xab\xf5\xf1\x2f\xd3\xc1\xc7\x86\xd8\x7e\x05\x9e\xe0\x60\xae\xdd\xef\x81\x49\xc7\x7d\x44\xaa\x50\x0b\x48\xe5\x70\x8d\x66\x28\x2f\x54\xfa\x04\xf1\x13\x6c\x85\xcd\x75\xc6\x31\xe0\xcf\x4e\x26\x23\x60\x8d\x60\x75\x79\xe2\x90\x1c\x45\x23\x15\x94\xa7\xd5\x4f\x54\x13\x4d\x76\x8f\x0a\xc3\xe4\x23\x1c\x85\xf4\x23\xc4\x65\x0e\x84\x5c\xeb\x6c\xa4\x00\x61\xd6\xbe\x43\x75\xd6\xb9\x10\x19\x14\x89\xc1\xa8\x66\xab\x90\x54\x14\x60\x4b\x92\x33\xa9\xe1\x28\xe6\xf4\x26\x57\xb3\x07\xba\x4e\xdc\x2b\x8d\x56\xb9\x82\x01\xcd\xde\x09\x94\xd7\x9d\x04\xe4\x0b\x27\xa6\x3d\x60\x47\x92\x0a\xeb\xf9\xbe\xa0\xf6\x6d\xa8\xd6\x42\x59\x35\x8c\xb6\x3b\xa6\xab\xc6\x05\xf6\xe4\xb4\x21\x08\x7e\x26\x6a\xcc\xa4\x13\x53\xd2\x97\xa3\x78\x71\xbf\xfc\xf8\x23\xc0\x22\x97\x0c\x7f\x2c\x52\x2c\xb4\x6a\x77\x0f\xc3\x01\x92\xc3\x2d\xa8\xa9\xe4\xc3\x5a\x10\xef\xf6\x01\xe8\x78\xc1\x67\xa2\x4f\xe5\xbb\xd0\x2b\x3f\xc3\x26\x89\x74\xaa\xfa\xf2\x1b\x2a\x1d\xe5\xbb\x15\x1a\x4e\x56\x31\x51\x85\xde\xbd\x56\x50\x49\x7b\xfb\xc8\x5a\x87\xea\xe4\xb8\xb1\x71\x17\x74\x48\x82\x43\xb9\xcc\x97\x04\xe1\xde\xa0\x4a\xea\xe6\xa9\x23\x1d\x9d\x9b\xfc\x33\xe9\x54\x47\xbe\x3b\x9e\xfa\x1f\x5f\xe2\x1c\xd7\xde\xe3\xd2\x7c\x6e\x84\x64\x75\xed\xb5\x7b\x0f\xba\x20\x0f\x38\x65\xdf\x7a\x23\xda\xfc\xfc\xe1\x84\xee\x8f\xb7\xa4\x99\x61\x2f\xbc\x3d\x23\xdf\x33\xd9\xa5\x1a\xfc\x55\x70\x27\x60\xff\x11\xc7\x39\x99\x9b\x67\xe4\xf8\x7d\x17\x7b\x54\x0d\x46\x56\xd2\x82\x68\x0d\x8a\x6a\x17\x96\x5f\x5d\x41\x68\xed\x9e\xdb\x84\xcf\x1b\xdf\xef\x13\x11\xaa\x0f\xc3\xd3\x35\xf3\xdc\x74\x3f\x72\x5a\x5f\x89\x72\xe2\x87\x06\xd7\x17\x46\xf1\xe7\xc8\x6a\x38\x29\xc3\xdd\x4c\x7a\x34\x72\x4a\x9f\x51\x55\xc5\x70\xb9\x93\x35\x1b\xde\xb5\x45\x55\x4e\x2e\x7f\xa6\x02\xc6\x3a\x60\xdd\x65\xc0\xf9\xc0\xe4\xa8\xb9\x56\x8b\x33\xb2\x5f\xa0\x9b\x6a\xed\x62\x9d\x11\x9b\x58\x52\xa6\xdb\x0d\x3e\x77\xf8\xb9\xc1\x29\x95\x85\x55\x0c\xe5\xdf\x84\xc3\x50\x00\xee\x1d\xce\x69\xde\xb0\x6b\x8b\x67\x94\xd7\x4c\x76\xc4\x53\x2e\xde\xb4\xc5\x98\xaf\xc2\x09\xba\xda\x85\x0b\x02\x2e\x5d\xda\x36\x9d\xab\x7c\x5b\x7b\x4a\x05\x4c\x5b\x84\x0d\x1c\xc6\x08\x8f\x4e\x53\x89\x41\xeb\xcf\xa9\xe3\x02\xdd\xa5\xc9\xe4\x33\xcf\xba\x38\x9b\x5c\x90\x4a\x42\x91\xf7\x98\x5c\x12\x76\x34\x4d\x26\x71\xfc\x9e\x6c\x62\xd1\xa5\x97\xa6\x27\x75\x36\xd0\x4e\x3b\x78\xf7\x8c\xdb\x4b\xe1\xc2\x97\xe4\x3d\xe9\xc5\x6c\x25\x2d\x3f\xdd\xac\x22\xe4\xd1\xb9\x64\xfe\x4f\x9b\x5f\x90\x71\xb3\x94\x87\xcf\xea\x2e\x10\x46\x0b\xb7\x56\x43\x74\x05\x69\xe3\xf7\xad\xf7\x85\x17\xcf\x3b\x04\xe2\x91\x2b\xc1\xec\xf5\x23\xb8\xc6\x79\xe9\x3a\xa0\xd6\x6d\xab\xb7\x5c\x3f\x3d\xb5\xc9\x52\xe1\x39\xe3\x19\x66\x0a\x84\x53\x5b\x34\xbe\x3e\x32\x78\x69\x80\x0b\x55\xe9\x4e\xfe\x1e\x8b\xa6\x6f\x83\x22\x4a\xbb\x02\x95\xf6\xeb\xe6\xa1\xdb\x6c\x07\x81\xe6\x58\x10\x8c\x6f\x7c\x45\xd2\xd1\x81\x71\xe2\x68\xb2\x4a\x08\x84\x30\x7b\xb0\x46\xa4\xc9\x71\x71\x56\x97\xf6\x68\x83\xf6\x68\x7f\xf6\x41\x88\xd2\x0a\x7e\xc0\x0c\x1b\x1d\x2e\x02\x89\xed\x23\x9a\xb4\xe9\x90\x25\x36\xd5\xf8\xc2\x2d\x94\x70\x29\xc7\xf0\xe7\x91\x18\x2a\x44\x0e\xf8\xd3\x1f\x93\x4d\xb2\xc9\xe1\xe4\xfb\xd7\xc8\x5a\xf4\xab\x4e\x3a\x87\xa6\x98\x70\xbb\x0a\x07\x74\x22\x08\x1d\x8c\xb4\x94\xab\xa9\xac\x05\x7c\xb9\x95\x7d\xcf\xb5\xc1\xd0\xb5\x1d\x9b\xeb\xfc\x56\xf2\x83\x5d\xe4\xe3\x4d\xe4\x6f\xb3\xdf\xff\x53\x27\xf9\xf1\x0e\x71\x0f\xc3\x4b\x7d\xe2\x1e\x36\x8f\x70\x8b\xc4\xe9\x62\xcf\x70\x7a\xbd\x6e\xf1\xec\xe4\xb9\x47\x3e\xf1\x8d\xe1\xeb\xb1\x4f\xc7\xc7\x2f\xce\xb0\x5f\x83\x90\xe1\x3d\x66\xfa\x5f\x0c\xf2\x23\x78\xad\xbe\xd7\x75\x7d\xfa\xd2\x86\x19\x55\x85\xae\xeb\x25\xcc\x6f\x32\xbb\x81\x51\x4e\x7f\x91\x14\xf6\xd9\xee\x31\x51\x67\xf3\x50\xa4\x7b\x66\xb2\x41\x63\xf9\x1d\xfc\x29\xb5\x47\xc2\x89\xf6\x36\xdf\x52\x30\x27\x2f\x8c\xcc\xf7\xde\x28\x9f\xd2\x5d\x5a\xf9\xf0\x34\x7d\x18\xca\xce\x1a\x5d\x2c\x3d\x0d\x3c\xb9\x49\xa6\x9b\xee\x51\x5f\xdc\xfd\x7d\xc3\x70\x21\xec\x32\x3e\x15\x94\x35\x08\x95\x5b\x93\x94\x22\x52\xef\xef\x0a\xf4\x31\xa5\x84\x69\xdc\xef\xdf\xca\x33\x6e\x10\x7a\x61\xec\xf8\xd2\x80\xd2\x92\xc1\x7f\x7b\xb4\x14\x8b\x91\xdd\xde\x43\x04\x9a\x31\x7d\x86\xe1\x5d\xa1\xeb\xc2\xd0\x06\x32\xaf\x7f\xf0\x6c\x9b\xdb\x9f\xe9\x09\x29\xef\x4f\xb4\x1e\xd3\x0d\x75\x1d\xde\x52\xa8\x6a\xfc\xfb\x10\x8b\xc5\x2e\x5c\x34\xcb\x7e\x02\x4d\xda\xb2\x0f\x30\x08\x34\x23\x2c\xb7\x9f\xee\x32\x56\x1b\x94\x1f\x5b\xa0\x03\xbb\xff\x0b\x00\x00\xff\xff\xf8\x51\x4b\xf8\x39\x37\x00\x00")
+var _configYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc5\x7d\x6b\x77\x1b\x47\x76\xe0\x77\xfd\x8a\x16\x1c\x1d\x4b\x59\x10\xa2\xe4\x99\x89\xc3\x9d\xb1\x8f\x2c\x69\x6c\x4f\x24\x5b\xb1\x64\xcf\xe6\x58\x5e\x9c\x06\x50\x20\xda\x6c\x74\x63\xfa\x41\x8a\x13\xe7\xbf\xe7\xbe\xab\xaa\x1f\x64\x83\xf6\x24\xd9\x4d\x2c\xa2\xeb\x79\xeb\xd6\xad\xfb\xbe\x1f\x25\xaf\xdb\xfd\x2a\x77\x2f\xfe\x72\xef\xa3\xe4\x8b\xeb\xe4\x75\xda\x34\xbb\xcc\xb5\xc9\x97\x55\xe6\xce\x5d\x05\xbf\x3e\x2f\x0f\xd7\x55\x76\xbe\x6b\x92\x87\xeb\x47\xc9\xd3\xd3\x27\x7f\xe8\xb5\x4a\x1e\xbe\xfe\xfa\x5d\xf2\x2a\x5b\xbb\xa2\x76\x8f\xa0\xcf\xba\x2c\xb6\xd9\xf9\xe2\x3a\xdd\xe7\xf7\xee\xa5\x87\x6c\x79\xe1\xae\xeb\xb3\x7b\xf7\x12\xf8\x9f\x8f\x92\xff\x28\xdb\x77\xed\xca\x25\xcf\xde\x7c\x9d\xc0\x87\x05\xfd\x7c\x5d\xb6\x0d\xfc\x78\x96\xcc\x66\xda\xee\x6d\xd9\x16\x9b\xe7\x79\xd9\x6e\xe2\xa6\x1f\x25\xdf\x7c\xfb\xee\xe5\x59\xf2\x6e\x67\x63\x24\x59\x8d\x23\x54\xc9\x3a\xcf\x5c\xd1\x24\x5f\xbf\xe0\xa6\x35\x0e\xb1\xc6\x21\xc2\x81\xff\x92\xee\x5d\xb1\x29\xef\x3c\xea\xcf\xdc\x9f\x87\xbc\x97\x97\xe7\x59\xe1\x77\xf7\x6c\xbd\x86\x49\x9b\x3a\x69\x76\x69\xa3\xdb\x3a\xd9\xe4\x09\xb4\xab\x93\xac\x48\xae\xb2\x66\x97\x5c\xed\x5c\x91\x54\xae\x01\x00\x5e\x66\xc5\x79\x92\x16\x9b\x64\x53\x5e\x15\x79\x99\x6e\xf0\xef\xa6\x4a\xd7\x17\xf5\x22\x79\x99\xae\x77\x49\xed\xaa\x4b\x00\x6e\xb2\x4f\xaf\x93\x95\x93\x79\xce\xb3\x4b\x18\x22\x05\x58\x97\x17\x99\xab\x93\x6d\x96\xbb\xc4\x7d\x38\x94\x55\xe3\x36\xc9\xb6\x2a\xf7\xf0\x71\x55\x95\x57\xd0\x9b\xa6\xdd\x65\x30\x14\xac\x27\x49\x2b\x97\xd4\xd9\x79\x01\xcd\xe0\xf7\x87\x33\x19\x61\xf6\x68\x0e\x3d\x5a\x68\x5e\xc0\xfe\x70\x45\x32\xd3\x21\xad\xeb\xab\xb2\xda\xcc\x93\xb2\x4a\x56\x65\xb3\x5b\x24\xdf\x4b\xab\x9a\x16\xae\x0d\x6a\x1a\x1a\xff\xe2\xa1\x53\x41\x84\xb6\x4a\x9b\xac\x2c\x78\x89\x04\x96\xb2\xc8\xaf\xe1\x5f\x0e\x87\xfb\xb8\xa6\x49\x65\xb2\x75\x8a\x70\x49\x61\x32\x5e\xf0\x3e\xbd\x70\x75\x08\xc6\x87\xab\xb6\x49\x8a\x12\x40\xdb\xc0\x9f\x87\x47\x49\x7d\x91\x1d\x92\x0c\x00\x0e\xe0\x1b\x98\xb0\x8e\x8f\xf7\x95\x4b\x2f\x71\x11\xae\x06\x68\xed\x0f\x0d\x2c\xa3\x34\xc8\xd3\xd9\xc0\x54\x78\x56\xe7\x78\x0c\x59\xb1\xe8\x62\x6d\xca\xe7\xbb\x48\x9e\x9d\xbb\x93\xca\xd5\x70\x84\x6b\x84\xf8\x65\xb6\x71\x65\x4d\xeb\xa7\xdd\x41\x53\x1d\x16\xbe\xd2\x79\x1b\xd0\x17\x36\x5a\x51\xc2\x5c\xc5\xb9\x6d\x1f\x46\x77\x07\xd8\x8b\x07\x29\x9d\xa4\xdf\xff\x1c\x70\x5a\x8e\x99\x00\xa8\xc7\x5f\x6e\xb5\xd1\x62\x0d\x1d\x00\xfa\xf8\xf5\x1b\xd7\xd4\xeb\xf4\x60\xcd\x16\xcd\x87\x46\x66\xda\x96\xd5\x1e\x4e\x82\xce\xaf\xe5\xb1\x0e\x29\x60\x26\x80\x03\xff\x4d\x67\xb5\x73\x95\x5b\x84\xc0\x6f\x0f\x9b\xb4\x71\xb5\xb5\xa0\xd5\x64\x4d\xb2\x6f\xeb\x06\x77\x7c\x55\x65\x4d\x0a\xf4\x44\x61\xfe\xb2\xb8\xcc\xaa\xb2\xd8\xe3\xed\xb9\x4c\xab\x0c\xbf\x31\x96\xe0\xbf\x70\x2e\xe8\xd4\x22\xba\xd0\x54\x11\x25\xa0\x3f\xf0\x7f\x64\xed\xe1\x0d\x2e\x32\x38\x68\xf8\xdf\xe4\x21\xfe\x5f\x02\xfd\xe2\x67\xc0\x05\x3b\x9c\xd7\x69\x71\x3d\x74\x24\x57\x69\xb3\xde\xe9\x79\xe0\x29\xf3\x79\xd0\xb0\x3a\xa8\x9f\x59\x2f\x03\x4d\xad\x3f\xea\xd1\xc8\xf5\xdf\xb6\xc5\xc5\xd5\x2e\xcd\x9d\x51\x80\x3f\xeb\x2f\x72\x8b\x69\xbf\x7f\x6b\x5d\xeb\x18\xc1\x10\x7a\x59\x05\xe3\x9c\x3b\xbc\x51\x5b\xb7\x71\x82\xaf\xdf\x7f\xf7\x6a\x4e\x27\x92\xe6\xab\x76\xcf\x97\x6b\xbd\x4b\x8b\xc2\xe5\x75\xb7\xab\x82\xf8\xcf\x51\x77\x9e\xac\x72\xeb\xf2\xbc\xc8\xfe\x6e\x84\x00\x80\x71\x28\x37\x73\xb9\xad\xe7\x4e\xd0\x0a\xa8\x78\x8d\x1f\xe8\x77\xc2\x80\x12\x30\x2e\xcf\x6a\x44\xe8\x95\xcb\xcb\xab\x05\xd1\x43\xb8\xa5\x32\x1b\x92\xa0\x34\x87\x43\x07\xd0\x40\x2f\x05\x38\xc0\xd7\x06\x9b\x27\x6e\x71\xbe\x10\xc2\x59\xee\xf7\x6d\x91\x35\xd7\x1f\xf3\x3c\xb3\x5d\xd3\x1c\xea\xb3\xc7\x8f\x01\x61\xb2\xf5\xc2\x7d\x48\xf7\x87\x9c\x30\x76\x36\x47\x6c\x38\xe4\xe9\xb5\xce\x84\x2d\x98\x5a\xc0\xb8\x74\x7e\xf5\x0e\x36\x27\x30\xc4\x0b\x8f\xc7\x33\x78\xbd\xed\x62\x53\x37\x1c\x14\x70\x7c\x95\xc3\x78\x3c\x2a\x6d\x7e\xdb\x05\xdc\x28\x0c\x68\x86\xb6\xca\x43\x0c\x04\x32\xef\x6a\xb8\x08\xe5\x05\x20\x12\x5c\x3e\x84\xc5\xe1\x00\x53\xf0\x88\x6b\xa0\x61\x38\x40\x59\xe8\x98\x09\xbc\x44\x40\x89\xdf\xba\xa6\x01\xca\x52\x27\x9f\x21\x0d\xa8\xc2\x4e\xf5\x9c\xb7\x86\xe4\x8f\x08\x41\x2d\x9b\xa3\x49\xc2\xc9\xbf\x85\x31\x2b\x5e\xe8\xd5\xae\xac\x9d\x9c\x69\x7c\xf4\x72\x0e\x3f\xce\xca\x83\x2b\x16\x69\xbb\xc9\xca\xd9\x4f\x74\x9e\x88\x41\x21\x38\xf0\xdc\x00\x46\xce\xd3\x3f\x7f\xb2\xbc\x02\x9c\xea\x2c\xf9\xf1\x27\xc0\xf7\x9f\x5d\x9e\x5f\x6f\xb3\xc2\x3f\x78\x9b\x4d\x85\xa0\x40\x20\x24\x7f\x91\xaf\xf4\x66\xb9\x4a\xd6\x40\xc7\x0e\xa7\xfe\xe4\x5f\x9f\x2e\x9e\xfc\xe1\xd3\xc5\x93\xc5\x93\xd3\xb3\x4f\x4f\xff\xf5\x0f\x33\x58\x0f\xdd\x91\xb9\xa0\x3c\xfc\xb7\x6a\x00\xf6\xf2\xb0\xc0\xaa\xf0\x24\x6a\xa5\x59\x78\x6e\x78\xf2\xbc\xee\x3c\x5b\x55\x40\x54\x5c\xff\x86\xe5\x59\x71\x61\x38\xee\xfc\xaa\xae\xdc\x4a\x1e\xf3\x79\xb2\x82\xf7\xbd\x71\x7b\x78\xd5\x65\xf4\x87\xf7\xd3\xcd\x26\xb1\xfd\xfd\x51\xbe\x7e\xf6\x88\xde\xbd\xeb\x84\x9e\xc5\x4e\xa3\xda\xa5\x15\xbc\x52\x8d\xab\xf6\xf5\xa3\x1b\x51\x71\x93\xd5\x4c\xf3\xc2\xf5\xc8\xcb\x3e\x8c\x61\xc2\x84\x28\x2a\x09\x49\xb7\xbe\x9b\xb4\xde\xad\xca\xb4\x52\xcc\x7a\xb6\xb9\x4c\x8b\x35\x34\xfc\x8c\xba\xfe\x1b\xb0\x5c\x3c\xae\x30\x60\x42\xaf\xe0\xbe\x7d\x18\x3e\xbb\x37\xf0\x25\x79\xed\x36\x59\x0a\x58\x7a\xdb\xe9\x7d\xf2\xf4\x77\xa7\xa7\xff\x03\xc7\x47\x8b\xfa\xab\x5b\xcd\xe5\x10\x18\xe0\x70\x83\xce\x92\xfb\xb8\x95\x24\x3c\x81\xa9\xf0\x7f\xc3\x1d\x6f\x80\x7d\x0b\xcd\x8a\x46\x6f\x33\xdf\xf2\x87\xff\xef\x04\x3b\x9e\xbc\xc3\xbf\x1e\xe9\xa5\x17\x02\x48\xeb\x4e\x95\x28\xd0\x2c\x7c\x05\x7a\x57\xf8\x5e\xdd\xae\x6a\x7c\x68\x86\x4f\xe1\xad\x7c\x3d\x01\xa2\x08\x0f\x72\x86\x6b\xd6\xcb\x54\xb7\xb0\xd3\xb4\x4e\x9e\x65\x15\xb5\x41\x98\x7c\x93\xc2\x33\x07\x90\x72\xe1\x69\x0d\x93\xd8\x85\x31\xd6\x48\x80\x84\x34\xf1\xd8\xe1\x11\x84\x50\x46\x36\x01\x9b\xed\x01\xdc\x88\xf8\xb6\xf6\xbb\x80\x5d\xb7\x76\x33\xe8\x05\xa0\xc2\x1d\x22\x91\xef\xac\x95\xdf\x24\x7d\x86\x91\x7a\x15\x0e\xb7\x50\xc3\x89\xfd\x5f\x20\x80\xb0\x0d\xc2\x40\xcf\xe6\xca\x8b\x01\x57\x08\xa8\x7a\xba\x91\x79\xbb\x8f\x7b\xe7\x61\xdf\xb8\x6d\xda\xe6\x8d\xe7\xec\x5f\xf0\x0f\xf4\xa8\x21\x43\xc3\xdc\x0b\x11\x70\x98\x03\xff\x2a\x9b\x98\x04\x7c\x4d\x4c\x19\xf0\x81\xc4\xb0\x5e\xa5\xd0\x29\xb5\xee\x00\x66\x99\x02\x0e\xd6\xd1\x70\x0c\x35\x64\x29\x01\xf2\x0f\x67\x33\xa1\x28\xd2\x03\xd6\xf5\x15\x5c\xfe\xf2\x7e\xf2\x75\x92\x12\x77\x0f\xf3\x25\xef\xae\x81\xbd\xbb\xbf\x73\xf9\x81\xce\x2a\xa5\xa7\x0b\x51\x09\x7b\xc1\x2d\xac\x17\xb3\xde\x06\x98\xa5\xd0\xb3\x25\x30\xe3\xec\x05\x9c\x26\xb0\x78\x25\xb1\xd1\x85\x5b\x23\xee\x0f\x6e\xe8\x2a\xab\x77\xdd\xde\xd2\x45\x91\xbf\x2a\x4b\x9b\xe8\xd6\xfd\x71\xb3\x10\x0b\x9e\xf3\xe2\xb1\x13\x72\x1a\xc2\x1a\x24\xf4\x8a\x09\x5b\x4f\x58\xd0\x5c\x95\x80\x93\x07\x91\x7a\xd6\xbb\x12\xd0\x8a\x8f\x7e\xb6\xdd\xee\x0f\xee\x7c\x46\x94\x68\x96\x5e\xc2\xfa\x2e\xe5\x06\xd0\x63\x57\x2d\x05\x40\x67\xd6\x14\x0e\x9d\xae\x80\x9d\xf8\x77\x78\xfd\x99\x07\x51\x0e\x77\x0f\x3b\x81\x8d\xbb\x0f\x6b\xe7\x36\x7c\xec\xb0\x9d\x73\x94\x82\x53\xe6\xf7\x48\x20\x91\x5b\x8f\x7f\x2f\xf1\xef\x25\x71\x1a\x67\xc9\xe9\xe2\xf7\x77\x1d\x5c\xa9\x69\x30\xbe\xfe\x34\x36\xc5\xeb\xf4\x43\xb6\x6f\xf7\xb2\xae\x8d\x8a\x45\xf4\xf0\x00\x3c\x00\x37\x90\x1f\xc1\x69\x4e\xe9\x38\xdb\x22\x10\x68\xb4\x39\x4f\xb5\x4f\x3f\x2c\x79\x3b\xfa\x3b\xcc\x34\x79\x1e\x1a\x3d\x2b\x36\x19\xd0\xaa\x36\xcd\x95\x00\xc0\x7b\x51\xc2\xcd\xad\x32\x92\x79\xfb\x53\xc0\x19\xc3\xd5\x5d\xef\x64\x9a\x1f\xbe\x7d\xc1\x67\x5b\x6e\x1b\x14\xa7\xf0\xd6\xc3\x60\xc0\xb1\x54\x35\x89\x51\x24\x8e\x00\xf6\x5d\x53\xab\x68\x37\xfe\xb6\xfd\x9a\x3d\x2f\x65\xb9\x20\x8d\x98\x3c\xd0\xd0\x12\xc7\xa0\x01\xac\x15\xb2\x6a\x72\x50\x37\xcd\x6d\xaf\x25\x63\x36\x7e\xe1\x17\x41\x65\x45\x43\x00\xc4\x19\x99\xeb\x0a\x5e\x83\x75\x8b\x0d\xb7\x24\xe7\x20\x41\xda\x6c\x98\x5b\x58\x91\xac\x23\x82\xc3\xfd\x7d\xa9\x02\x96\x6d\xab\x5e\xc2\xda\x96\x3a\xec\x59\xf2\x7b\xdb\xc2\x5b\x80\x69\xbe\xd1\x1d\x20\x66\xc2\xc6\x81\x29\xdd\x21\x6b\x0a\x8b\x92\x0f\x34\xf2\xd6\x5d\x39\xd4\x0b\x94\x48\x74\x49\xae\xb2\x13\xa0\x1f\xdd\xe6\x73\x1a\x95\xfe\x58\x56\x0e\x28\xac\xab\xce\x92\x2d\x88\x11\xae\x0b\xb2\xa2\xdd\xaf\x60\x30\x98\xe1\x50\xd6\x19\x31\xc5\x76\xad\x50\xf4\xc0\x65\x20\xe4\xae\x90\xed\x39\xe8\xb4\x3c\x6b\x34\x3e\xbe\x0a\xae\xc0\x97\x67\x63\xaf\x5e\x08\x79\x94\xbb\xb3\x7d\x06\x07\xf2\x05\xaf\x31\x94\xd5\xf8\x39\xe9\x6e\x79\x87\x1f\x3e\x34\xdc\x70\x11\x6c\x09\xe1\xf9\x73\xbb\x3f\x9c\x25\x9f\xf4\x50\xa0\x6c\x00\x41\xed\x42\xe0\x71\xe6\xb9\x4e\x25\x0c\x1d\x91\x9c\xe8\x4e\x7e\x5f\xbb\x6d\xcb\xe4\xd9\x15\xac\x0e\x82\x76\xcc\x34\xa1\xc8\xae\x7a\x19\x10\x86\x00\x75\xf8\x79\xcd\xf6\xae\x83\x5c\x80\x0d\x11\x7e\xd1\x3c\x1e\x03\xe8\xcf\xa1\xcb\xfc\xd7\x1d\xe9\x95\x0c\xdb\x00\x92\x84\x52\xf3\x24\xa7\xa7\xbd\x14\x6d\x81\xec\x42\x98\x3a\x26\x64\x80\x09\x2e\x94\x25\x32\x11\x0b\xf7\x28\x81\xee\xb3\xa2\x6d\x9c\x72\x0b\x48\x96\x2b\x47\x7a\x8c\x5d\x79\xc5\x2d\xa8\x7b\xee\xb6\x0d\x4e\x62\x70\x50\x9c\x4a\x6a\x64\xc0\x7b\xeb\x4a\xd2\xf3\x14\xe6\xc9\xd3\x86\x15\x5d\xd8\x72\x93\x5e\xf7\x8e\x1d\xfe\x4f\x9a\x5f\xa5\xd7\xd4\x2d\xc1\x23\xbe\x16\xcc\xa2\x5b\x66\x57\x94\xfa\x81\x18\x05\xcf\x61\x7e\xbd\xe4\xcd\x2c\xaf\x80\x78\x95\x57\x01\x94\xbe\xae\x41\x1c\x6d\xb7\xdb\x1c\x8f\x47\x30\xcd\xaf\x14\xdf\xc4\xba\x01\x5e\xb8\x66\xdc\x4f\xdb\xa6\xdc\x03\xa0\xd7\x4b\xee\xe4\x96\x08\xf2\xe8\x0a\xc0\x80\xb0\x26\xe0\x0b\xf6\xe5\xc6\xdd\x38\x22\x9c\x10\xe9\xfa\x7c\x6b\x12\x90\xe7\x86\xc2\x04\x95\x15\x2b\xd8\x76\xa5\xe7\xbf\x49\x9a\x65\x1d\x1d\x1f\x11\xeb\x75\xd3\x2d\x42\x8e\x94\x49\x6d\x55\x11\x67\x83\x03\xcd\x3d\xee\x13\xb0\x56\xe5\xe6\x3a\x71\xb0\xe2\x8f\x91\x42\x95\xe7\xe7\xb0\x06\x26\x2d\xb4\x12\x5c\x08\xc3\x8e\xfe\x5c\xe2\xdf\xfd\x5d\x7e\x43\x4a\x43\xb9\x4e\x3b\x21\x19\x28\xc1\xca\xda\x9b\xf4\x02\x56\x57\x65\x65\x95\x01\xa7\x00\xd8\x49\xe0\xb5\x9d\x86\x13\x50\x6f\x16\x4a\x85\x73\x2c\x0a\xe0\x1c\xd7\x32\x16\xa0\x02\xab\xb8\xf0\xe2\xa5\xc2\x4f\xba\xf3\xac\x28\x70\x48\x3c\x72\xe2\x25\x10\x12\x2b\x68\x2e\xe7\x24\x43\x2c\x0b\x77\x25\x34\xf2\x0c\x86\x6b\x6d\xfd\x6f\xe1\x42\x22\x13\x0c\xa4\x03\x80\x86\xc4\x09\x16\x7b\x09\xa8\x07\x6f\x77\x5d\xa3\x46\x47\x4f\x0c\x84\x6c\x5e\x07\x4d\xca\x12\x36\xcc\xfc\x39\xe9\x4e\x6b\xa2\x66\xc8\xf7\x9c\x3b\xba\x21\x5e\x29\x47\xdc\x76\xed\xf2\x4b\xe7\x55\x3e\xc8\x3e\x66\xdb\x6b\x65\xe9\x44\x5d\x45\xbf\x2d\xfd\x62\x3a\xa0\xa6\xa5\x92\xa2\xae\x05\x9a\xa3\x3b\x23\xd6\x93\x10\x1e\xb6\xa8\xf8\x4f\xda\xd8\x92\x44\x33\x1b\x4e\x14\x51\x80\xe5\x78\x45\x01\xcd\x9d\xb2\x76\xc2\xae\xc9\x34\xc2\x53\x8f\xec\x6b\x74\x47\x02\x36\x5d\x56\xbc\x35\x3b\x06\x69\x95\x5f\x77\xf6\x06\x12\x53\x48\x83\xf0\xbd\xd0\xd7\x13\x49\x40\x05\x23\x01\x55\xa2\x97\xe0\xd8\x85\x01\xab\x2a\x8c\x82\x6a\xa4\x79\x65\x24\x80\x32\x87\x5d\xc3\x39\xe6\x01\x25\xa2\xbe\x33\x92\x8f\xbe\xff\xee\x55\x72\x72\x22\x97\x5c\xd8\x4d\xbd\xf2\x74\x2f\xed\xb9\xed\x1e\xd7\xbf\xd3\x33\xe0\x50\xdf\x0f\xcb\x3c\x34\xfc\x0c\xa6\xac\xc4\x14\xf1\x92\xc8\x3c\x50\x01\xe0\x56\xe5\xc1\xc2\x91\xbc\x5c\x88\xf2\x28\xca\xe1\xc0\xc4\x8b\xde\x19\x7f\xd4\xf5\xd2\x48\x73\x25\xbf\xf4\xc1\x1d\xd2\x0a\x91\x57\x18\x57\x61\x47\x6b\x92\x0f\x85\x9d\x40\xd6\xf2\x40\x9a\x2c\x87\x34\x05\xfe\xf3\x39\xf1\x27\xb2\xc8\x3a\xa4\x27\xa6\x70\x41\x4a\x2d\x13\xa9\x12\x7c\x11\x9c\x03\x69\x10\xd3\xfa\x42\x0e\x41\x4e\x23\x5e\x68\x1f\xaa\x3a\xa3\x82\x15\xe4\xae\x66\xa9\x3f\x0e\xd0\x19\x25\x33\xfc\xc0\xd2\xce\x70\x9d\xf5\x10\x51\x5d\x00\x4a\xed\xf1\x9a\xe2\xf2\x50\x5a\x69\x0f\x49\x49\x5a\x36\x14\x11\xe5\xf1\xac\x3d\xa4\x67\x20\x1d\xe7\xf9\x0c\x70\x42\x26\x9c\xa9\xdc\x39\xe3\x8b\x53\x13\x57\x28\x46\x0c\x84\x9d\x4c\xad\x68\x06\x52\x0d\xaf\x2b\x42\x7c\xc1\x3c\x79\x9c\x45\x3a\xdd\xc3\xf3\x66\x82\xd1\x37\xc6\x21\x29\x6b\x1d\xd3\x31\x66\x93\x90\x8a\x02\x8b\x73\xa8\xca\x73\xd2\x2c\xac\x1c\x00\xd8\xf5\x69\x7c\x62\x94\x07\xc6\xaa\x01\xec\xa8\x5f\xad\x9b\x16\xbe\xe0\x26\xe0\x60\xe4\xf8\x17\xd1\x3b\x1a\x0a\xf5\x36\x31\xa9\xd6\x37\xe5\x39\xef\x44\xff\x5a\x22\xca\xc2\x6b\x0e\xcc\x51\xc0\x61\xc0\x51\xc0\xb9\x1d\x5c\x61\xca\x12\xd1\x3d\xf8\x0b\xcd\xa6\x28\x7c\x1d\x70\x3a\x91\x2e\x6b\xbc\x84\xc4\x86\xd4\x81\xf9\x48\xe5\x59\xde\xa5\x4c\x12\x90\xe0\x10\x47\x01\x9e\x17\xce\x1d\x66\xc1\x28\xfb\x88\x13\x9b\xe3\x51\x22\xef\x37\x4b\xf8\xbf\xdc\x86\x4f\x75\xb6\x81\x9f\x1a\x37\x53\x1d\xb5\x7d\xd6\x6d\xac\x84\x9f\xb0\xe1\x14\xed\xd9\x1c\x26\x0b\x45\xfd\x16\x3f\xd1\x2c\xae\x3b\x7a\x93\xe0\x2e\xc2\x9b\xb7\x43\x1e\x0b\xd5\x05\xc8\x07\x29\x56\xe0\x27\xa0\x1d\x21\xad\xe7\x6d\xdc\x80\x16\x1e\x7e\x3b\x40\x58\xe2\xaa\xf0\x1f\x24\xaa\xef\x65\xa5\x1e\x2f\x62\x58\xf1\xce\x37\x08\x6d\xde\xf1\xa6\xb3\x92\x73\x68\x0b\xb8\xf9\xe4\xe9\xf0\xa1\xda\x0d\xcb\xd3\xda\x50\x2d\x64\x77\x71\x25\x76\x20\x35\xb0\x33\x45\x33\x03\x9c\xc1\x17\x88\x68\x82\x70\x03\xa5\x09\x34\x4a\xb7\x66\xc8\x4a\x61\xcf\x19\xfe\xee\xa5\x03\x61\x77\x88\x45\x64\x1d\x24\x5e\x53\x5b\x02\xde\xc0\x88\x3a\xa9\x08\x0a\xc7\x9d\x97\xe5\xc1\xc8\x32\x0f\xeb\x71\x28\xc0\x48\x1b\xcc\x08\x3f\x71\x9e\x30\x02\x90\x9e\x1c\xe1\x29\x6b\xd2\x3f\x97\xc0\x7b\xbb\x74\xcf\x7c\x97\x20\x10\xa1\xdd\xcc\x63\x4e\x60\x5b\x51\x05\xc9\xd2\xe3\xb3\x59\x1f\x02\x05\x0c\x76\x62\x16\x4f\x96\x56\xb5\x05\x31\xe5\xc2\x70\x7f\x72\xaa\x38\x20\x1a\xc1\x95\x5b\xa7\xa4\x44\x41\xb1\x6c\x8d\x6f\x2b\x29\x1b\x18\xfc\xf3\x90\x10\x5e\xeb\xc6\xf9\x44\x40\x7e\x68\xb2\x3c\xc4\x0b\x9a\x57\x2e\x38\x1c\xf1\x92\xd6\xeb\x4f\x50\x71\x01\xe9\xb5\x5a\x6e\x78\xa9\x86\x10\x7c\xfc\xb0\xe4\x9a\xd6\x9c\x6d\x83\x81\xb0\xb9\x87\x65\xf4\xac\x65\xa8\x9b\x2a\x80\x04\x55\x29\x52\x3b\x58\x2b\xf2\x75\x32\x5d\x59\xf5\xf8\xf7\xce\x11\x44\xaa\x25\x81\xae\xee\x5b\x8e\xa2\x3c\x62\x8d\x7c\x88\xaa\x70\x7d\x55\xae\x56\xd7\xe1\x53\xf0\x1a\x25\xb5\xc7\x7f\x05\x6c\xc6\x6b\xfd\x5d\x89\xaa\xd7\x48\x2f\xaa\xaa\xb3\x50\x49\xd6\xf7\x42\xc0\xc5\xd1\x4b\xc9\xf7\x02\x2d\xa4\xc2\xab\xab\x7a\x0e\x2d\xd4\xe1\x1b\x87\x42\x2f\x4e\x20\x6c\x72\x88\x4c\x21\x04\x40\xc2\xa3\xa7\x2d\x86\x00\x11\x84\x98\xc5\x43\xb9\x8e\x08\x07\x89\xa2\x11\x6e\x96\xc4\x68\xd3\x9a\xf0\x95\x00\x8a\xd2\x90\xbe\x58\x34\x75\x7a\x5d\xdb\x22\xc7\xf7\x27\x63\xda\xb3\x72\x00\x61\xa1\x2c\xa4\xb4\xe8\x0c\x2a\x24\x62\x0f\x6c\x21\x09\xb4\x22\x8a\xfd\x5c\x66\x05\x88\x12\x74\x47\x63\x76\xfc\x3b\x77\xde\xe6\x29\x6a\xcc\x0e\xf8\xce\x91\xbe\x80\x10\x2f\x24\x62\x7c\xef\x89\x4a\x34\x59\x83\x06\x68\x4f\xf6\x58\x4f\x01\x0f\x8c\xde\x06\x3a\xd2\xa6\x24\x25\xe5\x41\x0f\xf4\xc7\x6f\xb7\xdb\x6c\x9d\x81\x28\xff\x03\xb2\x26\x3f\xc1\xd1\xcf\x1e\x7e\xf5\xe2\x11\xfe\xf7\x24\x79\x75\x0d\x12\x76\x8d\x08\x90\xcc\x7e\x31\xf4\x42\x0e\x64\x06\x28\x0c\x3d\x3f\xa0\xb6\xf2\x3b\x5a\x0d\xc9\xff\x70\x55\xc8\xec\x81\xd3\xa0\xec\x2b\xab\x4a\xeb\x93\x4c\x2d\x7e\xf8\xcb\xb2\x5e\x57\xed\x6a\x79\x48\x91\xe2\x17\x81\xc6\xe9\x24\xf9\xf8\xe1\xe7\xd9\xa3\xf7\xf5\x3f\xff\xf8\xfe\xe1\xfb\x1f\x7f\xfa\xf1\xff\xbf\x7f\xf4\xfe\xa7\x9f\xfe\xf9\xfd\xea\x61\x29\x0b\xfd\x85\x78\xa8\x5f\x88\x37\xf8\x25\xa7\x05\x7e\x0e\xbf\xd5\x6d\x9a\x67\x3f\xd6\x7f\xff\xc9\x55\xbf\xec\x36\xbf\xec\xfe\xf6\xcb\xef\x2e\x7e\x01\x38\x01\x55\xc3\xa7\xff\xd1\xfb\x95\x8e\xf5\x23\xfd\xe7\xe3\xfe\x9c\xff\xe7\x04\xfe\xd7\xe6\x81\x7f\x3f\xfa\xfc\x21\xa9\x26\xe0\x9f\x3c\xa9\x4e\x47\x93\xe3\x2a\xff\x29\x1a\x06\xda\xbd\xff\x65\x81\x3f\xaa\xb2\x84\x25\xa7\x9a\x14\xf8\x4a\xc8\xe5\xf1\x7c\x51\xe2\x85\x90\xa3\x14\xcd\xb1\x1c\x31\xc9\x55\xc2\x25\x3e\x98\x25\x0f\x8d\x35\x7b\x80\x3c\xd8\xec\xc1\x06\x2f\x68\xb3\x5e\x88\x92\x59\xe4\xb3\x00\x8c\x24\x22\x35\x89\xc9\x18\x66\xb7\xd1\x57\x96\xd9\x10\xc6\x1c\x22\x0e\x59\xd3\x91\xe6\xe6\x78\xff\x22\x3d\x13\x4b\x66\x57\x4b\x69\x00\xd7\x8e\xcc\xbc\x3c\xc8\x1f\xb3\xcf\x1e\xd4\x7f\x7c\x9c\x7d\x46\x46\x0b\x38\x79\x69\x75\x7f\xd6\x5d\x54\xf7\x1e\xb2\x90\xa5\xaf\x50\x5f\xa2\xd3\xe5\x65\x02\xc5\xf1\x4d\x0d\x2e\x73\x49\x52\x1e\x2c\xf6\x1b\xbf\xa8\xb3\x60\xb9\x0f\x1f\xd4\xe8\x1d\xa4\x8a\x85\x3f\xae\xe8\xc3\xea\xb3\xc5\xec\x6e\xd0\xa4\x03\x5c\x93\x8e\x31\x7a\x8d\xfc\xe2\x58\xef\xba\x4d\xe1\x61\xd9\x8c\x01\x71\x60\x00\x7a\x64\x8d\xd4\x08\xf3\x7a\x96\x00\x4a\x84\x0b\xdd\xa1\xab\x10\x20\x0f\xf4\x59\x9b\x98\x10\x6a\xe9\xf2\x8c\xb1\x0d\x9e\x0e\x66\xdd\x02\x58\xd7\x7e\x91\xd8\x0c\x16\x87\xff\xe9\x01\xe2\x8a\xd5\x68\x28\x4b\xf1\x76\x77\x24\x72\xc1\x6a\x81\x08\x34\x4d\xca\x6e\x28\xa4\x3f\xa1\xdf\x62\xc4\xea\x02\x02\x9b\xc0\x4c\xaf\x88\x9d\xca\x50\x2c\x00\x30\xbc\x07\x54\x7f\x3f\xe3\x03\xc2\x06\xf1\xd9\x3c\x1a\x5e\x12\x6e\x75\xf8\x35\xb5\x27\x5b\xd6\x20\xef\x02\xd9\x3f\x45\x5f\x80\xbb\xf1\x4b\xa3\xde\xcb\x18\xdb\x03\x04\xc2\x9e\xb6\x9a\x00\x9b\xc6\xd7\x75\x93\x10\x60\x4c\x6c\x40\xda\x87\xaf\x9f\x31\xa9\xd2\x0a\x56\xf5\x9d\x3c\x05\xb8\x9c\x0d\x2e\x87\xe7\x78\x58\x3f\x1a\x40\xea\x79\x34\xdf\xe2\x37\x58\x2e\x4f\x3e\x26\x22\xdc\xb2\x0b\x61\xc0\x61\x17\xaf\xef\xba\x87\xf9\xb8\x78\x82\x46\x2f\x6f\xed\xeb\x99\xa4\x89\x31\x64\x63\x00\x3e\x43\xf0\x5a\xc7\xb6\x3e\xd1\xd7\x70\x6b\x58\xe2\x93\xa7\xff\xb2\x38\x85\xff\xf7\xc4\x98\x8d\x37\xa8\x3e\x9a\x36\xcc\x81\x69\xd0\x1f\x7e\xf7\x2f\x9f\x7c\xea\xfb\xab\x9d\x17\x79\x90\x80\xf1\xc1\xc7\x33\x30\xb0\x07\x0c\x32\x0a\xbe\xe6\xb2\x78\xb3\xe5\x31\x36\xf9\x0a\xf3\xaa\x1e\x90\x38\xa1\xba\xc7\xf6\x4c\xc6\xfa\xc1\xba\xfd\x19\x28\x95\x3a\xd0\x11\x16\x1c\x9e\x3c\x65\x2f\x3a\xd2\x6d\x04\x0e\x05\xe8\xee\x89\xa4\xa0\x82\x1b\xcf\xef\x2e\x75\x18\xdc\x87\x8e\x41\x46\x6e\x47\x5a\xf8\x9b\x77\x84\x23\x2d\xa1\x5b\xe4\x48\x2b\xd6\x1c\xe5\x29\xe5\x04\x88\xad\x06\x51\xa1\xad\x5c\x60\xf0\xfd\xdc\xb4\xa9\x43\x5f\x93\x4d\xe9\x6a\x22\xb9\x00\x79\x54\x49\xd2\x2b\xe5\x40\xe0\xda\xe2\xde\x8c\x98\x8a\x57\xc1\xd6\x98\x62\xd2\x2f\xa0\xa4\xbb\xbe\x5e\x24\x5f\x13\x99\x59\xa1\x85\x0b\x76\x92\x8b\x4b\xa6\x68\xb1\xd1\xbf\x53\x15\x0c\x19\x71\xdf\xea\xb4\x0a\xa2\x31\x6c\x56\xf5\x8e\x75\xdd\xc2\x52\x62\x8c\x48\x75\xe2\x92\x3d\x1a\x80\x87\x27\xd1\x7a\xdf\xe6\x4d\x76\xc0\x01\xe1\x21\x45\x2f\x19\xba\xae\xf1\xe1\xea\x6e\x3b\x9a\xa4\xf0\x5c\xc3\x8d\xe2\xb1\x0c\x1d\x59\xb7\xcd\xf4\xa3\xc3\x9e\xe1\xb1\x8d\xcd\x8c\x4e\x41\x63\xb3\x8b\xd7\xf2\xb4\x09\xcd\x29\xa8\xef\xd2\x46\xcc\x69\x56\x80\x04\x03\x0c\xe3\xdf\x9d\xe1\x0e\x3e\x58\x73\xd3\x1b\x12\xcd\x21\x05\x56\x3d\xb4\x98\x34\x1a\x90\x2d\x6b\x53\xd6\xc5\xfd\x96\xdc\xef\x26\x44\x56\xab\x0a\x30\xd5\xd7\x21\x61\x41\xc7\xea\xeb\x10\x6b\x43\xd4\x60\x11\xca\xeb\x94\x50\x29\x2f\x82\x06\xf4\x5a\x0a\x21\x8e\xe5\x8c\xaf\xd4\x42\x45\x0a\x58\x25\x65\xdd\x0b\x45\x33\x77\xfc\x20\x78\xd2\x70\x02\x69\x0d\x1b\x7b\x72\xda\x1b\x5f\xb5\x37\x9d\x19\x50\x02\x84\xe3\x38\x59\xb9\xe6\x0a\x19\x9b\x60\x6b\xbc\x57\x1d\x34\x9c\x88\x5e\xf9\xcb\x14\x44\xbf\xdf\x0f\x00\x90\x25\xc6\x15\xa2\xd3\x01\xdf\xb4\x2c\xf7\xa7\x6c\xbb\xa8\x3f\x17\x07\x2f\x2f\x55\xd5\x4d\x96\xa3\x6a\x82\xc8\x18\xdb\xdf\xbc\xeb\x50\x8a\x4e\x99\x20\x63\xcc\x03\x23\x5f\x5f\xe9\x08\x6f\x45\x8b\x60\xbc\x62\xf1\x11\x55\x0f\xa5\xe8\x98\xd7\x7e\x11\x19\x8b\xa4\x3d\xc4\x12\xda\x20\x9a\x8b\x48\xf0\xcd\x44\xd3\x40\xf6\xdb\x60\x1c\x7f\xd8\xfa\xc2\xa2\xf2\x8c\xb5\xac\x63\x07\x2d\x4a\x0f\x36\x1c\x81\x08\xc9\x66\x13\x3f\xa5\x9c\x50\xd7\xcd\x7b\x18\x8c\x73\xd3\xad\xa3\xcc\xa9\xc0\x21\x46\x26\xdd\x5c\x9b\x7b\x0b\xed\x3f\xb3\xad\xeb\x61\xca\x28\x4b\x90\x71\xb7\x8e\x7c\x0d\x3e\xf1\x46\x1e\x44\x2f\xba\xad\x24\x21\x35\xe5\x1c\xf9\x55\xb2\x7c\xcc\x03\xcb\xa9\xa0\xfe\x0a\xdb\x78\x15\x50\xe5\x88\x0d\x9d\xb3\xd9\x01\x65\x27\x7d\xc9\xf1\x29\x16\x05\x87\x0a\xc1\xb8\xa2\xf6\x10\x3a\x94\x9d\xf1\x4b\xcd\xfe\x0a\x76\x10\xeb\xb4\xaa\xf0\x20\x52\xf6\xc8\x30\xb7\x5a\x7b\x92\xc3\x10\x83\x90\xb0\x99\x65\x98\x56\x49\x1e\x1c\xe8\x19\x4e\xba\x07\xb2\xd6\x86\xef\xbd\x57\xf0\x30\x04\x42\x43\x60\xef\x36\x91\xcd\x41\x81\xb0\x62\xcf\x10\xd8\x31\x3d\x31\x81\x6a\xdc\xeb\x42\x98\x64\x98\xc9\xdf\x8c\x1e\xa6\x92\xa1\x66\xaa\x7e\x2a\xf8\xe0\xe2\xeb\x6d\x57\x92\x35\xba\x2c\xc9\xe8\xda\xb3\x1c\x1d\x49\x96\x44\x8a\xce\x92\x3f\xdc\x9d\x0e\xec\x1c\xf9\x61\x04\x0a\x1d\x10\xc0\xf6\xa9\x01\x4b\x51\x69\x2e\xa8\x99\x89\x37\xb5\x82\xda\xe0\xa8\x84\xca\xb6\x09\xbb\x69\x2b\xaf\x9f\xef\x0c\x9b\xa2\xce\x07\x0d\xab\xa4\xdb\x11\x53\x91\xa0\x93\xaa\x6d\xb0\x7f\x40\x84\x3e\x39\x3d\x45\x5e\x13\x9b\x18\x9b\xf9\x1c\xff\x12\x7b\x13\xab\x6b\x45\x21\x63\x57\x8a\xef\x80\x11\xe5\x41\xaf\x11\xf6\xb2\xa0\xc7\xb6\xc6\xc7\x0a\x9d\xdf\x68\xe0\x4d\x06\x97\xa7\x29\x61\xd9\x70\x27\x5e\x67\x5f\x98\xf7\x03\x76\x5b\x62\x5b\xa0\x8d\x4f\x9e\x1a\xab\x09\x2c\x4d\xc9\x42\x36\x90\x79\xb1\x85\xf1\x01\xb8\x3c\x3d\xd4\x86\x2c\x22\xd6\x21\xb2\x03\xf3\x52\x85\x96\x2f\x9a\x98\xee\x20\xb9\x25\x89\x26\xee\xc3\x01\x56\xb2\x64\xc1\xed\xe9\xef\x46\xe6\xd3\x43\x15\x1b\xa0\xf3\xac\x3a\xef\x86\x2e\x02\x8d\xb4\x21\xcf\xe5\x9a\xa6\x11\xaf\x0a\xf5\xa4\x83\x5e\x43\x84\xff\x85\x41\x82\x74\x5b\xb8\x89\x35\x8b\xa0\x34\xd2\xe2\x4e\x91\x1a\x06\x5e\x78\xa3\xff\xe9\xab\x6f\x5f\xbf\x7c\xbc\xa0\x41\x1f\xef\x89\xb1\xda\xfc\x3c\xf3\x2a\x9e\xb4\x6e\xe5\x96\x61\x34\x56\x21\xee\xae\xfd\x93\xe7\x55\x31\x1a\x5a\x4b\xd4\x6a\xe0\x9a\xd5\x09\x5a\xe3\xb8\xde\x7e\xfb\x0d\xfa\xcc\xa5\x9b\xb4\x49\xf9\xfc\x31\x00\x05\x7d\xc3\xd8\x53\xa7\x14\x58\xf2\x4e\x6b\xa6\x47\x48\x96\xbc\x1d\x8e\x34\x6d\x73\x13\xfe\xe7\xa6\xf9\x87\x2d\x14\x70\x4f\xd9\x98\x07\x47\x09\x17\xdc\xd4\xda\x70\x67\xe0\xa2\x06\xc3\xaa\xa9\x22\x70\x62\x66\x37\xfa\x6b\xf2\xc5\x46\x06\xa5\x56\x35\x14\x41\x62\xa9\x7b\xd3\xe7\xe7\x9e\xa2\xbc\xf7\x37\x55\x1f\x48\x82\xba\x70\x35\x99\xbb\x74\x51\xb0\x18\x0c\xb8\xc9\x52\x38\x00\x1f\xa5\x33\x63\x85\x78\xe0\x3f\x0c\x98\x73\xe1\x4d\x97\x1c\x38\x35\x93\x08\x2b\xd5\xf8\xb3\x13\x25\xd0\xca\x92\xfc\x66\x1b\x89\xed\x02\xd0\x73\xd0\xcf\x86\xbf\x90\xeb\x9d\x77\x4b\x65\xff\xc9\x60\xee\x90\x92\xb1\x65\x12\xdf\x5f\xbb\xce\x9d\x88\x36\x7a\xd1\x2a\x36\x5c\xb3\x6b\x27\x47\x25\xf1\xd5\x6b\x51\xed\x9d\x99\x4f\x6d\xc2\xc6\x9f\xd9\x59\xe2\x77\xcf\xa4\x09\x07\x41\xec\x08\xc7\x20\x7b\xbd\x29\xcb\xd8\xa4\x2c\xca\x72\xdc\x9d\x17\x64\xca\xed\x16\xe9\x64\x3c\x0d\x06\x4b\x9c\xb1\x63\xc4\x84\xb9\xd4\x0d\x9e\x28\xfb\xe4\x59\x68\x4d\x30\x8b\x78\x25\x45\xf3\x04\x8b\x56\x4f\x7a\x72\xcf\xa0\x59\xc9\xa6\x28\x27\xb5\x82\xcf\x57\xd9\x06\xbd\x03\x10\x2b\xb2\x1a\x0e\xfa\x90\xaa\x6f\x35\xfa\xcc\x9c\x09\xd8\x8c\x14\x18\xe6\xa0\xeb\xd0\x24\xc7\x4c\x68\xc8\xbc\xc0\x99\xad\x9e\xdd\x7b\x62\x6f\xc8\x8f\x44\x75\xb1\xcf\x3e\x68\xcc\x25\xef\xd1\xd6\x12\xf4\x48\xfe\xf3\xbf\x3a\x5c\x29\x7b\xfd\xd3\xd1\x83\xf0\xc0\xd6\x77\x45\x14\x0b\x6b\x21\x6f\xc4\x86\x18\x0c\xbb\xc3\x82\x88\xcc\x38\x20\xe9\x10\x1d\x56\xcd\x97\x83\x88\x73\x60\xd1\xdb\xb5\x05\x30\x39\x1b\x26\x40\x84\xe8\xc8\x82\x0a\xfe\xcf\x47\x49\x83\x70\x32\x4a\x17\xb2\x46\xdc\xd7\x80\x78\x7e\x83\x0a\x3c\x61\xef\x32\x54\xb9\x98\xcb\x55\x2b\x3e\x0f\x17\x9e\xc3\x20\x16\x8e\x48\x03\x85\x7b\x65\xc5\x3a\x6f\xc5\xc9\x0f\x1d\xa1\x60\x51\xec\x17\x85\x0e\xb4\x12\xd5\x58\xc0\xcb\x00\x57\x98\xcf\xf4\x1c\xf8\xdb\x2a\x5b\x2f\xf5\xe5\xee\xba\xfd\x30\x30\xd5\x69\x14\x3d\x38\xc8\x55\x7f\x14\x60\xcc\x25\x02\xc4\x3b\x71\xb9\xac\x4b\x6e\x04\x9e\xb8\x27\x1d\xa9\x0e\x82\x43\x85\x82\xab\x02\x0a\xf9\xba\xc0\x4b\x82\xbc\x37\x98\x1d\x3f\x07\x26\x36\xa5\xa8\x55\x92\xe7\x5b\x22\x40\x48\x97\x82\x98\x23\x0d\xc8\xb5\xa5\x30\x4a\xa4\xa1\x51\xbf\x50\x4d\xaf\x18\x0a\x04\x1c\x9e\x91\xa1\x4d\x31\xdf\xb9\x75\x29\x30\x21\x4e\x91\xca\x39\xbe\x5c\x30\x4d\x60\x5c\xdc\x6c\xcc\x4d\xdd\x4f\xd4\x16\xe9\x25\x9c\xb2\x8f\x65\xe4\xad\x07\x40\x0f\xa5\x86\xbf\x92\x20\x33\x86\x33\x8c\xc9\x1b\x78\xa7\xb2\x9c\x90\x4e\x77\x27\xf1\x89\x2c\x07\x30\x6d\x17\x4e\x82\x95\xc7\x45\xe7\x28\x2c\xaa\x32\xe3\xbb\xc1\xaf\x12\xda\x58\xeb\x0b\xf3\x83\x34\x9e\x9f\xa7\x45\x8a\x14\x88\x1f\xe6\x63\xb3\xcd\xd3\x8b\x6b\x94\xc4\x0e\x65\x51\x07\x47\x86\x86\xf2\x7d\x56\xd7\x5e\xd1\xd2\xd5\x8d\x8b\x4b\xd2\xdc\xd3\xb6\xca\xfd\x8c\x12\x6f\x4c\x42\x0f\x19\x90\xb6\x67\xf5\x05\xf5\xd7\x1d\xbf\xc0\x87\x1a\x37\xb5\xcd\x2a\x74\x5c\x32\xf9\x30\x42\x48\x22\xa0\xb0\x58\x5a\x7b\x30\xa6\x3d\x23\x55\x30\x74\xdc\xb5\x33\x2e\x4e\x15\x8f\xc6\xd8\x5c\x93\xef\x07\x7e\x5d\xb5\x9b\x73\xd7\xb0\xd6\x09\x3f\xc0\xc3\xee\x55\x71\x30\x27\xfa\x39\xc8\x6c\x18\xfa\xac\x02\x21\xb9\x10\x10\xd7\x26\x2f\x34\x3f\xa6\x84\xe9\x69\x51\x5f\xe1\xad\xa7\xb5\xe8\x84\x07\x87\xec\xbc\x9f\x11\xaf\x37\x4b\x35\x1c\xbd\x2a\xcc\x01\xf3\x32\x2c\xe9\x81\xc4\xbc\x26\xea\x0d\xa0\x54\x4c\xeb\x4a\xe3\xab\xbc\x5c\x5f\xf8\xe0\x30\x54\x29\x96\x45\x28\xfa\xa2\xf1\x22\x62\xa8\x59\x56\xa1\xb7\x23\xad\x30\x3c\x9e\x5b\xe3\x38\x0b\x21\x1e\xc1\xc1\xc7\xd0\x85\x65\x35\x8e\xa3\x32\x56\x8e\xed\x22\x8c\x65\x14\xb2\x03\x7b\x79\x78\x72\x72\xee\xca\x93\xd5\x35\x4a\x7b\x8f\xcc\x2a\xc5\xd8\x2d\xca\x09\x68\xb0\xe4\x06\xf1\x25\x7a\x53\x95\x1f\xae\xc5\xb4\x27\xbb\x8a\x3c\x52\x98\xe8\x37\x3b\x58\xf4\xf9\x2e\xa0\x31\x35\xb4\xad\x7f\x8f\xf1\x69\xaa\x7b\x3e\x7b\x72\xfa\xe9\x69\x68\x90\x97\x00\xb6\x03\xce\x10\x09\xb0\x9f\x3c\x79\xfa\x29\xd0\x21\x06\x37\x5c\xf6\x6b\x0d\x5b\xe7\xed\x5c\xf9\x7b\x1d\xf8\x40\x18\x61\x08\x6d\xfa\xde\x87\x83\x15\x32\x46\xd5\x12\x9e\xd5\xb6\x4e\x7f\x6a\x24\x58\x03\x8c\xd5\x59\xa8\xef\x8b\x75\x1a\x88\xa6\x9b\xc8\x35\x41\x4e\xb5\xde\xa1\x92\x14\x9f\x06\x78\xbe\xd1\xc7\x9b\x4c\x05\x80\x4a\x69\xec\x4f\xf6\x11\xf1\xd1\x3c\x8c\x8d\x8a\xed\x89\x99\xd6\x5b\xa2\x6a\x4a\x35\x98\x7b\x57\x77\x16\x83\x78\x5a\xd5\x65\x88\x0c\x0b\x7d\x02\xb6\x9f\xc2\xee\x8d\xef\x7f\x4c\x1b\x5b\xfc\x0c\x8f\x03\x6e\x13\x6d\x53\x75\x7f\x9b\xf4\xb3\xb7\x85\xa1\x60\x42\x8f\x49\x60\x14\x23\x85\x93\x00\x41\x58\x47\xfa\x9d\x40\x80\xdb\x37\x6d\x0f\xf9\xae\x22\x67\x4f\x0f\xbf\x8a\xb7\x48\x11\xa7\xac\x97\x96\x62\xeb\x95\x98\x3e\xbf\xe4\x6f\x31\x1e\x50\xb3\x40\x10\x86\xf2\xbb\x8e\xcf\x93\x38\x4f\x75\xd2\x03\xd0\xa1\xd1\x3e\xe0\x7d\xc9\xb3\x0b\x52\x7a\x7a\x15\x10\x74\xa0\x1f\x83\x10\x75\xf3\xd1\x16\x21\x62\x11\x65\xa2\x40\xa9\xc5\x34\x37\xb5\x23\x00\xee\x17\xc9\x73\x8a\x0d\xc5\x97\x22\x5a\xe2\xd7\x2f\x54\x72\x6c\x30\x3a\x6c\xf6\xee\x07\x66\xe6\x5f\x61\xc8\x83\xd3\xfb\xfd\x75\x81\x81\xff\x1b\x47\x0c\xdf\x8c\x31\xff\xcb\xb2\xc4\xd7\x81\xb3\x6e\x00\xaa\x12\x61\x37\xbe\xa1\x47\xc6\x45\x2e\x47\x85\xae\xbe\x9c\x1a\x7e\x78\x0e\x9d\xda\x15\xde\xb2\xc7\x7b\xc9\x17\x72\xce\xe9\x42\x0c\xec\x1f\x21\xfc\xe0\xa1\x39\x51\xf9\x41\x01\x2f\x5c\x69\x0d\xe4\x81\x94\x9c\x93\x72\x38\x88\xc9\x40\xc6\xd4\x83\xa8\xc7\x92\x0a\x10\xa4\x96\xd9\x26\x8a\xed\x97\x5f\x6b\xb7\x86\x5b\xdc\xd5\xc5\xb3\xf4\xca\xae\x7b\xb6\xd2\x18\x43\xbf\xc6\x60\x86\x7c\xc3\x9e\x5d\x30\xc6\x06\x6d\x3e\x69\x6e\xee\x63\xda\x4d\xf3\x26\x48\x64\xbb\x4c\x82\xca\x40\xd6\x49\x59\x72\x8c\x29\xc8\x6b\x3b\x15\xfc\x1d\xf6\x76\xe0\x85\xab\x29\xbd\x8b\xae\x66\x32\xe7\x66\xc4\x81\x91\x5a\x07\xbd\x14\x90\x89\xa3\x70\xfe\x08\x67\x63\xf4\x0e\xcc\xa4\x38\x44\xc7\x72\xdf\x9d\x2e\xb2\xdc\xeb\xca\xd0\x48\x7f\xef\x9e\xa4\x98\x38\x1b\xd1\xf9\xb3\x5e\xe4\xcb\xac\xf9\xaa\x5d\x89\xd7\x30\x1a\xa6\x2b\x97\x83\x60\xed\xec\xc5\xf1\xfa\x6b\xf1\xeb\xcd\x8a\x01\x87\x51\xcf\xef\x91\xd3\x44\xdf\x99\x9f\xde\x4a\x78\xfb\xe0\x9e\x90\x26\x21\x98\xca\x33\x19\xa8\x7e\xa4\x98\x7f\x79\x2b\x51\x87\x42\x9e\x48\xca\x10\xd1\x9a\x3b\x6c\xba\xec\x00\x45\x08\x90\x41\x4a\x7a\x6c\x90\xfd\x97\xd1\x19\xb1\xa8\xa3\x57\xa8\x69\x53\xf2\x09\x1e\xbe\x52\xa3\xc7\xcf\x60\x5d\xda\xe3\x05\x63\x3c\xa3\xed\xe8\xea\x03\x7b\x18\xb1\x94\xbe\xa1\x19\x95\x93\x87\x6a\x4f\xf3\x4e\x06\xe8\x18\xec\x92\x3f\xa6\xc9\x0e\xde\xd0\x3f\xb1\x47\xc2\x67\xcc\x8a\xf0\x89\x10\x69\xfd\xe3\xe3\xf4\x33\x32\x35\x03\x9d\xd8\xd8\xd1\xbe\x51\x3f\x4a\x12\x84\xd0\xb3\xb7\x5c\x63\xb8\x94\xe9\xaa\x2c\x4a\x83\x22\x3e\xe7\x46\x3e\xfd\x5b\x06\x1f\x72\x95\x6c\x86\xdc\xba\xbd\x4d\x57\x23\xaa\x58\xda\x3e\x41\xcb\x09\x3b\x3f\xb8\xa6\x3d\xb0\x0c\x47\x0e\x6c\xe6\xb2\x18\x79\xd6\x61\x9c\x9d\xbd\x9b\xde\x91\xb4\xe9\x5a\x02\x5f\xd1\x0e\x68\xb9\xa1\x63\xbc\x31\x12\xac\xe4\x22\x8e\xca\x02\xcd\x36\x00\x29\x34\x4c\x74\x43\xa7\x69\x0b\xe2\xf9\x5f\xc8\xcf\x41\x10\x17\xb3\xff\x23\xe6\xb1\x5a\x02\x48\x99\x6d\xe1\x91\xd4\x2d\x43\xa2\x7e\x00\x0c\x9f\xa3\x3d\x85\xd0\x72\x1e\x84\x98\xa1\xf4\xdc\x12\x83\x8a\xb4\x4c\x9c\x40\xd1\xc3\x0f\x9e\x20\x65\x73\x28\x2b\x4f\xc6\x71\x87\xd4\x8a\xa9\x41\x5b\xe0\x1f\x0b\x8b\x79\xea\x7b\xec\x8d\xae\x11\x05\x55\x0e\x94\x10\x9d\xaf\xfc\x65\xf7\xe6\x1e\x0e\x88\x96\x22\xc3\x9f\x77\x19\x3b\xf9\x6f\x50\xbd\xdf\x88\xaf\xbd\xf9\xa3\xe3\x36\x52\xd2\x9d\x31\x9f\x0d\xad\x48\x61\xfa\xf4\x77\x27\xa8\x9a\x4d\xbe\xfa\xea\xec\xf5\x6b\x53\xe0\x0c\x87\xad\xeb\xb1\x3e\x43\xb9\xe8\x04\x37\x8b\x0b\x20\xc2\x48\x66\x00\x5c\x34\x32\x2f\x6d\x1e\x8a\xd7\xd8\x26\x6d\x62\x4e\x8c\x75\xbf\xb3\x1b\x1c\xb6\x03\x43\x04\x4d\xe2\x75\xd0\x29\xa0\x5f\x55\x08\x72\xd6\x7d\xf7\xb0\x71\xe7\x7c\xe9\xa7\x2e\xf9\xf4\x87\x68\xe2\xc7\x96\x81\x1a\x1a\x01\x25\xbd\x58\xaa\xc3\xdb\xb2\xf0\xd0\x36\xba\x50\x7e\xbe\x18\xc4\x6a\xd7\xd8\x84\x11\x85\xde\xbc\x19\x7b\xf8\x75\x17\xff\x8f\xf4\xf1\xb3\x3d\xcf\xbe\x82\xc7\x15\x75\x99\xf7\x13\x72\xcf\x85\x41\xf3\x9c\x01\x0d\xf3\x04\x7e\x33\xc8\x06\x11\xc1\xf7\x7e\x36\xaa\x62\xf7\x4f\x9c\x18\x2c\x61\xd8\xaf\xf1\x3d\xc1\xa3\xba\x4f\xe4\x8c\x30\xcf\x1e\x53\xc1\x3f\x75\xf7\xf5\xa8\x82\xfd\x89\x1e\xae\xe0\xcd\xbf\xf0\x8f\x9d\x3f\x0e\x99\x93\x03\xf9\xe1\x06\x16\x6d\xd9\xd6\x1e\xb9\xd9\x88\xcd\xc7\xa4\x31\x5a\x34\x16\x9e\x09\xde\xce\xc2\xcc\x09\x92\x4a\x6c\x20\x1c\x52\x31\x85\x17\xa1\x5e\x10\x6a\x3b\xb0\xd3\x7b\xe5\x8a\x73\x38\x00\xf4\xd6\xc5\x37\x50\xa6\xf1\xf1\xaa\x6c\x0b\xb0\x63\xf7\xd6\xac\x67\x46\xbb\xcd\x3b\xaf\x51\xba\x59\x35\xf1\x80\x7d\x07\x69\xf2\x29\x5f\xff\xaa\x54\x52\x3f\x93\xae\x23\xbc\x76\xff\x7b\x98\x48\xbb\x5c\x8a\xa4\x06\x4b\x22\xe2\x25\x61\x4f\xfe\xf8\xee\x13\xd7\xbf\xf7\x18\x2a\x87\x4f\xd2\x76\xe8\x76\x79\xef\xde\x25\x3c\xac\x1a\x97\x3a\x7e\x9d\x1b\x76\x1f\xef\x60\x83\xc9\x22\x1c\x7d\x82\xb2\x0c\xd2\xb4\x4b\x27\x10\x69\x0f\x40\xbc\x2c\x0f\x9d\x10\x77\xfc\x6a\x52\x4a\x40\x02\x02\xdd\x82\xea\xaa\xbb\x01\x43\x7c\xe0\x74\xda\xe2\x02\x60\x6f\x10\xbd\xbc\x7c\x70\x64\xa2\x94\x19\xf8\xa1\x1b\x8a\x9b\x0d\x42\xbb\xe7\x1a\xe3\xe1\x03\xb3\x2d\x59\xd4\x21\x23\x15\x82\x31\x05\xea\x82\x80\x72\x94\x1b\x40\xdb\xd3\x38\x2f\x03\x80\xb0\xd5\xc0\x9d\xd0\x13\xd7\xe7\x6b\x18\x03\x16\x6e\x97\x53\xd2\x2d\xe0\xdd\xa0\x56\xca\x30\x98\xad\x25\x70\x89\x35\xed\x02\xf5\xa2\x47\x35\x00\x0e\xf5\xc0\x31\x86\xb2\x3b\xfc\xef\x51\x55\xc2\xba\x25\x26\x96\x22\x5c\xfe\xfe\x40\x27\x10\xf8\x78\x0e\x3a\x0b\x4b\xae\x12\x02\x89\x04\xab\x78\xde\x32\x00\xdb\xac\xe3\xfc\x8a\x1d\x68\x1e\xef\xfa\x6b\x24\x96\xbf\x11\xe2\xd1\x7d\x89\xdd\x89\xf1\x9e\x98\xca\x7e\x40\xa6\xd0\x2f\x1d\x37\x0e\x8e\x4d\xaf\x29\x81\x1a\x8a\x00\x12\xd2\xe7\x0a\x0b\xb2\x89\x1c\x82\x3f\xe7\x84\x5c\x57\x19\xe5\x44\x0b\x3e\xc8\x74\xac\x26\xe0\xf3\xc9\xf6\x00\x4a\x4d\x6e\xc8\xd6\x49\x96\xd9\xc9\xf6\x97\x3c\x2c\xab\x39\xba\x7e\x4a\xdc\xbf\xd8\x00\x6a\x49\x1a\x16\xe8\x5c\x29\x70\x80\x4d\x91\x8f\x34\xdc\x63\xd5\x75\x5b\xfa\x2b\x19\x86\xd0\xd1\x39\xfb\x80\x29\xe4\x84\x7f\xb6\x5d\x93\xf0\x4a\x7a\x14\x64\x81\x5e\xe2\x00\xc4\x91\xc5\x2d\x14\x12\xb4\x83\x0c\x05\x3f\x18\x55\x58\x0a\xfc\x27\xbc\xf4\x98\x39\xe2\x1e\xa9\x92\xcb\x8a\x94\x77\x43\xe2\x1b\xfa\xb3\x0e\xe9\xbf\x69\x55\x6f\xb9\xf3\x17\xd8\x59\x6e\x1e\xf9\x4d\xec\xd3\x8a\x95\x27\x82\xa3\x32\x89\x21\xe5\xdc\x67\xc4\xd4\x48\x55\x89\x1a\xb7\xc8\x6e\x22\xa9\xac\x12\x21\x80\x47\xd3\x47\x4e\xcf\x6e\xd3\xe5\xb0\x48\x61\xeb\xc3\xb0\x86\xfd\x10\x9e\x83\x14\x70\x5e\x56\x92\x86\xb1\x76\xe7\x74\xf8\x8a\xd1\x2c\x21\xa9\x5a\xe4\x2a\xbb\xc8\x16\xb2\x89\x45\xfa\x33\x5c\xf1\xf4\x70\x78\x7c\xf5\x18\xaf\x86\x05\x25\x27\x6b\x1b\xd1\x76\xae\xba\x4c\xe9\x8b\x17\xb5\x76\xf9\xf6\x00\xa4\xa5\xc4\x3f\xe8\xe1\x4e\x49\x5d\x22\x7f\x56\xf4\x3b\xb0\x32\xfc\x8f\x43\xe5\x2e\x33\x77\x25\x09\x71\xe8\x89\x59\x02\x47\x0b\x9c\x48\xb6\x96\x90\x5a\x3f\x6d\x18\x6c\x62\x53\x86\xbf\xf1\xf8\xe1\x2f\x3c\xd1\x40\x4e\x2b\x4a\xfd\x14\x1e\x2f\xd9\x5f\x34\xbd\x27\xa5\x65\xe4\xc0\x6c\x4b\xf7\x93\x02\xfb\x53\x55\x65\xe5\xf3\x97\x71\x92\x28\x05\x62\x17\x7e\x94\xd7\x0c\x28\x5d\x06\x2f\x7f\xef\x96\x5b\xfa\x14\x6d\x40\x00\x88\x3c\xfc\x4d\x9d\x2e\x44\x2b\x08\x0f\xe2\x97\xcb\x9b\xf6\x63\x9f\x43\x24\x0e\x5e\x5b\x2e\xad\x43\x72\x60\x22\x1f\xdb\x48\x42\x29\x41\x95\xab\x3a\x47\xda\x78\xb9\x69\xc8\x35\xed\x8d\xad\x9f\xad\xe3\xd4\x69\xcf\x0b\x4d\x07\xf2\x6c\xf0\xab\x53\x48\xc6\x07\x9b\x1f\xde\x11\x76\x15\x82\x3e\xc8\x19\x30\x7f\xea\x9f\x6b\xaf\xc8\x26\xbe\x01\x31\xd2\x43\x0e\x68\x05\x89\xac\xdb\xb4\x92\xec\x10\xba\x41\x9f\x58\x04\xbb\x45\x91\xa1\xf6\x4e\x31\x5f\x6d\xa3\xfd\x63\xdf\x28\x9d\x66\x29\x29\x3f\xf1\xf9\xb0\xc7\x86\xcf\x39\x78\xad\x18\x1b\x59\x69\x10\xb3\x5a\xa8\xd3\xbb\x62\x97\x47\xc5\x01\x75\xd3\x13\x1d\xc3\x6c\x74\xce\x65\x5b\x18\xcf\x3f\x65\x7e\x7c\xd5\x0a\x55\x5e\x40\x83\x6b\xd7\xdc\x6d\xfe\xa6\x2c\x97\x70\x46\x4b\x87\xb7\x28\x7a\x38\x07\xb6\xa8\xb3\xa3\xe4\x50\x96\xe1\xd9\x7a\x1c\x58\x50\xca\x85\x4c\x42\x5e\x6d\x05\xb8\x60\x59\xec\x4d\x60\xe8\x2f\x83\x76\x94\xe6\xec\xfe\x78\xcc\xce\xb8\x61\x8f\x17\x50\x88\x11\x1f\x90\xaa\x0a\x27\x8c\x2d\x27\x4c\x1e\x0a\x5c\xb2\xa1\x4d\x65\x39\x12\x61\xa4\x14\x80\x44\xaf\xac\xa1\x79\x36\xad\x33\xfe\x56\x35\x98\x80\xe4\x87\xb6\xf1\xa1\x14\xa8\x28\x20\x07\x0e\x2f\x4f\xeb\x13\xc3\x1a\x37\x7c\xe6\x53\x51\x7e\x51\xc6\x6a\x51\xca\x93\x96\xf8\x5a\x14\xb1\x42\xbe\x13\xf7\x01\x88\x7c\x8e\xea\xc2\xb4\xe9\x04\x10\xf3\xdb\x45\x8c\x83\x9a\x9f\x30\x74\x52\x13\x08\x69\xf6\xbc\xe7\xec\xad\x37\x3b\xb4\xf0\x86\xe1\x65\x82\xb7\x2c\x9d\x91\x02\x6e\x06\x0f\xc2\xcc\x5a\x28\x55\x36\xaf\x56\xe5\xfe\xd9\x08\xa5\xca\x40\xa3\x68\xfb\xb2\x40\xfd\x64\xac\xf8\x90\x1f\xcf\x78\x6c\x23\x66\x38\x37\xcb\x87\x35\x72\x47\xd0\xeb\xd9\xab\xb7\xcf\x64\xe3\xd1\x68\x0c\x4e\x71\xe8\xb0\xd4\x5c\xfc\x71\xc9\xed\xcf\x30\x30\x9f\x32\x27\x44\xfe\x47\x7b\xa2\x19\xaa\xc0\x58\xb5\xe8\x82\xc3\x39\x59\x91\xa9\xba\x4a\x2d\x46\xcd\xa4\x20\x0f\x1c\x78\xf2\x11\x34\x05\xaa\x87\x72\x01\xce\x0e\xf8\x72\x4b\xa2\x48\x2d\x64\x50\x72\x61\xcb\x81\xa5\xcf\x5d\x2f\x7a\x0c\x03\xdd\xcb\xba\x46\x3f\x2c\x6f\x02\x32\x39\x9e\x0d\xf0\xc0\xf8\xfd\xad\x05\x79\x25\xbf\x96\x00\x54\x94\x5a\x4c\xd5\x96\x93\x3d\xa3\x54\x9f\x66\xce\xeb\x18\xc6\x50\xa0\xa3\x94\x65\x20\xf4\xc9\x12\xd1\x44\xfd\xea\xd9\x37\x2a\x23\xc5\xd1\x32\xbc\x19\xc2\x17\x58\x7a\x5a\x61\x8e\xb9\x03\xac\xc8\x49\xee\x4e\xdd\x18\x3e\x30\x4a\x20\xd6\xe5\x81\xfc\x6f\x48\x74\xa1\x53\x47\x73\x79\x22\x89\xcc\x72\x12\xc9\xd5\x57\xa5\x63\xb2\x79\x4e\xa8\x24\xf9\x7d\x1c\x0c\xbd\x6e\x62\x7d\x6d\x6c\x5d\xc4\x64\x4e\xc5\x1a\x15\xdd\x72\x00\x70\xad\xce\x29\xbd\x86\xcf\xcd\xe7\x00\x64\xec\x23\x5c\x51\x12\x21\xd6\xa8\x92\x3b\x82\xc5\xd5\xc4\x39\x2e\x1b\xce\x15\x88\xb1\x02\xa4\xcf\xa3\x17\x98\x86\x85\xe9\xd1\xc5\x89\x7c\x49\x34\xae\xc1\x4c\x72\x29\x5a\x10\x3c\x96\x53\x07\x6c\xef\x33\xc3\x04\xa9\x99\xc9\xe8\xaf\x49\x28\x17\x6c\x71\x03\x32\x51\x01\x4a\x9c\xe0\xa0\x2b\xe4\x6f\x60\x59\x92\x80\x57\xbc\x97\x55\xc3\x4f\x5b\x5a\xae\xc9\x6b\x2b\xce\x67\x62\x82\x3d\x5c\x4a\x64\xf3\x44\x34\x25\x86\xd6\x6f\x41\x1d\xf3\x36\x6e\x99\x93\xd6\xe6\x2c\xf9\xc3\xb8\x6e\x29\x0d\x7a\xaa\x0f\xaf\x29\xac\x8c\xcc\x01\x96\x19\x5c\xc2\xf1\xb3\xad\x63\xa5\x26\x2a\x7c\xee\xfd\xad\x2d\x9b\xd4\x0e\xe7\x65\x0d\x9f\x08\x90\x3e\xa3\x5b\xcf\x78\x88\x89\xa1\x6b\xef\x78\x0d\xd7\x11\x61\x83\x59\xdd\x30\x7d\x97\x38\x95\xc3\xa8\x78\x49\x08\x2d\xa1\x51\xb6\x29\x50\x38\xb6\xd8\xb0\x35\xfa\x8e\x5b\xf6\x33\xf1\x4e\x5a\x63\x4e\xb8\x27\xa7\xa7\x32\x83\xf7\xc1\x21\x3f\x4c\xf9\x4c\x1f\xf1\xbe\xe7\x2a\x18\x5d\x11\xb1\x3f\x2f\xed\xaa\x29\xb9\x63\x87\x0d\xe6\xa2\xb6\xa4\xee\x1c\x56\xa3\x51\x3b\x53\xb7\x8a\xc9\x71\xb9\x81\x67\xe5\x7a\x49\x4b\x41\x9d\xe8\xe9\x90\xf2\x95\x17\x4a\x91\x18\x94\x17\x10\x71\xfa\x63\x4b\x65\xba\x48\xbe\xc5\x77\x91\x13\xed\x71\x53\x8c\xd9\xc6\xd4\x13\x70\xff\x4e\x2c\x5d\x16\x6d\xaf\x2b\x30\x04\x45\x06\x48\xfe\x44\x77\xdf\x38\xe3\x19\x1c\xfb\x75\x89\xae\x9e\x8d\xf8\xac\x50\x3a\xe9\x39\xfb\x8d\x88\xbb\xa3\x5c\x4b\x0c\xf5\x94\xd9\x96\x78\x2a\x15\xc6\xbf\x3e\xa5\x2d\x61\x9d\x87\x5e\xf8\x20\xce\xf8\xd5\xbb\x77\x6f\xd8\x11\xa7\x66\x74\xdf\x50\x98\x97\x31\x74\xde\x6d\xe3\x53\x74\xdb\x58\xdc\x94\x41\x16\x86\x51\xba\xf2\xe5\xcb\x77\xc9\x63\xcd\x12\xe8\xbd\xd5\x29\xd9\xb6\xfc\x48\x1e\x91\x81\xe7\xd2\x40\xfa\x1b\xf4\x9f\xce\x01\x08\x9a\x34\xa5\x26\xa7\xe2\x79\x90\x8e\x0b\x91\x81\x9e\x1e\x75\x07\xbf\x62\x9f\x0e\x49\xac\x93\x56\xde\x50\x9f\x31\xf7\x16\xc4\xfa\x89\xd9\x1f\xa3\x4d\xd4\x5a\x22\x17\x5f\x02\x2d\xd4\x71\xdb\x47\x50\x72\xea\xd9\x4b\xef\x7b\x70\x60\x63\xe2\x96\x72\xb1\x5c\x82\x2c\x7a\xc0\xb3\x34\x5b\x9d\xbe\xf4\xe2\x29\x00\xc8\x22\x09\xfc\xb6\xd9\x07\x76\x7e\x0b\xbc\xe0\x49\x95\xe0\x53\x7e\x50\x8a\x8b\xac\x30\x4c\x21\x2e\x85\x7d\x46\x71\x38\xf5\x0e\xab\x2d\x54\x45\x32\xa7\xeb\xc8\xe8\x95\x59\x6d\xd4\xfd\x28\x0b\xa6\x9a\xdb\x7a\x94\x2c\x6b\x1c\x20\x9b\x34\x59\xa3\x12\x54\x16\x30\xf7\x67\x99\x8b\x42\xb3\x03\x69\x69\xd3\xee\xf7\x61\xfe\x57\x4d\x4f\x3f\xa8\x16\x0e\x02\x6c\xc6\x95\xc3\xba\x8b\x65\xe8\xc6\x6e\xfc\xc3\xd7\x3e\xe2\x9e\xe1\x42\xc9\x90\xa5\xcb\x9c\x3d\x3a\x01\x22\xb9\xf7\xef\x16\xad\x11\x42\x44\xde\x02\x0f\x3f\x90\x96\xb5\xac\x02\x8f\xa0\xc9\xb1\x74\xea\x45\x0c\x2f\xcd\x81\xa8\x58\x6b\x80\xf6\x2b\xd0\x4c\xa7\x9a\xa6\x2b\xc8\x8d\x4f\xc4\xce\xde\x94\x35\xc5\xb7\xc6\xe9\xd3\x7a\x8a\xf9\x30\x18\x3e\x4c\x8d\x88\xc9\xd4\x3c\x5a\xa8\xce\x14\x8e\x62\x49\x47\x11\xa7\xec\xb5\xc0\xb7\x14\x68\x61\x96\x37\x27\x98\xa5\x9c\x51\x96\xce\x47\x43\x52\x3c\x68\x53\xe5\x80\x95\x37\x7d\x95\x15\xc8\x25\x5c\x1f\x68\x4d\x02\x34\x4c\x32\x9d\x15\x69\x1e\x1c\x6b\xa8\xa3\x41\x4c\xa6\x54\x4a\x9d\x80\x5a\x49\xdd\x35\x7b\xc1\x4b\x70\xa8\x33\xf1\x3e\x00\x7b\x93\x4a\x2b\x8a\x6e\x29\x1a\xc5\xca\xcc\x14\x77\xde\x81\x3d\xab\xd7\x69\x45\xfe\xeb\x28\x07\x05\x43\xd2\x76\xc9\xc5\x60\xc1\x05\x51\x28\x67\xef\xb5\x30\x0d\x96\x83\x59\xc8\x27\x20\x8a\x04\x2c\x79\xb3\x26\x69\x52\xc6\xbd\xfe\xeb\xeb\x02\x3d\x5d\x30\xae\x25\x45\xed\x17\x9a\x76\x30\x28\xa5\x39\xa1\x44\x9c\xdd\x24\x0b\xfd\x8c\x4e\xdd\x94\x15\x7a\xdb\xc9\xfa\x0d\x04\xa4\x6e\xae\xd1\x97\x6d\xf6\x9f\x88\x0f\xff\x35\x63\x47\xb0\xee\xf5\xfb\xeb\xb3\x1f\xa4\x7c\x4b\x49\x31\x16\x6c\xc3\x9e\xfd\x67\xe3\x3e\x34\xd0\xc7\x2b\x35\x24\xee\xa2\x3e\xb8\xf4\x42\xa7\xe2\x34\x39\x8e\x7e\x4b\x4e\xae\x12\x9e\x29\xd1\xce\xc8\x5a\x1f\xb2\x75\xf9\xf4\x0a\xe9\x7e\xef\xfb\xe8\x83\xc0\x80\xeb\xc6\x22\x04\x37\x18\x3e\x6f\xda\xb5\xcf\x54\xaa\x2f\xab\xc4\xc6\x4b\x9e\xad\x35\xfa\x5d\x14\xe3\xf9\x00\x11\xd6\x08\x6a\x99\xe2\xf3\x30\x51\x5b\xe7\x5e\xbd\xa3\xdd\x8b\x42\x91\xcf\xaa\xab\xe4\xc0\x21\x51\x87\x21\x56\x61\xd2\x9a\xcf\xde\x71\xec\x33\xf0\x96\xa8\xde\xc5\xc9\x88\xce\x3e\xa8\xef\x53\x81\x21\x60\x9d\x5b\x40\xd5\xb3\x7e\x30\x0f\x5a\x87\x52\x91\xf0\xaa\xb4\xa8\x73\x2e\x24\xa1\x64\xc3\x70\x9c\x33\x7f\xaa\x76\x11\x07\x34\x21\x8d\xbd\xea\x82\xde\xe4\x01\xa1\x45\x6f\x9e\xbd\x7e\xc5\xe7\xce\x77\x49\x99\xc2\x3a\xd1\x45\x31\xf3\xe8\xd5\x33\x40\x24\xb0\xec\xd3\xec\x91\x4f\x7a\xe1\x53\x6e\x91\x3f\x17\xc6\x01\xd1\xaf\xec\xbe\xe1\x82\x50\x51\xd9\x4e\xed\xeb\x03\xd9\x0e\xb2\xc6\xd6\x88\x9a\xa3\x67\xa1\x9a\x5d\x6f\x01\x1c\x6b\xee\x2d\x35\x12\x56\x21\x99\xaf\x34\x63\x9b\x8d\x57\xf8\x15\xfc\x76\xd1\x4f\x1d\xcf\x2c\x05\x12\xa9\x05\xb2\x3d\xe5\x37\xf0\x91\x9b\xec\xda\x86\x2a\xff\x38\x02\x83\x75\xfd\x8f\xbc\xb7\x0b\xf7\x34\xff\x22\x10\xc3\x32\x55\xef\xa9\x03\xae\xc6\xb5\x7f\x1c\xe4\xf3\xeb\x14\x42\x5a\x28\x85\xa6\x30\x7e\xf1\x46\xc7\xf1\xba\x2f\xb5\xcc\x87\x54\x94\xb3\x37\x49\x31\x14\xae\x06\x64\x05\xc3\x78\x1e\x64\x6d\x90\xed\x61\x9f\x5d\x4a\x6c\xa9\x6f\x53\xe5\x64\x44\x4e\x1d\xca\xa3\xaa\x37\x3d\x6f\x3e\x52\x33\x87\x64\x34\xd2\x2c\x2b\x15\x8d\x7e\x24\x6d\x4c\xf4\xcb\x65\x99\xb7\x7b\xd7\xf5\x86\xb1\xcd\x28\x60\xb5\x4e\x11\x86\x89\xa9\x49\xa3\x0f\xad\xd0\x35\xa6\x37\x84\x05\x7e\x02\x96\x52\x46\x38\x49\x94\xe6\x1d\x74\xcd\x27\x57\xf6\x0b\xc4\x66\xd9\x94\x4b\x9e\xc7\xd3\x7e\xca\xe1\xaa\xe5\x5f\xce\xfa\xe1\x04\xe4\xc9\x44\x22\x3a\x09\x7a\x17\x59\xc1\xcf\x66\x80\xfd\x72\x81\x25\x47\x2e\xab\xd3\xf5\x75\x44\x6d\xb0\x17\xc0\x88\xff\x28\x31\x74\x0d\x3d\x22\xbc\x8b\xbb\x5c\x98\xd9\x19\xb5\x90\x07\x79\xdd\xc9\x92\x46\xae\xe4\x81\x5f\xbc\x78\xca\x61\xf8\x52\xcf\x6b\x4e\xed\xa2\xb5\x68\x2c\x78\x6d\x6b\x54\xee\x55\x45\x90\x44\x73\x2c\x3d\x50\x30\xcd\x95\x5b\xed\xca\xf2\x82\xa6\xa1\x70\xbf\x37\xdf\xbe\x7d\x27\x6a\x61\x1a\x16\x95\x34\x38\x91\xa4\xdd\x9c\xc9\x1a\x66\x70\x88\x2e\xdf\x78\xd2\xc0\xe3\xa0\x1d\x21\x4e\xab\x87\x61\x05\x18\x1d\x5e\x6d\x78\x2b\x39\xaa\x37\xe9\x15\xeb\xec\xe6\x05\xb7\xd2\x91\xe2\x51\xbe\xe7\x6a\x48\xfc\x44\x91\x4c\xf5\xf0\xc7\x9f\x1e\x61\xd7\x42\x4e\x90\x3e\x13\x1c\xe0\x50\xae\x3c\x15\xa1\xdf\xa2\xa4\x54\xcf\x82\xcc\xbc\x9d\xa4\x40\xaa\xf4\xa8\xd5\x3c\xde\x4f\x57\x2c\xb4\xaa\x97\x4e\x46\x4a\x11\x88\xfb\x81\xfd\xac\x37\x4c\x50\x20\x5a\x06\x2f\x21\x52\x81\x86\x61\x04\x55\x98\x72\x09\x75\xa1\x9a\x2a\x74\x38\x87\x53\x77\x4a\x45\xa0\x68\xca\xda\x62\x33\xbb\x99\x92\x26\xa4\x47\x9a\xb4\x29\xf6\x5e\xe1\xd1\x16\x23\xce\x19\x13\x06\x42\x91\x8f\xad\xe0\x08\xf0\x31\x57\x00\x34\x90\x07\xb3\x04\x1e\x1b\x6a\x3b\x9f\x38\x55\xd7\x1f\x03\xa0\xcd\x86\xef\xc5\xb0\xa9\x7c\x12\x28\x54\xf1\x4d\x9e\x97\x5e\x65\x29\xca\x10\x53\xa2\x7b\x5f\x0f\x55\xb2\x0f\x29\xdc\xc5\x69\x7d\x54\x61\x3f\x61\x45\x6f\x02\xcf\x3d\x36\x15\x31\x2e\x6b\x76\x08\x75\x1e\x32\x2f\x2a\x9f\x23\x50\xed\x66\xd8\x74\xa9\x3e\x5f\xc7\x4c\xe9\x53\x86\x1d\x39\x99\x7a\x82\x4d\x3c\xc8\xdf\x34\xf3\x16\x67\x09\x14\x2d\xfd\xd4\x15\x1c\x9b\x63\xab\x97\x03\x96\xde\x33\xa5\xda\x4b\x4d\x53\x35\x3e\x7d\x37\x27\xa8\xd1\xf4\x24\x7a\xfd\x58\x0e\x93\x5a\x0c\x12\x0f\x15\x50\xed\x90\xb3\xef\x92\x62\x3f\xb4\x92\xf2\xdb\x87\x96\x96\xcb\xde\x14\xf7\x98\x8d\xe8\x55\xfd\xe1\x9f\x17\x31\xf7\x7f\xba\xb0\xe4\x01\xaf\xca\x2b\xd4\xa5\x72\x33\x8e\x10\x0f\xd4\x66\xae\xa6\xd6\xa7\x4f\xcc\x3e\x91\x9d\xef\xc6\xda\xef\xf8\x1b\x76\xf8\x54\xdb\xff\x40\xed\x38\x7d\xaf\x64\x31\x2f\x11\x49\x29\x93\x4e\x26\x49\xf5\xc9\xc5\x15\xb9\x70\xf6\x6d\x15\x86\x28\x74\x7a\xb5\x78\x65\x94\xd5\x50\xeb\x2f\xf2\x0a\x17\xed\x54\x7f\x95\x54\xca\x2b\x48\x69\xa3\x5e\x4a\x15\x53\xd6\x27\xef\x68\x8c\x3a\xf2\x88\x05\x5e\x2d\x3d\x0f\x85\x47\x5e\x87\x5e\xa5\x6e\x84\xd2\x4b\x8e\xc7\xf2\x0c\x89\x36\x8c\x83\x8a\x01\xa5\x9e\x3e\x3d\x3b\x3d\x4d\x28\xb9\x58\xe7\xcb\xe9\xa7\xfc\xe5\x29\x7f\xb1\x11\x82\xa4\x20\xb7\x7a\xba\xca\x49\x98\xab\x2b\xe7\x0c\xb2\xfb\x1f\x9e\xbf\xfe\xba\xc4\x96\xa2\x00\x67\xee\xd5\x6b\xc0\xe9\x01\x16\xd5\xc0\xe7\xfd\x1c\xbe\x59\x2d\xda\x38\x9c\x47\xf8\x4c\x64\xd7\x8c\xc9\x67\xb5\x8e\xfb\xe0\xd6\xad\x19\x24\xae\x83\x44\x61\x83\x79\x8a\x5e\x49\xed\x27\xd6\x3e\x10\x27\xdd\xc9\x9f\x23\xdc\x29\x97\x94\x62\x67\x1f\x21\x75\xac\xbe\x50\xb9\x88\xf3\x1b\x57\xae\x6f\x4d\xb1\x88\x09\x52\xe6\xa8\x45\x0b\x79\xe4\xba\x11\x47\x42\xe4\x16\x64\xe5\x83\x8a\x10\x9a\x2a\xe2\xfd\xdf\xb6\x07\x57\x61\xe6\x35\xf2\xd8\xca\x30\xa1\x8b\x2f\x03\x5c\x35\xe6\x8b\x87\xef\x6c\x46\x09\x6f\xc8\x0f\x0f\xd8\x5d\x76\x21\xc5\x40\xf8\xca\x7c\xf3\xd1\x59\xdd\xa6\x14\x93\x9a\x2e\x94\xcd\x48\x52\x67\x96\xf4\xeb\x1c\x76\x66\xca\x7e\xa9\x4e\x0b\xa4\x79\xbb\xa5\x27\xdb\x5c\xd3\x38\x0f\xb8\x08\xd1\x2c\x77\xa1\x11\x91\xc3\x28\x2d\x83\xbc\x77\xec\xcf\x39\xdc\x4a\xc2\x79\x50\x1f\x93\xf5\x4e\x2f\xd6\x1f\xd0\xd6\x84\x55\xef\x1b\xb6\x52\xe0\x49\x15\x89\x39\x19\x95\xeb\x7a\x8b\x05\x99\x60\xae\x27\x1c\x5f\x4f\xb7\x42\x89\xc7\x71\xd9\x1c\x60\x26\x2a\x44\x1d\xc2\x62\xa5\xd0\xde\x17\xfc\xc8\x30\x9d\x0d\x16\xf9\xa0\xe3\xd2\x2c\xe8\xba\x9b\x70\x27\x5a\xc6\x89\xcf\x15\xd9\x6a\xce\xe5\xc6\xea\xd2\x4a\x92\x77\xb3\x0c\x3a\x86\x3e\xba\x80\xe0\x27\xb3\xe9\xda\xb2\x58\xd1\x91\xd1\xc1\x50\xa1\x08\xcc\x76\x41\xcb\xa8\xad\xbc\x63\x27\x20\xb9\x8e\x92\x66\xb7\x78\x49\xe5\x22\xf2\xee\x32\x8c\x27\xc9\x30\x5e\x47\x76\xa8\x65\x99\x60\x9f\xbe\x13\x61\xcf\x9c\xf9\x63\xb5\xb9\x00\x16\x11\xd7\xa8\x67\x4b\x71\x51\xa1\xbf\xea\x25\x47\x47\xa2\x20\x08\x8f\x43\xa6\xca\x49\xc3\x0a\x5f\x2f\x59\x67\xc1\x30\x2d\xf9\x53\x4a\x1e\x04\x55\x9a\x68\xcd\x12\xab\xcb\x14\xc6\x51\x14\xb9\x04\xa6\x8a\x6d\x55\xe1\x27\x60\x21\xfa\xe9\x13\x52\x16\xb0\x0a\xad\x9a\x56\xf7\x1e\x7c\xdc\xde\x6c\xe8\x47\x4b\xad\xde\xfd\x88\x00\x90\xd3\xb1\xd3\xfa\x75\x6b\x00\x10\xcc\x06\x7e\x43\xe7\xd4\xd1\xc8\x28\x19\x6c\x29\x63\xab\xaf\xca\xf7\xa1\xf7\x6f\xe0\xa6\x9a\x91\x1d\xd1\x80\x2e\xea\x03\x7e\xd1\xc8\xa3\x3f\x2d\x42\x23\x35\xda\x0b\x3d\x36\x11\x75\x8a\x2f\xf6\x0a\xd9\x24\xb3\x54\x47\xea\xf0\xb9\xa4\xfc\xa1\xf7\xd1\x50\x14\x1d\x2c\x7c\xd2\x2c\x43\x38\x71\xd1\xb8\x0e\x92\xb4\xf9\x9c\x67\x7d\xda\xa4\x96\xcc\x5e\xd6\x71\x4d\xa0\x68\x05\x77\xf9\xa2\xc0\x32\x13\x5f\xec\xc4\x6f\x81\x34\x0e\x69\xa1\x3a\x1f\x51\xae\x07\x54\x47\x43\xfe\x30\xfb\x42\x98\x57\xff\x0b\xf4\x69\x41\xc5\x7e\xcd\xe1\x67\xc5\x48\x46\xf4\x61\x4a\x49\x4e\x35\xd5\xed\xd0\xdd\xb7\x9e\x00\x47\x1e\x10\x9c\x76\xc3\x2d\xa9\x41\xfc\x88\xbe\x16\xf3\xb8\xba\xcc\xc3\x34\x00\x85\x9d\x27\x69\x0a\x0b\x66\xa3\xac\x18\x95\x2c\x89\xda\x06\x4c\x93\xf0\xd7\x94\x88\x0b\x8f\x9a\x73\x0d\xf4\x1e\x52\xf3\xec\xb5\x92\x38\x44\x0a\x78\x3b\x5c\xce\x8e\xfb\xc7\x47\x16\x1a\xa2\xe9\xcc\x64\x05\xe6\xd7\x32\xec\x66\xc1\x72\x1b\x1b\x0a\xe3\xe5\x29\xcd\xa7\x51\x82\x54\x52\x4f\x28\x7b\x97\x5d\x3c\x9f\x4c\x9f\xbd\x11\xcc\x5e\xb1\x71\x58\x74\x13\xd5\x41\xf1\xb9\xb0\x23\x47\xa4\x5a\xe9\xf0\x26\xdf\x16\xe4\x51\xe9\xbc\x8b\x43\xf2\xd0\x1e\x81\x47\x94\x89\xc8\x30\x16\x23\xf6\xb3\x0f\x70\x4d\xef\x77\x8b\x22\xd3\x07\x75\x92\xec\x2f\x26\x30\x3c\x3f\xde\xfc\x9c\xcc\xe8\x8a\xd1\x3f\xa5\xd0\xcc\x6c\xde\x31\x14\x68\x22\x3a\xef\x18\x49\xb8\x74\x5d\x34\xe9\x07\xc4\x08\xa6\xaa\xe8\x79\x03\x6f\x40\x81\xc1\xb0\x96\x29\x28\xfb\xa0\xd9\x48\x38\x58\xd9\xd4\x73\xfc\xe2\x05\xae\x1c\xf4\xe8\xf9\xac\x2a\x84\xba\x52\xaa\x1c\xee\x52\x5a\x6d\x72\x71\xa6\x5d\xc3\x63\x63\xee\x07\x3f\xfe\x64\xc7\xce\x85\xe3\x7b\x33\x8b\x75\x39\x47\xa9\x13\xe3\x3f\x15\x3c\xd1\xeb\x49\x80\xb8\x67\x76\x94\xb2\x58\xf6\xa9\x64\x51\x6a\xcd\x40\x25\x90\xef\x02\x0d\xef\x50\x49\xbb\xc0\xc5\x0e\x33\x65\x61\x96\x71\x4d\xdd\x68\x63\x3c\xe7\x0f\x94\x49\x8d\x0d\xf3\x98\x70\x49\x5a\x05\x03\x48\x46\x92\x25\x48\x1b\x2d\x2a\x3d\xc3\x45\x04\xc4\x59\x3f\xe3\x78\xd2\x25\x18\x24\x2b\x00\x91\xb3\x4d\x7f\x10\x8e\x5a\x35\xf3\x7d\x42\xcd\xf0\xff\xde\xe0\x35\xd8\x16\x17\x45\x79\x55\x2c\xb7\x79\x7a\x1e\xad\xa6\x24\x7b\x7d\xb0\x28\xbb\xd8\xee\x03\xd2\x8c\x20\xb8\xa1\x2c\x97\xe8\xb0\x6b\x0b\x0a\x60\x5b\x96\xec\xcb\x6b\x9f\xb8\x36\x1e\xb9\x2f\x65\xdd\x8c\xe6\x2d\x9e\x15\x3d\x59\xf4\xdf\xe8\x53\x61\xd5\x51\x51\xc4\x1d\xf0\xc4\xb4\x5d\x6b\x94\x42\x1a\x14\x54\xc5\xf4\x48\x64\x94\x0d\xaa\x69\xd7\x9a\x1e\x2c\xac\x37\x89\xb3\xfa\x42\xb3\x5f\x90\xb9\x09\x69\xa2\x55\xa3\x8d\x98\x2a\x3f\x01\x50\x66\xcb\xc2\xcb\x0c\x9a\xb2\x27\xbb\xd4\xbf\x16\x95\x73\x5e\x45\x4f\x0e\x93\x87\xc0\x7c\xf0\x91\xf2\x60\x67\xc9\x33\x9b\x4f\x8c\xb1\x94\x67\x3e\xf0\x6c\xc2\x94\x70\x22\x98\x04\x2b\x5a\x98\x03\xe5\x92\xc4\x15\x7e\x0f\x92\x3f\xc9\xd5\xe2\xb7\x13\x87\x19\xe8\x3b\xe7\x87\x09\x1a\xc3\x79\x49\x92\x86\x9b\xe6\x00\x92\xb4\xae\xb2\x03\x07\x1d\xbd\xf0\x7f\x48\x20\x86\x45\x00\x08\x18\x8c\x7a\x53\x85\x5f\xfd\x15\x43\xa0\x44\x32\x5c\x74\x2c\x5b\x67\xc9\x0f\x69\x95\x61\xb0\xa0\xd9\xba\x4c\x56\x52\xd3\x00\xa5\x9f\x8e\x94\xdc\x3e\x7f\xa1\x9a\x05\x83\x90\x69\x33\x0e\x5a\x4e\x20\xff\x3f\xe6\xb4\xad\x19\xbb\xcc\xe6\xf5\xdb\xb8\x77\xf7\x26\xd4\x64\x81\x58\x89\xba\xc9\x9a\xd6\x7c\xea\xab\x96\x8a\x86\x24\x98\x40\x47\xca\x6d\x88\xd3\x53\xed\x27\xe7\x98\x3a\x2d\x87\xa3\xb5\x8b\x81\x56\xac\x1c\x1a\x84\xcd\x19\xc7\x13\x3e\xc5\xad\x49\xac\x66\x40\x6c\x0c\x95\x98\x6f\xf1\x1c\x6c\x70\xfc\xb3\x67\x61\x09\xa3\xd2\xd7\x89\x15\xe3\x9e\xe4\x2f\xa3\x4c\x72\xa1\x2f\x73\x94\x93\xde\xaa\xb9\x84\xc6\x67\xbe\xd2\x14\x57\x3f\x35\x19\x57\x56\xfb\x4c\x61\x5c\x3f\x54\xb2\x2e\xa0\x88\x4c\x8f\x51\x30\xa9\x46\xc9\x2f\x98\x13\x63\x4d\x91\x59\x5b\x84\xe5\x8e\x51\x3f\xc8\xa2\x45\x56\x96\x25\x1b\xbf\x89\xf3\x8a\x55\x94\x96\x07\xa3\x08\xaa\xb8\x69\x26\x38\xf3\x8b\xe0\x02\xe4\x5b\x86\x9a\x18\x6d\x82\xdd\x8a\xbb\xa1\x64\xa7\xd5\xde\x18\xb9\x17\xcc\x26\x84\xc8\xd7\x3b\xef\x2d\x55\x3a\x9e\xf9\x01\x03\x01\xa5\xfb\x48\xca\x43\x19\x12\xda\x67\xa4\x9d\x94\x4b\xad\xfb\x91\xfc\xa1\x5a\xf6\x59\xa9\xba\xd7\x98\x91\x28\x67\x42\x45\x34\x3c\xec\x12\xcd\x1d\x4b\xd1\x18\xda\x44\xff\xe1\x0b\x8d\x93\x6b\x43\xc0\x5b\x93\x8b\x8a\xcf\xa6\x14\x84\x4c\xa2\x37\x60\x77\x82\x72\xc9\xcf\x64\xe7\xb9\xff\xa6\x94\x77\x51\xa5\x4a\x7c\x8f\xb6\xe4\xad\x1e\x14\x75\x2c\x31\xa8\x8a\xf8\xa8\x87\xf5\xa3\xce\xc8\x32\x20\x3e\x7b\xc8\xee\x84\x2b\xaf\x7c\x2d\x03\x1a\x97\x65\x53\x0a\x47\x20\xce\x88\xcb\xd9\x52\x87\xa4\x5c\x13\xab\xa0\x6e\xe9\x30\x27\x66\x0b\x97\xab\xbe\xc7\x70\x52\x3f\x18\x41\x82\xf4\xfa\x8c\xad\xf1\x82\x80\x5a\x4b\x7d\x67\x79\xc3\xfa\x11\x1a\xab\xcf\x9e\xf8\x52\x0b\xd1\x1d\x3c\xfb\xe3\xaa\xfa\xcc\x3f\xa3\xe2\xb0\x11\x4f\x40\xaf\xbb\x6c\xfb\x86\x29\xc2\x72\x0e\xf5\xd8\x45\xa7\xb3\x69\xf7\xcb\x0e\x14\x69\x44\x58\x48\x77\x94\xc8\x6c\xc7\x33\x49\xac\x82\x40\xb1\x8a\xeb\x82\x91\xa2\x41\xc0\x3d\x7c\x6e\x75\x7b\x0e\xc8\xde\x74\x36\x61\xbf\x0e\xd5\xa5\xd0\xc7\x8c\x2b\xdb\xa1\xd5\x88\x5b\x03\x52\xe2\xe7\xa0\x47\xe9\xff\x58\x24\x3f\xa0\xd2\x0d\xfb\x52\xd2\x99\x6d\x7a\x89\x0e\xa7\x56\xc8\xba\x3d\xa0\x6a\xa4\xb3\xc6\xb8\x9a\xf1\x92\x54\x58\x11\x5b\xe6\x33\x86\x60\x66\x4d\x2e\x01\x7d\x75\x1f\xf5\xa1\xf8\x30\x52\x0a\x0b\x7c\x24\xd1\x35\xd8\x6f\x0e\x7d\xa5\xd9\xdb\xfe\x0d\x27\x33\x21\xcf\x2e\x1f\x01\x93\xa2\x4f\xae\x42\x9c\x6f\x9d\xc6\x9d\x8e\x1c\x1b\xa5\x7d\x8e\x97\x79\xc4\x09\x06\x27\x16\x6f\xa8\x33\x21\xd0\x06\x9d\xb0\x5b\xc8\x58\x61\xf2\x32\x70\xf2\xb3\xc7\xdf\xee\xaf\xbe\x43\x74\x21\xad\x54\x9f\x55\x45\x26\x69\x9f\xfc\x3a\x6e\xbe\x60\xc1\xc6\x3b\xeb\x18\xdb\x74\x54\x01\x3a\xc6\xd0\xb0\xb6\xb4\x8e\xd6\x99\x4f\x0a\xb4\xf8\x82\xc6\x51\x45\x64\x8e\x58\x10\xd9\x0b\x28\x14\x26\x39\x25\xcd\x62\x21\xb9\x95\x91\xfd\x84\xdf\x17\xd1\x98\x48\xcc\x89\xcc\xc9\x92\x1f\xd4\x67\xc3\xa8\x0e\x4d\x66\xfd\x9e\x16\x53\xa4\x5d\xfb\x55\x70\x4c\x01\x47\x8e\xff\x4b\x89\x02\xf0\x47\xf5\x25\x6b\xac\xe9\xb5\x40\x3a\x1e\xb9\xed\x8b\x17\x90\xa8\xd9\xfd\xce\xf1\xd5\x19\x0b\x4b\xb0\x57\xf2\x2c\xb1\xf2\xaa\xcf\xbf\x7d\xf1\x52\xf8\x77\x9f\x11\x73\x12\x17\xd4\xb3\x33\xea\xa7\xf5\x9d\xb8\x21\x76\x50\x6b\x70\x83\x5c\xc5\x56\x8c\x94\x1a\x3e\x60\x8e\x29\x63\xfc\x50\xe0\x55\x2f\xfd\x83\x12\x84\x20\xaa\x8a\x43\x0c\x86\x35\x00\xff\x53\x00\x07\x23\xf7\x9e\x87\xc2\x10\x46\xc1\xd9\x03\x96\x52\x51\x8e\x4c\x06\x0b\x26\xea\x14\x44\x0c\xeb\xb7\x92\xca\x8b\x74\x26\x47\x32\x0b\xba\x3b\x3c\x92\x1b\xd9\x03\x6d\x38\xc2\x25\x94\x8d\x16\xd5\x8b\xa8\x60\xf8\x40\x7b\x36\xd1\x6a\xfa\x6d\xe9\x95\x95\xd2\xe3\xc2\xf9\x74\x46\x56\x21\x9a\x76\x18\x8d\x5d\xf4\xe0\x2e\x7c\x87\xee\x03\xd3\x4d\x38\x34\x2d\x3e\x59\x78\x4c\xa3\xe4\x61\x93\xf0\x8c\x5a\xf6\xb0\xac\xf3\xeb\x91\x88\x26\x11\xe8\xc8\x03\x73\x6e\x33\xe0\x96\x14\xd1\x42\x04\x9b\xcb\xcd\xd2\xe4\x72\xd7\x03\x49\xcd\x48\x64\x4a\x4e\x4e\xda\x42\x25\x69\x20\x2a\x68\x60\xa5\xc6\xda\x68\x18\x53\xa3\xc4\x6a\x37\xa2\xeb\x9c\x71\x55\x09\xa0\xf4\x94\x54\xa3\x82\xc9\xc1\x14\x37\xe3\x74\x90\x80\xf6\x66\x44\x7e\xfa\xfb\x5b\x11\xb9\x59\xaa\x84\x1e\x90\xae\x57\x02\xaf\x0e\xa8\x6a\x8b\x74\x65\x9f\x0b\xa9\xb7\x2c\xa6\x47\x4c\x7b\xd7\x47\x67\xaf\x52\x8e\x58\x5e\x45\xae\x9b\x82\xbe\xe8\x3c\xd2\xde\x79\x1d\x8f\xd8\x9a\xb0\xe0\x06\xbc\x0e\x87\xa4\x44\x78\x61\x1a\x43\xd2\xec\xf0\x72\x86\x30\x68\x8e\xc1\x47\xc8\x0a\xc5\xd9\xd4\x1e\x50\xfa\x34\x92\xc7\x5c\xa1\xf4\x9d\x88\x7f\x10\xa5\xfc\x5d\x5b\xc4\x09\x5b\x3d\x97\xa2\xe5\x93\x9a\x32\x17\x73\x6f\x88\x91\x49\x56\x6b\xd2\xbf\x81\xd5\x8b\x69\x31\x02\x39\xa1\x8b\x84\xee\xde\x7c\x21\x5e\xc6\xcb\xc5\x85\xb0\x1a\xcb\x15\x9c\x9f\xdd\x42\xcc\x33\xce\xd6\x4f\xd5\x0d\x06\x0e\x9f\x17\x18\xad\xc2\xd7\x49\x96\x8c\x8c\xb7\x9c\x2f\x5f\xcb\x09\x19\x10\xb5\x61\x40\xa4\x28\xb9\xc9\x14\x1a\xc5\x86\xa6\xee\xef\xc5\x10\x7d\x8a\xe4\xde\x3b\x6b\x05\xba\xc2\xdc\x98\x06\xf6\x23\x93\xc9\xdb\x5a\x0a\x0f\x9b\x7a\x08\xee\x7a\x56\xa8\xd0\xbf\x01\x22\x40\xbd\x56\x25\x50\x92\xdb\x37\x4d\xcd\x7a\x5b\x5e\x1d\x4b\x91\xbf\x28\x29\xe7\x79\x3a\x54\x2b\x7a\xc5\xa9\xef\x35\xac\x6b\x31\x41\x02\xd7\xb6\xf1\xe3\xa7\x71\x61\x41\x21\xca\x68\xa2\xee\x83\x3b\x42\x21\x7a\x83\x93\xe1\x42\x59\x4d\xe3\xc0\xe2\x48\x33\x51\xbf\x11\xb8\x1c\xab\xd7\x92\xfb\x78\xa8\x5e\xea\xdb\xa2\x77\xaf\x2c\xec\xe3\xc1\xfd\x92\xd0\x7a\x55\x88\xd0\x1a\xde\x06\xad\xe9\x83\xc3\xf3\x65\x44\x65\x22\x7b\xd3\x76\x65\x03\xba\xb9\x4b\x59\x49\xff\x4e\x59\x54\x7e\x29\x1e\x53\x48\x93\x87\x46\x92\x06\x91\x38\xa8\x9d\x8c\xcd\xa5\x40\x65\x4c\x42\x81\xf7\xcb\xf3\xcd\xd4\x8e\x4a\x17\xb2\x36\x13\x5d\x90\xf4\x78\x7c\xab\x0e\x32\xdf\x53\x5b\xc2\x14\x8e\x81\xdb\x0d\xf0\x0b\xab\x2a\xad\xae\x87\x7e\x3f\x16\x67\x2d\xde\xd4\x4a\xb4\xa8\x72\x84\x68\x1b\x95\x02\x47\x01\xc3\x62\xb8\x6a\x78\x51\x63\xf9\x5e\x71\x9b\x9f\x98\xe8\xbe\x6a\x71\x9b\x22\xf0\x5b\x22\x8d\x97\x8e\x23\x5e\xf9\x69\x43\x54\x3e\x0e\x49\x25\x6a\xc1\x71\x5c\xdc\xdc\xbf\xec\xc8\x0b\xc8\x10\xd3\x18\x54\x69\x1c\x2a\x82\xa2\xcd\xb2\x6e\x90\x91\x8e\x97\xd8\xd7\x28\xf1\x18\x1d\xd3\x11\xf1\x9f\xf1\xae\x94\xc5\xc5\x6c\xfd\x0c\x12\x89\xea\x0d\xcb\xe0\x90\x28\xc3\xba\x00\x59\x08\x7b\xb5\xb3\x39\x81\x7c\x7f\x64\x50\x0c\x1c\xab\x3b\xab\xd1\xed\x60\x86\x0b\xa7\x36\xa8\xce\x66\xec\x45\x93\xfd\x50\xd0\x97\x84\x31\x04\x39\x41\xc7\x96\xe0\x4f\x94\x94\x44\x43\xf3\x2f\xf1\x84\x32\x51\xdf\x08\xba\xa3\x0d\x85\x2a\xed\xf6\x3b\x61\x14\xbe\x3f\x35\xb4\xde\x2c\x16\x0b\xbc\x3a\x0f\xb8\x2c\x0b\xaf\x30\xdc\x35\x39\xf3\xa4\x00\xef\x2b\xce\x13\x1e\x60\xe0\xa2\x2f\x7e\xde\xa2\x05\x8b\xb5\x5c\xfe\x28\x3a\x32\x98\xbf\x9f\x54\x5a\x69\xda\x15\xc5\xa6\xbd\xdb\xb8\xae\x8f\x7c\x32\xbf\xa5\x24\x11\xb5\x4f\x67\xae\x85\xa0\xfc\x62\xb9\x04\x14\xa6\x75\x5d\x7b\xab\xa3\xfa\xc9\xdf\xf6\xa6\x08\x31\x97\x9a\x51\xf4\x9c\x28\x7d\x1f\x98\x89\x29\xdd\xe2\xe9\x25\xce\xa8\x09\xfb\x82\x61\x08\xdc\x13\xe0\x13\xb4\x9e\x8d\x7c\x44\x45\xf2\xd8\xb7\x63\x09\x9a\x02\x31\x2b\xd8\x4d\x94\x62\xa8\xd9\x11\x7b\x28\x72\x3a\xd0\x42\x6d\x39\xad\x1e\x1a\x38\xeb\xc9\xb0\x64\x28\xc4\xc0\xb4\x1c\x7e\x37\x67\x92\x9b\x8d\x0f\xb8\xc4\x6b\xb9\x4c\xab\x26\xab\x9b\x5b\x07\xef\xd4\xcc\x9d\x3c\x93\xf8\x5f\x0f\x6c\x40\x3d\xba\xe3\x2d\xa8\x5f\xf7\x6d\xbb\xf2\x21\x21\x14\x85\x7d\x2b\x86\x58\xd3\x1e\x0a\xb8\xfd\x91\x37\xe8\x1d\xc5\xcf\x4b\x18\x11\x72\xeb\x25\x15\x17\x28\xb7\x5b\x8c\x28\xc7\x2b\x15\x7c\x93\x52\xad\x91\x7a\x6d\x75\x2d\x85\x6b\x82\xbc\xc7\xc8\x71\xde\x8a\x0f\xa3\x76\xf9\x97\x7e\x42\x34\xaa\x92\x31\x16\x05\x73\x58\x29\x8c\xfd\x7e\x56\x16\xef\x29\x7a\xf4\x3d\xa6\x96\x79\x3f\xeb\x9c\x15\x9e\x44\x5b\x2f\x69\x73\x2f\xa3\xa5\x7b\x57\x83\x1e\x77\xa5\x9d\xb6\xdb\x9b\x7a\x01\x4c\xe2\x6e\x04\x9a\x25\x57\x38\xea\xcf\x87\xec\x4f\x59\xdc\xd7\xe2\x1d\xfd\x93\xb7\x04\x4f\xc3\x60\xeb\xce\x30\xb0\x38\x9a\x02\x8f\xea\x19\x0c\x14\x14\xab\x10\x6f\x7f\x76\xbe\xc9\x45\x79\xad\x88\x86\xca\xc9\xb6\x0a\x8f\x63\x0c\xcf\xb4\xe5\x6c\xe8\xc3\x5d\x69\xb5\x77\x0e\x60\x6d\x46\xe8\x74\x89\x11\x28\xce\xf2\xb9\x48\xed\x27\xcc\xb4\xe2\x28\x29\x45\x41\xfe\x90\x54\xfb\x42\xb0\x41\xcd\x43\x23\x0a\x16\xd6\xc3\x06\x33\xa0\xab\x16\x7b\x18\x7a\xd6\x08\x18\x5d\x8c\xe7\x14\x22\xff\xf4\x74\x22\xde\xa2\x8f\xd4\xb9\xf3\xc9\xb6\xa8\x72\x32\xdb\xca\xe4\x13\x87\x47\x0d\x4b\x15\x45\xb9\xb4\x73\x60\xe6\x4a\xd7\x18\xd4\x8d\x1c\x53\x78\x4b\xcf\x80\x9b\xf8\xf1\x41\xfd\xd3\x60\xd5\x75\x38\x2d\xf8\x07\x71\x16\x7c\xf8\x65\xb5\x76\xe8\x9e\x39\xe1\xf4\xb5\x69\xff\xf8\x8f\x3d\xfb\xaf\xf7\x24\xbc\x36\x0e\xbd\x0b\x29\xdf\x69\xef\x69\xb9\x95\x5e\x48\x28\x1b\x47\x9b\x0d\x92\x78\x93\xe5\x71\xe5\xd9\x4a\xe6\x3a\x8c\xd0\x5b\xdb\x9e\xca\xd9\x47\x40\x64\xd4\xb7\x75\x5b\x1f\x7e\x53\xd0\xe8\x44\x93\xa4\x5f\x69\x1b\x49\xbf\xbd\x47\x10\xaf\xd6\x41\xf2\x30\xa7\x43\xe3\x93\x9f\x9d\x0e\x35\x0c\x6e\xd3\x4c\x1c\x07\x71\x4b\xa1\x74\x3b\xa4\xad\x69\x0f\xc2\xe7\xeb\x23\x01\xfc\xa5\x64\x31\xaa\xc3\xf4\x4f\xa4\x98\x92\x2c\xeb\x6c\x56\x91\x7b\x5a\x8f\x67\x75\xba\x95\xc1\x41\x2a\x6d\x39\x93\xd4\x82\x93\x70\x5a\xa7\x38\xb3\x60\xe8\x9b\x44\xca\x3a\xc9\x2f\x6b\x4a\x9d\x5e\x16\x72\xf6\x40\xdf\x90\xc3\x7f\xd6\x74\x2c\x3e\xc2\x86\xd2\x46\x3e\xae\x47\x97\xad\x8b\xac\x97\xac\x59\x65\x83\x93\x58\xa9\x7c\x31\x3b\x6f\x66\x92\x72\x14\xf6\x02\x32\x59\xe6\x6e\x14\x71\xc4\x0e\xe4\x8b\x30\x83\x95\xe4\xcd\x50\xfe\x9a\x23\x9b\x5c\x3e\x81\xde\x60\xab\xde\x71\xef\xee\xca\xcd\xfa\x70\x16\xca\xa5\x2e\x71\x28\xb7\x9f\x21\x37\xf4\x72\xa2\xd8\x2b\x9f\xab\x0b\x2c\x1e\x4a\x5f\x52\xa3\x85\x2d\x47\x7b\x93\x1f\x76\x32\x30\x06\x83\xa7\xcc\x27\x68\x36\xb0\x55\x1f\x3c\x47\x1b\x41\xde\xa8\xbc\xc4\xd9\xc6\xb5\xdc\x03\xa7\x24\xdf\x3b\xca\x64\x35\xa7\x14\xf6\x9a\x3b\x2a\x26\x21\x3e\xe6\xdd\xca\x46\x70\xe2\x79\xad\x09\x71\x2b\x8c\x55\x15\x05\xe7\xbd\x89\x68\x95\x0d\xa8\xba\x28\x59\x5c\x07\x85\xb1\x5f\x24\xae\x22\x15\x3a\x88\xb8\x12\xed\xca\x67\xd1\xc4\xb4\xe0\x68\x6c\xb0\xda\xe3\x6c\x6c\xde\x6e\xf9\xfa\x49\x99\x0b\xca\x40\x53\x5b\x3d\x0b\x39\x1e\xcd\xe0\x70\xdb\x01\x71\xbb\xa3\xc9\x3f\xd7\x5f\x95\x41\x25\xe3\xb4\xa4\x2c\x10\xc5\x6f\x3f\x4d\x01\xaa\xcc\xcd\x93\x52\x93\x41\xf8\x3a\x2e\x92\x15\x62\xca\xa3\xc1\xe5\x44\x02\x53\x24\xa7\x07\x4a\x31\x9f\x50\xc5\x21\x96\x9a\xc3\x81\x97\x73\x8b\xb6\x54\xd7\xbe\xd4\xec\x09\xb6\xc7\xc8\x57\x24\xde\xa2\x77\x22\xc5\xc4\x95\xfb\x09\xef\x03\xb7\x9b\x0d\xfd\x7c\xe4\x01\xbc\xa6\x98\xdb\x00\x76\x4d\xc9\x4a\x20\x45\x7b\x35\x93\x82\xb4\x4b\x6f\xa7\xc8\x74\x9c\x24\x88\xaa\x97\x31\xee\x38\xb8\x73\xb7\x42\x9c\x73\x34\x81\xcc\xc3\xcc\x9b\x2b\x42\x2b\x8b\xc5\x9e\x58\x7d\x3d\x5f\xb0\x28\xb1\xe6\xe4\xb9\xd8\xb7\xcf\x2e\x71\xd1\x6a\xfd\x45\xa0\x27\x29\xa7\x01\x86\xf1\x78\x3f\xfc\x49\x3d\xe7\x7f\x6e\xf7\x13\x68\x32\xb6\x0a\x59\xeb\x6f\x35\x43\x4b\x2f\x99\x7d\x4c\x25\xd8\x73\x8b\xbd\x13\x50\x07\x8e\xe3\xe8\x23\x97\x35\x73\x4d\x31\xa2\x27\x44\x54\xa4\x6a\x83\x48\xeb\x89\xc4\x4c\x52\xb4\x75\xa7\x0f\x4b\xf5\x28\xab\xc3\xb5\xbb\x39\x09\x28\xb5\x9a\xc8\x57\xf5\x7d\xee\xee\x02\x04\x7c\xf1\x63\x20\x8c\x98\x19\x58\x83\x38\xac\x33\x2d\xa5\x06\x53\xa4\x26\xf7\x7b\x61\xeb\x82\xfd\xcd\xee\x92\xae\x3f\x15\xae\xa3\xa3\xf1\xe3\x9f\xc8\x0a\x69\x2a\x7c\x41\x94\x8b\xb4\x4a\xcb\x8b\x09\x77\x52\x1a\xce\x06\x7e\xbf\xb3\x8e\x9d\x9f\x25\x19\x39\xb1\xf2\x70\xa8\x2f\x48\xf3\xb0\x88\x55\x1f\xfa\x64\x0e\x45\x65\x7c\xd6\x1c\x61\x2f\xfb\x37\x77\x7d\x55\x56\x1b\x8a\x82\x96\x48\xd9\xd2\x97\x78\x1d\x9e\x89\x6c\xf6\xe3\xbe\xa4\xa4\x98\x3d\x33\xf8\x44\x5b\x98\x42\xa1\x23\x8f\xd4\x40\x1f\xef\x6d\x0c\x1d\xdf\x8d\x7a\xc0\xc1\x75\x36\x41\xbf\x7f\x27\x30\xb3\xbf\xda\x4a\x9c\x42\x3b\xf3\xc8\x88\x13\x54\xcc\xbd\x8a\x1e\x8b\xe4\x4b\xcc\x97\x40\x7c\x40\x43\xe9\x82\xcf\xa5\x88\x7b\x88\xa4\x4a\xcd\x2e\xe0\x91\x9f\x80\xa1\xd0\xaa\x8f\x9e\x47\x3e\x18\x6f\x9b\xf2\xe0\xf3\x97\x52\xd0\x76\xee\xd2\x82\xe3\xe5\x58\x13\xec\xf3\xfb\x69\x9c\x63\x1a\x26\xa6\x18\x5b\x1e\xb6\x9a\x0d\xfd\x88\x8e\xf5\xc7\x5f\xa1\xa6\xb6\xb4\x5f\x94\xb2\x0b\x8e\x4f\x53\xf6\x60\xa6\x37\x71\xdc\x86\xb7\x81\x8b\xb0\x51\x70\x2c\xb9\x19\x69\x0d\xb8\xc0\xa9\xff\x36\x3c\x0d\x0a\x27\x07\xa4\x0b\x5d\x24\x74\x76\x75\x3b\xf2\x65\x54\xd9\x16\x9a\xf2\x89\x4a\x71\xcb\x5b\x26\x0f\x95\xb1\x96\x1f\x4d\x2c\xfb\xe1\x4c\x81\xb4\xf5\xac\x3f\xe0\x59\xcf\x63\x57\x3f\xc1\x2d\x43\xed\xf1\x9b\x0e\x98\xa4\xc4\xc8\x55\x98\x64\x09\xbd\x1a\x3a\xc5\x79\x06\x47\xa4\x2c\xb6\xc7\x8d\xe9\xdd\x58\x3e\xf6\x19\xd7\x16\x41\xc8\x2c\x6b\xfa\x26\x20\x94\xb5\x9d\x0d\x7d\xa2\x00\xf8\xc1\x2f\xfd\x1f\xef\x2a\x86\xc5\xa1\x40\xea\xe3\x6a\x12\xe5\x08\x1d\xfe\x47\x6a\xde\x58\x8f\x34\x68\x88\xbb\x59\x4f\x1f\x48\x6c\x9a\xdd\xfc\xd6\x13\x90\x86\xb3\x81\xdf\x8f\x24\x3b\x9e\xd5\xb9\x2d\x97\xfc\x7b\x4e\xf1\xae\x4a\x72\x4c\xf3\x0e\xff\x96\x14\xeb\x29\x3a\xdb\xe6\x39\xe7\x33\x90\xdc\xb9\x70\x61\xfa\xba\xf4\x9b\x8f\x80\x47\x8b\xa5\x37\x49\xdc\x2e\x13\xa9\x9c\x60\xab\x99\xfb\xb5\x8c\x2a\xef\xf5\x6e\x5b\x7e\xf7\x77\xfd\x8c\xf0\x91\x4e\x7e\xec\xfa\xc9\xfa\x34\x49\xce\xd8\x40\x78\xff\x7a\x6a\x2a\xb4\xac\x4e\x39\xd9\xca\xdd\xd9\x01\x91\x9e\xb9\x15\x19\xd0\xf1\x66\x58\x52\x1e\xa6\xd7\x75\xa0\x61\x23\x2f\xae\x8d\x65\xec\x40\xbc\x5e\x53\x82\xd8\x6d\x1c\x0d\x64\x75\xa7\x99\x7f\x24\xed\x0c\x85\x12\xfa\xf0\x8f\x9b\x9d\x02\x89\xc9\x1d\x74\x0a\x9c\xae\x70\x8c\x1c\xb4\x78\xd5\x41\xbe\x69\xcb\x0c\xc9\xd5\x4b\xcc\x01\x06\x9d\x8f\x8e\x77\xca\x8b\xfa\xdf\xe0\x6c\x8a\x41\x68\x53\x4e\xf3\xb2\xcf\xb8\xee\xef\x24\x4a\x5a\xea\x3c\xcd\x63\xdb\x49\xad\x67\xce\xb8\x58\xb6\x5b\x8d\x5f\x53\x44\x75\xf5\xec\xd5\x01\x02\xa1\x7d\x83\xd1\x15\x05\xeb\x07\x74\x9e\x9e\x1f\x31\xca\x8d\x9a\x8b\xb4\xef\x6c\xa9\xa3\x63\xdc\x2a\x02\xfd\x43\x57\x93\x6c\xeb\xd6\x09\x46\x23\x5c\x15\xec\xcb\xba\x5d\x63\x90\xce\xb6\xcd\x43\xe4\xf0\xbf\xe6\xd7\x89\x2f\x3d\x2e\x41\x79\x03\xd7\x11\x13\x46\x70\xf0\xcd\xa4\x73\x94\xc6\xfd\xe3\x6c\xfe\x76\xa7\x03\x4d\x7d\x18\x90\x0a\xe6\x9c\x16\x55\x7c\x84\x35\xf6\xec\xfd\xec\x7e\x30\x7d\xf2\x09\x00\x0a\xde\xf8\x09\x44\x15\xb3\xa4\x72\xc2\xb4\x08\xe0\xa6\xb2\x57\x75\x58\x26\x19\xc3\x86\x62\x84\x34\x30\xb9\x37\x8c\x49\x8f\xbc\x2a\x5e\x79\xc0\x1f\x11\x17\xd6\xd6\x4e\x72\x87\x88\x13\x91\x3d\xca\xf5\x54\x6f\xb8\x70\x2a\x11\xc0\x06\x9d\xbb\x88\xca\xd9\x26\x92\xbe\x8d\x42\x7d\xda\x1c\xe3\xc6\x00\x5e\xc5\x92\x04\x63\x90\x97\x24\x22\x67\x9d\x61\x6c\x9a\xe6\x87\x61\x4d\x07\x30\xe9\xb7\x42\x24\x03\x50\xc8\x08\xf5\x51\x8a\xba\xfd\x3e\x79\x7a\xc4\x0b\xdd\x3b\xa0\x2f\x60\x46\x9b\xaf\x36\xf2\xd0\x50\xfd\x69\x4f\x50\xe3\x85\x20\x1b\x45\x01\xca\xc0\x09\x05\x7e\x8b\xff\x88\x73\x23\x9d\xcd\x00\xc2\x00\xb4\x26\xfa\x08\xe2\xab\x3a\xf1\x6c\xad\xe9\x6c\xe8\xcb\xa0\x77\x4d\xec\xe4\xfb\x5b\xb8\xd6\x84\xe5\x2b\x7f\x23\xbf\x9a\x25\x7a\x4b\xdc\x6c\x00\xa4\x2c\x56\xe6\xba\x3a\xc6\x81\x5b\xd0\x69\xe8\xed\x12\xd7\xdb\x9c\xe4\xd5\x12\xa7\xe8\x1c\x3d\x8e\xb2\x71\xc7\x7a\x4b\x73\x8a\xd0\x5a\x53\x86\x6a\x21\xcc\x70\xbb\x41\x89\x2b\xc6\x62\xad\x13\xcf\x55\x85\xd8\x2b\x02\x33\x06\x5f\xc5\x4a\x44\x1a\x50\x2b\x2c\x70\x80\x31\x39\x1d\x70\x7e\xc6\x4c\xdc\x10\x4f\x4e\x50\xf0\xbf\xcd\x7d\x33\x2e\xdc\xc2\x8b\x0d\xbc\x36\x39\x24\x91\x93\x6f\xc5\xde\x9a\x5a\xc9\xe5\xe9\xe9\x04\x6f\x4d\x1c\xf5\x86\x63\xe7\xb8\x0b\x9e\xbb\xe7\x66\xef\xfa\xb1\xb9\xdf\x94\x92\xe9\x41\x6e\x34\x7e\xd4\xea\x71\x0f\x36\xc1\x9e\x86\x46\xc3\xac\xc7\xe6\x6f\x4f\xa0\x34\x33\xb1\xe5\x75\xed\x28\x1a\x7b\x63\x10\x64\x8d\x71\xa7\x41\xf0\xfa\xf7\x0b\x81\x4a\xba\xca\xa1\x31\xbc\x88\xf7\x4d\xb7\x3f\x89\x7a\x1c\x67\x41\xc3\x3d\xc4\x0e\x31\x02\x3f\xf2\x08\x9c\x6d\xaf\x27\xa1\x30\xb4\xeb\x51\x0d\xcc\x87\x5a\x6c\xf6\x47\x8b\x0a\xef\xca\xf3\x73\x4c\x46\xde\x49\xb2\x8c\x89\x3a\xc9\x72\x42\x82\x01\x3e\xf9\x9a\xce\x87\x0f\xda\x8b\x0b\x9d\xcc\xb9\x13\xf4\xdc\x3e\x73\x26\x7b\x33\x21\xc3\xd6\xd3\x52\xf4\xd3\x3e\x1f\x3b\xff\xc0\x6c\xe4\xd9\x14\x4c\xa7\xf8\xf6\xeb\x27\xbd\x27\xf1\xa8\x53\xdd\xc7\xad\x69\x9f\xfc\xaf\x7f\x85\x6f\x6a\x4f\x6c\x11\xf7\x61\x4c\xc9\x9e\xd5\x17\x77\xf4\x4e\xc5\x38\x5b\xd9\x58\x98\x97\x27\x96\x8e\xe5\xb9\xa4\xfa\x3f\xe8\xe7\xa4\x65\x5b\xc4\x6b\x35\x80\xd1\x54\xad\x92\x35\x9d\x0d\x7c\x19\xd6\x29\xdd\xdd\x29\x75\x18\x7a\x77\xd3\x1f\x59\xe0\x7f\xc8\xae\x46\xd0\x0a\xa3\xfe\x6f\x78\x18\x0f\x79\x5b\xa5\x1a\x6b\x7d\x2b\xec\x87\x93\x24\x71\x3e\x2b\x8c\x90\xbf\x1d\xe2\xd4\xec\x58\xc7\x4e\xcc\xde\x42\x55\xc8\xcc\x52\x49\x3e\x22\x18\x4d\xa8\x22\x5c\xad\xea\x20\xb1\xb3\x78\x1b\x23\xcd\x48\xaf\x9e\x2b\x34\x3a\x37\xfe\xc8\x6f\xe0\xfb\x19\x7c\x9f\xc0\x95\x06\xe2\xab\xbe\x31\x12\x5b\x5f\x1f\xdc\x1a\x08\x67\x58\xc5\x9a\xa4\xfa\x5d\x29\xf5\x83\xbb\xf3\x62\xfd\x2b\x52\x20\xd1\xcc\x14\x3f\x46\xd5\x5b\xc6\x12\x5a\xe8\xa0\x83\x96\x93\x0e\x38\x88\xe5\x22\xbb\x1d\x6a\x43\x42\xb1\xb4\x11\x70\x0a\x1c\x07\xd2\x67\xd0\xea\x06\xc5\xa1\xee\x0e\x78\xc9\x5d\x9c\xa2\xee\xbe\x50\x7c\xec\xde\x60\x79\xb7\xbb\x83\xdd\x97\xaa\x3e\xa2\xcc\xa2\x6c\x74\xba\x56\x4e\x7c\x7c\x36\xee\xd6\xac\x90\xf1\x5e\x5e\xa8\xe3\x7c\x47\xa9\x73\x0c\x26\x37\xc4\xe6\x4b\xe2\x11\x83\x9a\xfc\x7d\x2b\xf0\xc6\x97\x24\x40\x2c\x36\x03\x30\xd0\xc4\xb5\x3d\x94\xf0\xb7\x09\x56\x36\xe5\x36\x41\xb3\xa3\xdd\x66\x52\x8a\xa0\xe3\xbb\xa4\x75\x1c\xa7\xa0\x3d\xf5\xf0\xbe\xcd\x99\xe6\x69\xf5\x55\x86\x54\xbc\xa7\x75\x6d\x34\xd3\xec\xd4\x24\x6b\xb6\xf1\x01\x9f\x18\xfa\xb9\xbf\xe6\x7b\xe2\xe2\x57\x4c\x80\x55\x7e\x74\x18\xe3\x5b\x2e\x35\x8b\x3d\xe9\x88\x52\x39\x24\x8c\x55\xf1\x01\x37\x44\x2c\xcb\x3c\xe7\xea\xc4\x51\x8e\x10\x76\x82\xb9\x24\x8e\x8c\x24\x63\xab\x1b\xb5\x72\x38\xa0\xe4\x9c\x9f\xea\x66\xa4\x0b\x09\xd4\x65\x42\x48\xea\xa0\x14\xad\x94\x0e\xa7\x54\x88\x3d\x5f\x48\xee\x7f\xdb\xdd\xec\xee\xf8\x7e\xf2\x96\xf7\x14\x65\x64\xa5\xd4\x0b\xba\x41\x2b\x3f\xd4\x4d\x72\x22\x47\xe4\x3e\x4c\x39\x22\xf7\xe1\x57\xc5\xb0\x01\x21\xfe\xa0\x61\xd3\xfc\x0e\x74\x0c\xe8\x71\x3a\xa8\xb1\x14\x0c\xa3\x37\x00\x1a\x56\x9e\x30\xbe\x0d\xa3\x95\xc6\x73\x1d\xe0\xae\x46\xb2\x1c\xe0\xa7\x7e\x4e\xc1\x77\xe1\x4e\xd0\x00\x21\x06\x47\xcf\x4c\x69\x06\x49\xac\xa7\x3b\x01\xac\x52\x37\xbd\xf7\xfb\xe5\xf1\xc0\xc6\x27\x14\x99\x54\xf3\x23\x98\xfb\x02\x85\x9c\x6f\x0b\x38\x9c\x22\xcd\xf2\xc8\x4e\x16\xc5\x00\xa7\x4d\x2f\xd5\x92\x49\xa8\xde\x2d\xf4\xd8\xa3\xe9\xa7\xac\xba\xe1\x44\xa4\x12\xf1\x58\xea\x89\xdf\x26\x7f\xd4\xa0\xb1\x4e\x0f\x8d\x6e\xde\x60\x98\x3c\x5d\x46\x0c\xa2\x1b\xce\xc8\x24\x81\x6e\x2d\xc0\x4b\xbd\x32\xbf\xb8\xee\xb5\x32\x7b\x46\x38\x1f\xbe\x87\x9c\xb5\xdd\x67\xa6\x96\xe3\xd9\x0a\xa6\xca\x11\xf5\x2a\x6b\xc7\xb1\xaf\x96\x33\x06\x0d\x3c\x99\x78\xf2\xd4\xa6\xcc\x2a\x9b\x34\xf7\x48\x4a\xd2\x8e\xa6\x84\x9d\x82\xac\x51\x87\x3e\xd2\xa6\x77\x95\x3f\x83\xcc\xe2\x9c\x43\x29\x2c\x42\xa4\x69\x02\xf1\x60\xbd\x10\xc6\xf5\xa8\x25\x9f\xba\x7a\x18\x48\x75\x97\x7a\x87\x6e\x29\x94\x7b\x47\xa5\x10\x75\xaf\x63\x32\x7f\x2b\xd6\xca\x56\x59\x44\x8d\xeb\x81\x59\xee\x28\xa3\xb7\x1d\xe1\x55\xfa\x1e\xb1\xac\x2e\xe9\xd1\xc9\x49\x62\x3d\x72\xf6\xa1\x72\x65\x76\xe2\x6d\x75\xee\x30\xf9\xec\x84\xb3\xd6\xa6\xfd\x53\x6e\x8f\x7c\xaa\xbf\x13\x8d\x56\xea\xa3\x87\x22\x45\xa4\x0f\xc8\x31\x05\x9f\x55\x78\xba\xb3\x19\x0b\x7b\xc7\x36\xbd\x20\xdd\x9f\xd6\x24\xa9\xcd\x40\x58\xef\xd4\xc1\x48\x2b\x93\xdc\xe2\x81\x7a\x7c\xca\xda\xdb\x03\x00\xd5\x60\x8a\xa0\xef\x33\x00\xba\xb0\x81\xbb\x3e\x10\xf4\x65\xbe\x89\x91\x24\x28\x85\x94\x6f\x3b\x7c\x6a\xf6\x2b\x14\x11\xce\x2a\x34\x6b\x22\x0c\x2a\xc9\x5c\x8b\x7f\x50\x53\x62\x0d\xe6\x5b\x9d\x7d\x24\xf1\xec\x3b\x6c\x6d\x7c\x3e\x42\x82\xf9\xcd\x22\x98\x26\x32\xfc\xf8\x3f\xa2\xd9\xa9\xb4\x71\x16\x6a\xf7\xa9\xb2\xea\x22\xf8\x21\x2c\x7f\xdc\xb5\x7c\xe1\x6a\x96\x98\xbe\x83\xca\x2e\x1c\xbd\xae\x69\x4b\x81\x77\x4c\x0a\x42\x73\xfd\x8e\xae\xbb\x4f\x58\x22\x59\xab\x27\x7b\x79\x2a\xe8\x5b\xb5\xcc\x24\x42\x0f\xca\xa7\x46\x2e\x6d\xfa\x86\x68\xc0\x45\x23\x14\x09\x1d\xa3\x52\x31\xd9\x62\x6d\x39\x22\x32\x56\x1f\x5a\x50\x47\x73\xe0\xdc\x8e\x3d\xda\x72\x40\x4b\x79\x7e\x34\xe9\xe0\xa1\xbc\xbd\x3b\xca\xbf\x33\x99\x3b\x1f\xc8\xef\x43\x9e\xcb\xca\x99\x8f\x25\xf8\xe9\x85\xf7\x07\x75\x29\xcc\xf5\xf9\x86\xce\x02\x39\xcc\x58\x35\x05\x6e\xd8\xae\x0f\xb5\xa3\x61\x26\x09\xb2\x76\x6e\xa8\x50\xe3\x6d\x20\xe3\x55\xf8\x60\xac\x7e\x54\x80\x2f\x67\x15\x9a\xd8\xb5\x9f\xdf\xf5\x34\x9f\x08\x6e\xd7\xdf\xf5\xfe\x76\x87\xf0\x74\xc4\x0d\x5c\x72\x02\xff\x86\x1e\xe0\xf6\x84\x75\x3d\xbf\x07\x93\xc8\x44\x96\x55\x59\xcc\x27\xbf\xc6\xa8\x3a\x68\xf5\xe6\xd2\x4a\x56\x8e\xbc\x97\x1a\xa6\x2c\x6e\xb2\xac\x92\x41\x9e\x0b\xcf\xf8\x02\x34\xa3\xb1\x92\xc7\xf9\xa8\xa7\x23\x9e\xe9\x76\x2e\xfd\xd7\x29\xc6\xc0\xbe\x19\xd7\x1e\xbf\x5b\x0d\xb9\x43\x0a\x50\xf6\x8e\x9c\x80\x8a\xd0\x6c\x80\x6a\x1d\x7d\x01\x6b\xf5\x8a\x35\xf4\xa8\x34\x63\x34\x32\x41\x62\x7f\x45\x65\xf9\xad\x38\xc1\x9e\x16\xea\xde\xd9\xe5\x08\xa8\xc4\xd3\xc0\x6e\x51\x57\x30\x69\xbf\xd8\xf0\x58\xcd\x4b\xaa\xfe\x47\xc2\xd6\x50\x65\xf2\x9a\xf5\x31\x7d\xaf\x9f\x31\x2a\x43\x1d\xbc\x6f\xa4\x88\x28\x75\xf0\xc5\x06\x4b\xbe\x80\x77\x29\x3b\xdf\xa1\x33\xdb\xfa\xe2\xbe\xdf\x66\xbb\x9f\x44\x60\xea\xf6\x78\xcb\xd8\x77\xd4\xeb\x68\x55\xdc\x11\x7a\x38\xa9\x98\x7d\x07\x45\x1c\xef\x68\x88\x43\xa4\xdf\x47\x54\x71\xf5\x9a\xfd\xf4\x6f\x87\x98\xb6\x9c\x0d\x7c\xb8\xb3\x0e\xe8\x2d\x4a\xe3\xcf\xf3\xb2\xdd\x8c\xab\x7f\x9a\xf2\xf0\x3f\xa9\xfd\xd1\x7d\x8e\x28\x1b\x6a\x5c\xf1\x1a\x57\x3c\xac\x07\x0a\x76\x74\xb3\x36\x08\x6e\x29\x97\x44\x9b\x70\x27\x7d\xdb\x7e\xe2\x95\x91\xdf\xeb\x63\x6d\x86\xe6\xb4\x2f\x23\xa2\x75\x30\x48\x0e\xe1\xfd\x7d\x5f\xfc\xe5\x63\xe2\x6a\x2b\x12\x9e\x30\xc7\x0d\xfd\x3c\x29\xbe\x95\x32\x99\x18\x25\x7f\x17\xcc\xa6\x89\x9b\x95\x6d\x1e\xe2\x25\x86\xcc\xed\x3a\x6a\xec\x6e\x3b\x7d\x54\xe9\x67\xe5\xbf\xac\x5a\x31\xa9\x68\xf4\xa4\xb4\xfc\xe2\x94\x93\x92\xb6\xbd\x13\x91\xdf\xeb\x3b\x45\x53\x90\x74\x7f\x00\x9e\xb7\x2c\xd2\x5c\x73\x65\x06\xa5\x65\xea\x5d\xbb\xdd\xe6\xee\x4f\x9c\x42\x25\x54\x94\xd4\x7f\x3a\xec\xe3\x28\x8b\xfd\x64\xbf\x1e\x9d\x47\x3d\x2a\xfc\xdf\x5d\xfd\x95\x7e\x91\x28\x86\xa8\x75\x90\xb2\x59\xfc\x33\xba\xbd\x95\xc7\xe1\x22\x1b\xb7\x54\x49\x91\x61\x17\xc9\xf3\x5d\x89\xc2\x3a\xbe\xf9\xe1\x69\xa1\x5e\x12\xfe\xff\x84\xb3\x92\x96\xbd\x93\xca\x8a\xa6\x2a\xef\xaa\xb5\x52\x8d\x4e\x7d\x28\x31\x34\x99\x26\x39\x91\xd2\xb2\xa8\xa8\x92\xe2\xb6\x41\x48\x42\xc7\x87\x60\xb2\xdf\x04\x2d\xd3\x3b\x4c\x0c\x6a\x7f\xa8\xcd\xa6\x5d\x2b\x85\x4b\x7b\x0b\xea\x39\x59\xf2\xa0\xea\x17\xd1\x1d\x35\xf0\x8f\xb8\x61\x6c\x23\x72\x8c\x96\x53\xce\x82\x1a\xce\x86\x7e\x1f\xf8\xf1\x58\xe6\x0b\xe8\x78\xb9\xcf\xfe\x2e\x2c\xca\x8d\xa6\xfc\x39\x15\x98\x1f\x0e\x40\xe7\xc3\x59\xc7\x61\x7b\x6f\xb1\x64\x59\x5c\x42\xb1\xa3\x5f\xa4\xaa\x65\x2d\x95\x5e\x15\xbb\x4b\xaa\x90\x09\xd1\xc1\xf2\xab\xcb\x37\x4c\x3e\xb3\x73\x45\x54\x03\xc3\x4b\x2c\x84\x8b\x56\x66\x4d\x87\x0b\x38\xb0\xc1\x2c\x7d\xae\xd1\xa2\x31\xda\x43\xdb\xc5\x35\xa3\x49\xb4\xc3\x72\xb0\x53\xd2\xf0\x3a\xa0\x36\xe7\xbb\x9b\x54\x5f\xf8\xfa\x61\x9b\x61\x55\x9f\xaf\x90\x63\x70\x19\x71\xfd\xad\x5d\xe8\x7b\xee\x63\x6f\xf0\xf7\x38\xf0\x06\xb6\xed\x36\xfe\x9d\x67\xcd\x27\x7b\x73\x74\x3d\xc7\x14\xdc\xf2\x76\x30\xa3\xc4\x4b\xf3\x6f\x86\xb4\xd1\x94\xee\xa1\x1a\xa7\x33\x1c\xc1\xaf\x1f\x75\x88\x43\xb1\x61\x11\x65\xab\xde\x71\x3d\x7c\x40\x66\xc6\x07\x1b\x29\xc3\x88\xc5\xeb\xdc\xe6\xd1\x48\x2a\x4d\x1a\x68\x3c\x91\xe6\xf8\x3c\xc1\xc5\x6c\x30\x4b\xdf\xa4\x9b\x49\x2d\x7f\x03\x81\x00\x87\xaa\x7d\x72\xc0\x29\x22\x01\x76\x69\xa8\x90\x17\x2e\xb6\x67\xfe\x84\xaf\xf1\x78\xc9\x97\x65\xb9\x59\x5d\x3b\x95\x07\xa6\xa5\x1b\x1a\x2e\x24\x39\x3b\x3e\x22\x7c\x4d\x0f\x40\xb7\x84\xeb\x91\xd9\x86\x8e\x3e\x64\x9e\x66\x24\x67\x2a\x35\xbb\x01\x13\xa7\x48\xf9\xbe\xf8\x76\x6f\xb4\xf9\x50\x34\xba\x2e\x65\xde\x9f\x0b\x2e\x26\x7a\x7c\x90\xd1\xd0\xa7\x1f\x5a\x04\xc7\x35\x3d\x27\xd2\x8d\xe9\x90\x86\xb3\x21\xfd\xba\xf3\xfb\x5f\x4a\x89\x74\x77\x84\x18\x19\xf0\x58\x9c\x18\x19\xe6\x0e\x68\xa1\x23\x1d\x8f\x19\x28\xff\x4f\xf4\x59\xf3\x6d\x8f\x24\x5a\x7f\xce\x8a\x8c\xca\x6e\x9a\x3f\x45\x50\x4b\x26\x94\x49\x7d\x0d\x9a\x81\x12\x3a\x92\x30\x9f\x1d\x2a\x34\x53\xfe\x94\x64\x0f\x3d\x77\x91\x6f\x4a\xef\x2f\x72\xa3\x9f\xc8\x24\x07\x2e\xdb\xcb\xfd\x30\x1b\x4a\xbc\x93\x81\x12\x46\x53\xf6\x26\x47\x54\x1e\xd2\x29\xd7\x96\xda\xf5\x2f\xec\xb1\xc6\xa5\xb7\x52\x13\xbd\x36\xad\x06\xa1\x12\xea\x0b\x28\xb9\x16\x65\xdb\xe2\x1a\xf5\xc9\x43\xaa\x4f\xff\x88\x04\xa1\x35\x26\xa8\xc9\xe5\x20\xad\xda\x3a\xf5\x13\xc7\xc2\x69\x01\xa9\x00\xcb\x3a\x3c\x2d\xaa\xfa\x85\xa3\xd0\xc4\x16\x16\x98\x3c\xfd\xdd\xc9\x8e\x18\xe9\x8c\xe5\x65\x5a\x4a\xc2\x51\x19\x5e\x82\x7b\xfa\xc9\xd9\x27\xa7\x7d\x7b\x22\x0e\xc8\x98\x40\x43\x47\x5e\xa3\xb6\xf8\x91\x50\x56\xe9\xfb\x46\xa1\x83\xac\xa5\xed\x37\x00\xd5\x98\xe9\xd1\x1a\xf7\x51\xca\x86\x19\x02\xfd\xa8\xd3\x1f\x01\x7e\x68\x3c\xfb\x32\x70\x28\x21\x7a\xe5\xd9\x14\xeb\x81\xb6\xec\xa3\x58\x3f\x05\x03\xb6\xbd\x53\x16\x86\xca\x49\x36\x9e\x90\x50\xe2\xac\x58\xf9\xcf\xa5\x7b\x4e\x65\x81\x55\x50\x31\x97\x44\x4a\x71\xd3\x9c\xa9\x75\x12\x2d\xc0\x91\x6e\x7f\x3a\xd2\xee\x8c\x63\x13\x71\xf8\x3e\xc6\x45\xc2\xe2\x7d\x56\xbd\xb0\xb7\xe7\x75\xb9\xc9\x60\x4c\x4d\xc3\x52\xee\x54\xb1\x2e\x6a\x3e\x1b\xff\x3a\xf4\x69\xf8\xf7\xa3\x65\x3f\x93\xcb\x41\xd6\xc7\x30\xa8\xb5\x40\x90\x17\xc5\x45\xec\x1f\xc7\x31\xcd\x23\x49\x20\x69\xa0\x8d\x3a\x60\xd8\x70\x7e\x20\x83\xa0\x34\x1d\x48\xdb\x6a\x83\x14\x93\xc7\xb0\xe4\xa9\x96\x5d\x67\x02\xdc\xb5\x69\x5f\x5f\xb8\x4b\x0f\x0d\xd5\xa8\x3f\x8e\x39\x7a\x65\x39\x3a\xc8\xcd\x3e\xcc\x1a\xd8\x89\x7b\x52\x82\x86\x56\x9e\x7c\xd5\xee\xa5\x38\x0f\xbb\x5a\xa6\x94\x4d\x25\x97\x3a\x38\x53\xf8\x28\xdb\xca\xed\x31\x46\x43\xa9\x95\x22\xc0\xf9\xe4\x78\x6f\x69\x13\x59\x11\x96\x0d\xf8\x0b\xe6\x48\xa2\xea\xc6\x9a\x69\x9d\xb2\x26\x4d\xce\xb3\x2e\xa0\x1d\x4d\xb4\x6e\x53\x0d\x74\x15\x8a\x7d\xeb\x10\x2b\x5f\x00\x90\xfd\xce\x50\xcf\x23\x0a\xa3\x47\x41\x96\x08\x4e\x23\x77\x3b\xa2\x70\xbb\x1e\x96\xb4\x47\xe7\x3e\x7c\x97\x5e\xb8\x28\xb9\x9f\xa4\xe4\x23\xbe\x69\x9b\x6e\x38\x78\x8d\x9f\xa1\x62\x24\x65\x6e\xed\xd6\x25\xfa\x2b\x62\xc9\x3a\xf1\x63\x8b\x92\xfd\x6d\x09\x8b\x74\x8c\x7b\x3e\x25\x2d\x66\xb9\x9c\xa2\xa8\x18\x4f\xfb\x57\xb0\x17\xc1\x40\xca\x3f\x80\xd0\x50\xd2\x3f\x4e\x3c\x38\xb4\x5f\xb2\xb9\x72\x5a\x39\x3e\x0a\xe2\x95\x26\x1c\x05\xb5\xeb\x1f\xc5\xd1\x72\xcc\xf7\x07\x56\x21\xa4\x5d\xe6\x4e\xea\x57\xa6\x23\x4c\x65\x27\x17\x4f\xe8\x15\x4d\x09\xe8\x7a\x75\xcc\xfe\xa1\x3c\x2d\xf2\x3e\x7e\x05\x61\xf7\xb0\xfa\xa1\x28\xf4\x75\x9b\xd7\x6e\x7a\x6e\xb3\x20\xb6\x87\xea\x27\xf3\x95\x0b\xb6\x7d\x9b\xb7\xd7\x44\xb1\x4c\x79\x65\x92\x7f\xfc\xe8\x3d\x59\x4a\x3f\xdc\x9a\x1f\xc6\x6f\x37\x72\xee\x7a\xe8\x99\x7a\x3a\xff\x47\x8b\x3e\x9d\x91\xb5\x44\xd8\xac\xeb\xbb\xad\x80\x0a\xb6\xe2\x12\xab\x8c\xd6\x12\xb7\x3f\x01\xb1\xa5\x65\x1f\xb5\x8f\x4d\x8a\xf0\x16\xc8\x32\xd9\x0d\x99\x38\x04\xa9\x10\x92\x2d\xa9\xfb\x94\x1b\x9d\x27\x6b\x00\x7e\x23\xae\xc9\x88\xbd\xa4\x4e\xeb\x60\x78\x2f\xcb\xc0\x0d\xd6\xf6\x28\xb1\xea\x67\xff\x4e\x3f\x51\x32\xd5\xa1\xaa\xa0\xe1\x09\x72\xc9\x49\xc9\x8d\xfd\xc0\x8b\x59\x1d\x5c\x5a\x37\xc8\x9d\x4d\xef\x8e\x26\x2e\xdc\x5e\x7b\x48\x1e\x2a\xfd\x1f\x46\x4f\x19\xba\x5f\x9a\x34\xb2\xb0\x77\x2c\xd0\x5d\xec\x64\xc0\x77\x0a\xb1\xca\x8f\x63\x69\x0f\x06\x9d\x12\x29\xf6\x42\x56\xae\xa8\x24\x49\xde\x6e\xc7\x24\x69\xd8\x43\xa4\xcb\x5f\x13\xe2\x17\xa4\x98\xb3\x54\x9c\xf8\x68\x6d\x5c\x83\xa9\xe6\x25\x68\x1e\x5f\xfe\x55\x9b\xc9\x83\xe6\x8a\xcb\xac\x2a\x8b\x49\x7e\xa7\xba\xbb\x64\x66\xc3\xdb\x4f\x06\xac\x4e\x9d\x23\x9c\x68\x89\x91\xfb\x82\x03\x98\xbe\x17\xeb\xb6\x5a\x7b\xfc\xf1\xcb\x72\x60\x20\xfc\xf0\xa2\xbc\x2a\x88\xe5\xaa\x3a\x1f\x5e\x76\x92\xf5\x8d\x2e\xa0\x3d\x6c\xd0\xd7\xd8\x32\xa2\xc9\x32\x9e\xc1\x3d\xba\x32\x80\x21\xd6\xf8\x06\xc1\x48\x72\xa8\x4d\x39\xe5\x44\x9b\xf2\xd7\xe9\xe9\x28\x92\x5e\xd2\x86\xb4\x07\xc1\xad\xf0\xb9\x23\xc7\xe7\xac\xd8\x90\x41\xac\xb9\x22\xde\x5a\xac\x10\xc0\x6a\x1c\x26\xbd\x63\x4b\x1e\x60\xd0\xf7\xaa\x33\x69\x53\xd2\xd6\xc5\x37\x05\xc8\xe8\xd8\xab\x01\x8d\x6e\xd4\xe6\xd1\xf7\x81\x6d\x75\x75\x79\xd4\xae\xaf\xcc\xe3\xee\xbd\x94\x9a\x97\x65\x3e\xc9\x41\x86\xdb\xcd\x06\x7e\x3e\xf6\xb8\x9e\x93\x85\x5d\xee\x1a\x8d\x8a\x04\x19\xa5\x03\xf1\xdc\x46\x38\xaa\x4b\xf7\x5c\x32\x64\xc5\x49\x11\xa4\x1b\xe5\x2d\xb9\xca\x26\xe4\xba\x1d\x52\xcd\x88\xef\x2a\xba\x8d\xf3\x70\x51\xb9\x63\xec\xd1\x2f\xd7\xdd\x36\x20\xee\x2d\x2b\xdc\x80\x8d\xf5\x03\xf5\xf6\xa6\x25\x43\x2a\xdc\x5f\x9a\xc3\x1c\x52\x8b\x67\xcb\xd9\x26\x8b\x4d\xf8\xf7\x88\xaa\x46\x8e\x25\x16\x6e\x14\x5a\xf5\x0d\x03\x70\x9b\xc0\xfd\xa1\xa3\x58\x51\xf7\x06\x0f\x7c\x49\x74\xe4\x87\x0b\xf0\x42\x35\x2f\x53\xf1\x43\xdb\xf7\xf1\xa4\xbe\xb3\x32\x2f\x5e\x2a\x6f\x60\x4c\xa1\x27\x0d\x1f\xcd\x2d\x43\x46\x1a\x68\x8f\x64\x14\x51\xea\x89\xa3\x21\xf5\xc3\x94\x70\x31\xb3\xdb\xe9\x54\x1f\x8d\x62\x12\x53\x2c\x88\x7c\x8b\xda\x2f\x12\x2a\x53\x99\xd3\x8b\xce\x70\x3e\x4f\x9f\x9e\x9d\x9e\x26\xa7\x8b\x27\x03\x67\xfe\x8f\x47\x4b\x64\xbe\x15\x15\x38\x90\x4a\x46\xc7\xf7\x7b\x4c\xed\xa8\xbf\x47\x9c\xd2\xdb\x2e\x60\x07\x98\x26\xeb\x08\x48\x5f\x5d\x0f\xb0\x3d\xb0\xc8\xbe\xdb\xa9\x2d\x23\x0a\xf8\xb2\x2b\xe3\x4f\xf4\x57\x6a\x38\x07\xf1\x31\xbe\x44\x37\x4d\x31\xe4\xb8\x1a\x46\x6e\x0c\x61\x5f\x77\xbc\xff\x06\x5d\x63\x4c\xd0\x35\x0b\x01\x00")
 
 func configYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -83,7 +83,7 @@ func configYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "config.yaml", size: 14137, mode: os.FileMode(420), modTime: time.Unix(1478399231, 0)}
+	info := bindataFileInfo{name: "config.yaml", size: 68405, mode: os.FileMode(420), modTime: time.Unix(1792037762, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
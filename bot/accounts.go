@@ -0,0 +1,337 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/accounts.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// Google's OAuth endpoints for devices without a browser, such as the bot.
+// https://developers.google.com/identity/protocols/oauth2/limited-input-device
+var (
+	oauthDeviceCodeURL = "https://oauth2.googleapis.com/device/code"
+	oauthTokenURL      = "https://oauth2.googleapis.com/token"
+)
+
+// youtubeReadOnlyScope lets the bot list the playlists and ratings of an
+// account, but not change anything.
+const youtubeReadOnlyScope = "https://www.googleapis.com/auth/youtube.readonly"
+
+// tokenExpiryMargin is how long before it expires an access token is
+// refreshed, so that it does not expire while a request is made.
+const tokenExpiryMargin = time.Minute
+
+var (
+	// ErrAccountNotLinked is returned when a user has not linked an account.
+	ErrAccountNotLinked = errors.New("No YouTube account has been linked")
+	// ErrLinkPending is returned when a user is already linking an account.
+	ErrLinkPending = errors.New("An account is already being linked")
+	// ErrNotRegistered is returned when a user who is not registered on the
+	// server tries to link an account.
+	ErrNotRegistered = errors.New("Only users registered on the server can link an account")
+)
+
+// OAuthToken holds the credentials of an account that a user has linked.
+type OAuthToken struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token"`
+	Expiry       time.Time `json:"expiry"`
+}
+
+// DeviceCode is the code a user enters at VerificationURL to link an account.
+type DeviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURL string `json:"verification_url"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+// oauthResponse is the response of the token endpoint, which reports errors
+// such as "authorization_pending" in its body.
+type oauthResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	Error        string `json:"error"`
+}
+
+// Accounts keeps the YouTube accounts that users have linked with the OAuth
+// device flow, so that the bot can list what only the account owner can see,
+// such as their liked videos. The tokens are saved to accounts.file.
+//
+// Accounts are kept by the ID users are registered under on the server, as
+// anybody can join under the name of another user who is not connected.
+type Accounts struct {
+	tokens  map[uint32]*OAuthToken
+	linking map[uint32]bool
+	loaded  bool
+	mutex   sync.Mutex
+}
+
+// NewAccounts returns an Accounts without any linked accounts.
+func NewAccounts() *Accounts {
+	return &Accounts{
+		tokens:  make(map[uint32]*OAuthToken),
+		linking: make(map[uint32]bool),
+	}
+}
+
+// Configured returns true if an OAuth client has been configured in
+// accounts.youtube, without which accounts cannot be linked.
+func (a *Accounts) Configured() bool {
+	return viper.GetString("accounts.youtube.client_id") != "" && viper.GetString("accounts.youtube.client_secret") != ""
+}
+
+// Linked returns true if `user` has linked an account.
+func (a *Accounts) Linked(user *gumble.User) bool {
+	if !user.IsRegistered() {
+		return false
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	_, ok := a.tokens[user.UserID]
+	return ok
+}
+
+// Link starts linking an account for `user`, who must be registered on the
+// server, and returns the code they must enter. The bot waits for the user in
+// the background and tells them in a private message once the account is
+// linked.
+func (a *Accounts) Link(user *gumble.User) (DeviceCode, error) {
+	if !user.IsRegistered() {
+		return DeviceCode{}, ErrNotRegistered
+	}
+	id := user.UserID
+	a.mutex.Lock()
+	if a.linking[id] {
+		a.mutex.Unlock()
+		return DeviceCode{}, ErrLinkPending
+	}
+	a.linking[id] = true
+	a.mutex.Unlock()
+
+	code, err := requestDeviceCode()
+	if err != nil {
+		a.mutex.Lock()
+		delete(a.linking, id)
+		a.mutex.Unlock()
+		return DeviceCode{}, err
+	}
+	go a.waitForLink(id, user.Name, code)
+	return code, nil
+}
+
+// Unlink forgets the account of `user`. Returns false if they had not linked
+// one.
+func (a *Accounts) Unlink(user *gumble.User) (bool, error) {
+	if !user.IsRegistered() {
+		return false, nil
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if _, ok := a.tokens[user.UserID]; !ok {
+		return false, nil
+	}
+	delete(a.tokens, user.UserID)
+	return true, a.save()
+}
+
+// AccessToken returns a valid access token for the account of `user`,
+// refreshing it if it has expired.
+func (a *Accounts) AccessToken(user *gumble.User) (string, error) {
+	if !user.IsRegistered() {
+		return "", ErrAccountNotLinked
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	token, ok := a.tokens[user.UserID]
+	if !ok {
+		return "", ErrAccountNotLinked
+	}
+	if time.Now().Add(tokenExpiryMargin).Before(token.Expiry) {
+		return token.AccessToken, nil
+	}
+
+	response, err := requestToken(url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {token.RefreshToken},
+	})
+	if err != nil {
+		return "", err
+	}
+	if response.Error != "" {
+		// The user revoked the bot's access, so the account must be linked
+		// again.
+		if response.Error == "invalid_grant" {
+			delete(a.tokens, user.UserID)
+			a.save()
+			return "", ErrAccountNotLinked
+		}
+		return "", fmt.Errorf("Google refused to refresh the access token (%s)", response.Error)
+	}
+	token.AccessToken = response.AccessToken
+	token.Expiry = time.Now().Add(time.Duration(response.ExpiresIn) * time.Second)
+	return token.AccessToken, a.save()
+}
+
+// Load reads the accounts saved to accounts.file, if it exists. The accounts
+// are only loaded once, so that accounts linked since are kept when the bot
+// reconnects.
+func (a *Accounts) Load() error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.loaded {
+		return nil
+	}
+	a.loaded = true
+	data, err := ioutil.ReadFile(os.ExpandEnv(viper.GetString("accounts.file")))
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &a.tokens)
+}
+
+// save writes the accounts to accounts.file, which only the bot may read as
+// it holds the users' credentials. The caller must hold the mutex.
+func (a *Accounts) save() error {
+	data, err := json.Marshal(a.tokens)
+	if err != nil {
+		return err
+	}
+	filePath := os.ExpandEnv(viper.GetString("accounts.file"))
+	if err := os.MkdirAll(filepath.Dir(filePath), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filePath, data, 0600)
+}
+
+// waitForLink polls the token endpoint until the user registered as `id` has
+// entered `code`, declined, or let the code expire, and tells them how it went
+// under their name `name`.
+func (a *Accounts) waitForLink(id uint32, name string, code DeviceCode) {
+	token, err := pollDeviceToken(code)
+
+	a.mutex.Lock()
+	delete(a.linking, id)
+	if err == nil {
+		a.tokens[id] = token
+		err = a.save()
+	}
+	a.mutex.Unlock()
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"user":  name,
+			"error": err.Error(),
+		}).Warnln("Could not link an account.")
+		DJ.SendPrivateMessageToName(name,
+			fmt.Sprintf(DJ.LocalizeFor(name, "accounts.messages.link_failed"), err.Error()))
+		return
+	}
+	logrus.WithFields(logrus.Fields{
+		"user": name,
+	}).Infoln("A user linked their YouTube account.")
+	DJ.SendPrivateMessageToName(name, DJ.LocalizeFor(name, "accounts.messages.linked"))
+}
+
+// requestDeviceCode asks Google for a code the user can enter to grant the
+// bot read access to their YouTube account.
+func requestDeviceCode() (DeviceCode, error) {
+	response, err := http.PostForm(oauthDeviceCodeURL, url.Values{
+		"client_id": {viper.GetString("accounts.youtube.client_id")},
+		"scope":     {youtubeReadOnlyScope},
+	})
+	if err != nil {
+		return DeviceCode{}, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return DeviceCode{}, fmt.Errorf("Google returned status %s", response.Status)
+	}
+
+	var code DeviceCode
+	if err := json.NewDecoder(response.Body).Decode(&code); err != nil {
+		return DeviceCode{}, err
+	}
+	return code, nil
+}
+
+// pollDeviceToken waits until the user has entered `code` and returns the
+// token that is granted. Google asks to be polled no more often than every
+// code.Interval seconds.
+func pollDeviceToken(code DeviceCode) (*OAuthToken, error) {
+	interval := time.Duration(code.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	deadline := time.Now().Add(time.Duration(code.ExpiresIn) * time.Second)
+	for time.Now().Before(deadline) {
+		time.Sleep(interval)
+		response, err := requestToken(url.Values{
+			"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+			"device_code": {code.DeviceCode},
+		})
+		if err != nil {
+			return nil, err
+		}
+		switch response.Error {
+		case "":
+			return &OAuthToken{
+				AccessToken:  response.AccessToken,
+				RefreshToken: response.RefreshToken,
+				Expiry:       time.Now().Add(time.Duration(response.ExpiresIn) * time.Second),
+			}, nil
+		case "authorization_pending":
+		case "slow_down":
+			interval += 5 * time.Second
+		case "access_denied":
+			return nil, errors.New("Access to the account was denied")
+		default:
+			return nil, fmt.Errorf("Google refused to link the account (%s)", response.Error)
+		}
+	}
+	return nil, errors.New("The code expired before it was entered")
+}
+
+// requestToken posts `values` along with the client credentials to the token
+// endpoint. Errors reported in the body are returned in the response.
+func requestToken(values url.Values) (oauthResponse, error) {
+	values.Set("client_id", viper.GetString("accounts.youtube.client_id"))
+	values.Set("client_secret", viper.GetString("accounts.youtube.client_secret"))
+	response, err := http.PostForm(oauthTokenURL, values)
+	if err != nil {
+		return oauthResponse{}, err
+	}
+	defer response.Body.Close()
+
+	var result oauthResponse
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return oauthResponse{}, fmt.Errorf("Google returned status %s", response.Status)
+	}
+	if result.Error == "" && response.StatusCode != http.StatusOK {
+		return oauthResponse{}, fmt.Errorf("Google returned status %s", response.Status)
+	}
+	return result, nil
+}
@@ -0,0 +1,179 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/accounts_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type AccountsTestSuite struct {
+	suite.Suite
+	Accounts *Accounts
+	User     *gumble.User
+	Dir      string
+}
+
+func (suite *AccountsTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+	suite.Accounts = NewAccounts()
+	suite.User = &gumble.User{Name: "user", UserID: 5}
+	suite.Dir, _ = ioutil.TempDir("", "mumbledj-accounts")
+	viper.Set("accounts.file", filepath.Join(suite.Dir, "accounts.json"))
+	viper.Set("accounts.youtube.client_id", "client")
+	viper.Set("accounts.youtube.client_secret", "secret")
+}
+
+func (suite *AccountsTestSuite) TearDownTest() {
+	viper.Set("accounts.youtube.client_id", "")
+	viper.Set("accounts.youtube.client_secret", "")
+	oauthDeviceCodeURL = "https://oauth2.googleapis.com/device/code"
+	oauthTokenURL = "https://oauth2.googleapis.com/token"
+	os.RemoveAll(suite.Dir)
+}
+
+func (suite *AccountsTestSuite) TestConfigured() {
+	suite.True(suite.Accounts.Configured())
+
+	viper.Set("accounts.youtube.client_secret", "")
+
+	suite.False(suite.Accounts.Configured())
+}
+
+func (suite *AccountsTestSuite) TestAccessTokenWithoutAccount() {
+	_, err := suite.Accounts.AccessToken(suite.User)
+
+	suite.Equal(ErrAccountNotLinked, err)
+}
+
+func (suite *AccountsTestSuite) TestAccessTokenIsReusedUntilItExpires() {
+	suite.Accounts.tokens[5] = &OAuthToken{AccessToken: "access", Expiry: time.Now().Add(time.Hour)}
+
+	token, err := suite.Accounts.AccessToken(suite.User)
+
+	suite.Nil(err)
+	suite.Equal("access", token)
+}
+
+func (suite *AccountsTestSuite) TestAccessTokenIsRefreshed() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("refresh_token", r.FormValue("grant_type"))
+		suite.Equal("refresh", r.FormValue("refresh_token"))
+		suite.Equal("secret", r.FormValue("client_secret"))
+		fmt.Fprint(w, `{"access_token": "new", "expires_in": 3600}`)
+	}))
+	defer server.Close()
+	oauthTokenURL = server.URL
+	suite.Accounts.tokens[5] = &OAuthToken{AccessToken: "old", RefreshToken: "refresh"}
+
+	token, err := suite.Accounts.AccessToken(suite.User)
+
+	suite.Nil(err)
+	suite.Equal("new", token)
+	suite.True(suite.Accounts.tokens[5].Expiry.After(time.Now()))
+}
+
+func (suite *AccountsTestSuite) TestRevokedAccountIsUnlinked() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": "invalid_grant"}`)
+	}))
+	defer server.Close()
+	oauthTokenURL = server.URL
+	suite.Accounts.tokens[5] = &OAuthToken{AccessToken: "old", RefreshToken: "refresh"}
+
+	_, err := suite.Accounts.AccessToken(suite.User)
+
+	suite.Equal(ErrAccountNotLinked, err)
+	suite.False(suite.Accounts.Linked(suite.User))
+}
+
+func (suite *AccountsTestSuite) TestPollDeviceTokenWaitsForUser() {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("device", r.FormValue("device_code"))
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusPreconditionRequired)
+			fmt.Fprint(w, `{"error": "authorization_pending"}`)
+			return
+		}
+		fmt.Fprint(w, `{"access_token": "access", "refresh_token": "refresh", "expires_in": 3600}`)
+	}))
+	defer server.Close()
+	oauthTokenURL = server.URL
+
+	token, err := pollDeviceToken(DeviceCode{DeviceCode: "device", ExpiresIn: 10, Interval: 1})
+
+	suite.Nil(err)
+	suite.Equal(2, attempts)
+	suite.Equal("refresh", token.RefreshToken)
+}
+
+func (suite *AccountsTestSuite) TestPollDeviceTokenWhenDenied() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": "access_denied"}`)
+	}))
+	defer server.Close()
+	oauthTokenURL = server.URL
+
+	_, err := pollDeviceToken(DeviceCode{DeviceCode: "device", ExpiresIn: 10, Interval: 1})
+
+	suite.NotNil(err)
+}
+
+func (suite *AccountsTestSuite) TestAccountsAreSavedAndLoaded() {
+	suite.Accounts.tokens[5] = &OAuthToken{AccessToken: "access", RefreshToken: "refresh"}
+	suite.Nil(suite.Accounts.save())
+
+	accounts := NewAccounts()
+	suite.Nil(accounts.Load())
+
+	suite.True(accounts.Linked(suite.User))
+	info, err := os.Stat(viper.GetString("accounts.file"))
+	suite.Nil(err)
+	suite.Equal(os.FileMode(0600), info.Mode().Perm(), "Only the bot should be able to read the credentials.")
+}
+
+func (suite *AccountsTestSuite) TestUnregisteredUsersCannotUseAccounts() {
+	suite.Accounts.tokens[5] = &OAuthToken{AccessToken: "access", Expiry: time.Now().Add(time.Hour)}
+	impostor := &gumble.User{Name: "user"}
+
+	suite.False(suite.Accounts.Linked(impostor), "Accounts should not be found by name.")
+	_, err := suite.Accounts.AccessToken(impostor)
+	suite.Equal(ErrAccountNotLinked, err)
+	_, err = suite.Accounts.Link(impostor)
+	suite.Equal(ErrNotRegistered, err)
+}
+
+func (suite *AccountsTestSuite) TestUnlink() {
+	suite.Accounts.tokens[5] = &OAuthToken{RefreshToken: "refresh"}
+
+	unlinked, err := suite.Accounts.Unlink(suite.User)
+	suite.Nil(err)
+	suite.True(unlinked)
+	suite.False(suite.Accounts.Linked(suite.User))
+
+	unlinked, _ = suite.Accounts.Unlink(suite.User)
+	suite.False(unlinked)
+}
+
+func TestAccountsTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountsTestSuite))
+}
@@ -0,0 +1,261 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/arguments.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// Arguments holds the arguments of a command once they have been checked
+// against its signature. It implements interfaces.Arguments.
+type Arguments struct {
+	values map[string][]string
+	flags  map[string]string
+}
+
+// SplitArguments splits a message into arguments at whitespace, including line
+// breaks. Text enclosed in double quotes is kept together, so that
+// `"daft punk"` is one argument.
+func SplitArguments(message string) []string {
+	var (
+		args    []string
+		current []rune
+		quoted  bool
+		started bool
+	)
+	for _, r := range message {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			started = true
+		case !quoted && (r == ' ' || r == '\t' || r == '\n' || r == '\r'):
+			if started {
+				args = append(args, string(current))
+				current, started = nil, false
+			}
+		default:
+			current = append(current, r)
+			started = true
+		}
+	}
+	if started {
+		args = append(args, string(current))
+	}
+	return args
+}
+
+// ParseArguments checks `args` against `signature` and returns the arguments
+// by name. Flags may be given anywhere; everything after "--" is taken as
+// positional arguments. The returned error is localized for `user`.
+func ParseArguments(user *gumble.User, signature interfaces.Signature, args []string) (*Arguments, error) {
+	parsed := &Arguments{
+		values: make(map[string][]string),
+		flags:  make(map[string]string),
+	}
+
+	var positional []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			positional = append(positional, args[i+1:]...)
+			break
+		}
+		if !strings.HasPrefix(arg, "--") {
+			positional = append(positional, arg)
+			continue
+		}
+
+		name, value, hasValue := arg[2:], "", false
+		if j := strings.Index(name, "="); j != -1 {
+			name, value, hasValue = name[:j], name[j+1:], true
+		}
+		name = strings.ToLower(name)
+		flag, ok := findFlag(signature, name)
+		if !ok {
+			return nil, fmt.Errorf(DJ.Localize(user, "commands.common_messages.unknown_flag_error"), "--"+name)
+		}
+		if !flag.TakesValue {
+			if hasValue {
+				return nil, fmt.Errorf(DJ.Localize(user, "commands.common_messages.invalid_argument_error"), value, "--"+name)
+			}
+			parsed.flags[name] = ""
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				return nil, fmt.Errorf(DJ.Localize(user, "commands.common_messages.missing_argument_error"), "--"+name)
+			}
+			i++
+			value = args[i]
+		}
+		if !validArgument(flag.Type, value) {
+			return nil, fmt.Errorf(DJ.Localize(user, "commands.common_messages.invalid_argument_error"), value, "--"+name)
+		}
+		parsed.flags[name] = value
+	}
+
+	for _, argument := range signature.Arguments {
+		if len(positional) == 0 {
+			if !argument.Optional {
+				return nil, fmt.Errorf(DJ.Localize(user, "commands.common_messages.missing_argument_error"), argument.Name)
+			}
+			continue
+		}
+		values := positional[:1]
+		if argument.Variadic {
+			values = positional
+		}
+		for _, value := range values {
+			if !validArgument(argument.Type, value) {
+				return nil, fmt.Errorf(DJ.Localize(user, "commands.common_messages.invalid_argument_error"), value, argument.Name)
+			}
+		}
+		parsed.values[argument.Name] = values
+		positional = positional[len(values):]
+	}
+	if len(positional) > 0 {
+		return nil, fmt.Errorf(DJ.Localize(user, "commands.common_messages.too_many_arguments_error"), strings.Join(positional, " "))
+	}
+	return parsed, nil
+}
+
+// ExecuteWithArguments checks `args` against the signature of `command` and
+// executes it with the parsed arguments.
+func ExecuteWithArguments(user *gumble.User, command interfaces.SignatureCommand, args []string) (string, bool, error) {
+	parsed, err := ParseArguments(user, command.Signature(), args)
+	if err != nil {
+		return "", true, err
+	}
+	return command.ExecuteArguments(user, parsed)
+}
+
+// Usage returns how a command with `signature` is used under the alias
+// `alias`, such as "!add <url...> [--next] [--shuffle]".
+func Usage(alias string, signature interfaces.Signature) string {
+	parts := []string{usagePrefix() + alias}
+	for _, argument := range signature.Arguments {
+		name := argument.Name
+		if argument.Variadic {
+			name += "..."
+		}
+		if argument.Optional {
+			parts = append(parts, "["+name+"]")
+		} else {
+			parts = append(parts, "<"+name+">")
+		}
+	}
+	for _, flag := range signature.Flags {
+		if flag.TakesValue {
+			parts = append(parts, "[--"+flag.Name+" <value>]")
+		} else {
+			parts = append(parts, "[--"+flag.Name+"]")
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// usagePrefix returns the prefix shown before commands in their usage. The
+// prefix may be left empty when only commands.alternate_prefixes are used.
+func usagePrefix() string {
+	if prefix := viper.GetString("commands.prefix"); prefix != "" {
+		return prefix[:1]
+	}
+	for _, alternate := range viper.GetStringSlice("commands.alternate_prefixes") {
+		if alternate != "" {
+			return alternate
+		}
+	}
+	return ""
+}
+
+// Has returns true if the argument `name` was given.
+func (a *Arguments) Has(name string) bool {
+	_, ok := a.values[name]
+	return ok
+}
+
+// String returns the argument `name`, or the value given to the flag `name`.
+// The values of variadic arguments are joined with spaces.
+func (a *Arguments) String(name string) string {
+	if values, ok := a.values[name]; ok {
+		return strings.Join(values, " ")
+	}
+	return a.flags[name]
+}
+
+// Strings returns the values of the argument `name`.
+func (a *Arguments) Strings(name string) []string {
+	return a.values[name]
+}
+
+// Int returns the argument or flag value `name` as a whole number, or 0 if it
+// was not given.
+func (a *Arguments) Int(name string) int {
+	value, _ := strconv.Atoi(a.String(name))
+	return value
+}
+
+// Duration returns the argument or flag value `name` as a duration, or 0 if
+// it was not given.
+func (a *Arguments) Duration(name string) time.Duration {
+	value, _ := parseDurationArgument(a.String(name))
+	return value
+}
+
+// Flag returns true if the flag `name` was given.
+func (a *Arguments) Flag(name string) bool {
+	_, ok := a.flags[name]
+	return ok
+}
+
+// findFlag returns the flag of `signature` named `name`.
+func findFlag(signature interfaces.Signature, name string) (interfaces.Flag, bool) {
+	for _, flag := range signature.Flags {
+		if flag.Name == name {
+			return flag, true
+		}
+	}
+	return interfaces.Flag{}, false
+}
+
+// validArgument returns true if `value` is a valid value of `argumentType`.
+func validArgument(argumentType interfaces.ArgumentType, value string) bool {
+	switch argumentType {
+	case interfaces.IntArgument:
+		_, err := strconv.Atoi(value)
+		return err == nil
+	case interfaces.DurationArgument:
+		_, err := parseDurationArgument(value)
+		return err == nil
+	}
+	return true
+}
+
+// parseDurationArgument parses a number of seconds, such as "90", a time such
+// as "1:30" or "1:02:30", or a duration such as "1m30s".
+func parseDurationArgument(value string) (time.Duration, error) {
+	if duration, err := time.ParseDuration(value); err == nil {
+		return duration, nil
+	}
+	var seconds int
+	for _, part := range strings.Split(value, ":") {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return 0, fmt.Errorf("\"%s\" is not a duration", value)
+		}
+		seconds = seconds*60 + number
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
@@ -0,0 +1,106 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/arguments_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type ArgumentsTestSuite struct {
+	suite.Suite
+	signature interfaces.Signature
+}
+
+func (suite *ArgumentsTestSuite) SetupSuite() {
+	DJ = NewMumbleDJ()
+	viper.Set("commands.prefix", "!")
+	suite.signature = interfaces.Signature{
+		Arguments: []interfaces.Argument{
+			{Name: "position", Type: interfaces.IntArgument},
+			{Name: "terms", Optional: true, Variadic: true},
+		},
+		Flags: []interfaces.Flag{
+			{Name: "next"},
+			{Name: "start", TakesValue: true, Type: interfaces.DurationArgument},
+		},
+	}
+}
+
+func (suite *ArgumentsTestSuite) TestSplitArguments() {
+	suite.Equal([]string{"add", "daft punk", "--next"}, SplitArguments(`add  "daft punk" --next`))
+	suite.Equal([]string{"add", ""}, SplitArguments(`add ""`))
+	suite.Equal([]string{"add", "first", "second"}, SplitArguments("add\nfirst\r\nsecond"))
+}
+
+func (suite *ArgumentsTestSuite) TestParsesArgumentsAndFlags() {
+	parsed, err := ParseArguments(nil, suite.signature, []string{"3", "--next", "daft", "punk", "--start=1:30"})
+
+	suite.Nil(err)
+	suite.Equal(3, parsed.Int("position"))
+	suite.Equal("daft punk", parsed.String("terms"))
+	suite.True(parsed.Flag("next"))
+	suite.Equal(90*time.Second, parsed.Duration("start"))
+}
+
+func (suite *ArgumentsTestSuite) TestTakesEverythingAfterSeparatorAsArguments() {
+	parsed, err := ParseArguments(nil, suite.signature, []string{"3", "--", "--next"})
+
+	suite.Nil(err)
+	suite.False(parsed.Flag("next"))
+	suite.Equal("--next", parsed.String("terms"))
+}
+
+func (suite *ArgumentsTestSuite) TestRejectsInvalidArguments() {
+	for _, args := range [][]string{
+		{},
+		{"three"},
+		{"3", "--unknown"},
+		{"3", "--start"},
+		{"3", "--start", "soon"},
+		{"3", "--next=yes"},
+	} {
+		_, err := ParseArguments(nil, suite.signature, args)
+		suite.NotNil(err, "%v", args)
+	}
+}
+
+func (suite *ArgumentsTestSuite) TestRejectsExtraArguments() {
+	signature := interfaces.Signature{
+		Arguments: []interfaces.Argument{{Name: "position"}},
+	}
+
+	_, err := ParseArguments(nil, signature, []string{"1", "2"})
+
+	suite.NotNil(err)
+}
+
+func (suite *ArgumentsTestSuite) TestUsage() {
+	suite.Equal("!boost <position> [terms...] [--next] [--start <value>]", Usage("boost", suite.signature))
+}
+
+func (suite *ArgumentsTestSuite) TestUsageWithoutPrefix() {
+	viper.Set("commands.prefix", "")
+	viper.Set("commands.alternate_prefixes", []string{"/dj "})
+	defer viper.Set("commands.prefix", "!")
+	defer viper.Set("commands.alternate_prefixes", []string{"/dj "})
+
+	suite.Equal("/dj boost <position> [terms...] [--next] [--start <value>]", Usage("boost", suite.signature))
+
+	viper.Set("commands.alternate_prefixes", []string{})
+
+	suite.Equal("boost <position> [terms...] [--next] [--start <value>]", Usage("boost", suite.signature))
+}
+
+func TestArgumentsTestSuite(t *testing.T) {
+	suite.Run(t, new(ArgumentsTestSuite))
+}
@@ -0,0 +1,160 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/autostop.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// stopTimeFormat is the 24-hour clock format used for scheduled stop times.
+const stopTimeFormat = "15:04"
+
+// AutoStop pauses playback at a scheduled time of day. The channel is warned
+// shortly beforehand and the audio is faded out rather than cut off.
+type AutoStop struct {
+	at           time.Time
+	warningTimer *time.Timer
+	stopTimer    *time.Timer
+	mutex        sync.Mutex
+}
+
+// NewAutoStop creates an AutoStop with nothing scheduled and returns it.
+func NewAutoStop() *AutoStop {
+	return &AutoStop{}
+}
+
+// ParseStopTime parses a time of day in HH:MM format and returns its next
+// occurrence after `now`.
+func ParseStopTime(clock string, now time.Time) (time.Time, error) {
+	parsed, err := time.Parse(stopTimeFormat, clock)
+	if err != nil {
+		return time.Time{}, errors.New("The stop time must be in HH:MM format")
+	}
+	at := time.Date(now.Year(), now.Month(), now.Day(), parsed.Hour(), parsed.Minute(), 0, 0, now.Location())
+	if !at.After(now) {
+		at = at.AddDate(0, 0, 1)
+	}
+	return at, nil
+}
+
+// Schedule arranges for playback to stop at `at`, replacing any stop that was
+// previously scheduled.
+func (a *AutoStop) Schedule(at time.Time) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.cancel()
+	a.at = at
+
+	untilStop := at.Sub(time.Now())
+	warning := time.Duration(viper.GetInt("autostop.warning")) * time.Second
+	if warning > 0 && untilStop > warning {
+		a.warningTimer = time.AfterFunc(untilStop-warning, a.warn)
+	}
+	a.stopTimer = time.AfterFunc(untilStop, a.stop)
+
+	logrus.WithFields(logrus.Fields{
+		"stop_at": at.Format(time.RFC3339),
+	}).Infoln("Scheduled playback to stop.")
+}
+
+// ScheduleFromConfig schedules a stop at the time of day configured in
+// autostop.time. Nothing is scheduled if the setting is empty.
+func (a *AutoStop) ScheduleFromConfig() error {
+	clock := viper.GetString("autostop.time")
+	if clock == "" {
+		return nil
+	}
+	at, err := ParseStopTime(clock, time.Now())
+	if err != nil {
+		return err
+	}
+	a.Schedule(at)
+	return nil
+}
+
+// Cancel removes the scheduled stop. Returns false if no stop was scheduled.
+func (a *AutoStop) Cancel() bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	scheduled := !a.at.IsZero()
+	a.cancel()
+	return scheduled
+}
+
+// Next returns the time at which playback is scheduled to stop. The second
+// return value is false if no stop is scheduled.
+func (a *AutoStop) Next() (time.Time, bool) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return a.at, !a.at.IsZero()
+}
+
+// cancel stops the pending timers. The caller must hold the mutex.
+func (a *AutoStop) cancel() {
+	if a.warningTimer != nil {
+		a.warningTimer.Stop()
+		a.warningTimer = nil
+	}
+	if a.stopTimer != nil {
+		a.stopTimer.Stop()
+		a.stopTimer = nil
+	}
+	a.at = time.Time{}
+}
+
+// warn lets the channel know that playback will stop soon.
+func (a *AutoStop) warn() {
+	at, ok := a.Next()
+	if !ok {
+		return
+	}
+	minutes := int(time.Duration(viper.GetInt("autostop.warning")) * time.Second / time.Minute)
+	DJ.Notify("autostop_warning", "", fmt.Sprintf(viper.GetString("autostop.messages.warning"),
+		at.Format(stopTimeFormat), minutes))
+}
+
+// stop fades out the current track and pauses it. If a daily stop time is
+// configured, the next one is scheduled afterwards.
+func (a *AutoStop) stop() {
+	a.mutex.Lock()
+	a.at = time.Time{}
+	a.warningTimer = nil
+	a.stopTimer = nil
+	a.mutex.Unlock()
+
+	logrus.Infoln("Scheduled stop time reached. Pausing playback...")
+	if stream := DJ.AudioStream; stream != nil {
+		fadeOut := time.Duration(viper.GetInt("autostop.fade_duration")) * time.Second
+		steps := int(fadeOut / (100 * time.Millisecond))
+		for i := steps; i > 0; i-- {
+			stream.Volume = DJ.Volume * float32(i) / float32(steps)
+			time.Sleep(100 * time.Millisecond)
+		}
+		if err := DJ.Queue.PauseCurrent(); err == nil {
+			DJ.Notify("autostop_stopped", "", viper.GetString("autostop.messages.stopped"))
+		}
+		// The stream is paused, so restoring the volume is inaudible until
+		// playback is resumed.
+		stream.Volume = DJ.Volume
+	}
+
+	if err := a.ScheduleFromConfig(); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Warnln("Could not schedule the next stop time.")
+	}
+}
@@ -0,0 +1,61 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/autostop_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AutoStopTestSuite struct {
+	suite.Suite
+	Now time.Time
+}
+
+func (suite *AutoStopTestSuite) SetupSuite() {
+	suite.Now = time.Date(2016, time.June, 1, 20, 0, 0, 0, time.Local)
+}
+
+func (suite *AutoStopTestSuite) TestParseStopTimeLaterToday() {
+	at, err := ParseStopTime("23:30", suite.Now)
+
+	suite.Nil(err, "No error should be returned.")
+	suite.Equal(time.Date(2016, time.June, 1, 23, 30, 0, 0, time.Local), at)
+}
+
+func (suite *AutoStopTestSuite) TestParseStopTimeAlreadyPassedToday() {
+	at, err := ParseStopTime("08:15", suite.Now)
+
+	suite.Nil(err, "No error should be returned.")
+	suite.Equal(time.Date(2016, time.June, 2, 8, 15, 0, 0, time.Local), at, "The stop should be scheduled for tomorrow.")
+}
+
+func (suite *AutoStopTestSuite) TestParseStopTimeWhenInvalid() {
+	_, err := ParseStopTime("8pm", suite.Now)
+
+	suite.NotNil(err, "An error should be returned for an invalid time.")
+}
+
+func (suite *AutoStopTestSuite) TestScheduleAndCancel() {
+	autoStop := NewAutoStop()
+	at := time.Now().Add(time.Hour)
+
+	autoStop.Schedule(at)
+	next, ok := autoStop.Next()
+
+	suite.True(ok, "A stop should be scheduled.")
+	suite.Equal(at, next)
+	suite.True(autoStop.Cancel(), "Cancelling a scheduled stop should succeed.")
+	suite.False(autoStop.Cancel(), "Cancelling again should report that nothing was scheduled.")
+}
+
+func TestAutoStopTestSuite(t *testing.T) {
+	suite.Run(t, new(AutoStopTestSuite))
+}
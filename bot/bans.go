@@ -0,0 +1,106 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/bans.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// Bans keeps a copy of the ban list of the Mumble server, so that users who
+// are banned or muted on the server can be refused commands if bans.enabled is
+// set. The server only sends its ban list to users who are allowed to ban, so
+// the bot's account needs that permission.
+type Bans struct {
+	entries    []gumble.Ban
+	refreshing bool
+	mutex      sync.Mutex
+}
+
+// NewBans returns an empty Bans.
+func NewBans() *Bans {
+	return &Bans{}
+}
+
+// Update replaces the known ban list with `bans`.
+func (b *Bans) Update(bans gumble.BanList) {
+	entries := make([]gumble.Ban, 0, len(bans))
+	for _, ban := range bans {
+		entries = append(entries, *ban)
+	}
+
+	b.mutex.Lock()
+	b.entries = entries
+	b.mutex.Unlock()
+	logrus.WithFields(logrus.Fields{
+		"num_bans": len(entries),
+	}).Infoln("Updated the server ban list.")
+}
+
+// Blocks returns true if `user` may not use commands because they are banned
+// on the server, or muted by it if bans.include_muted is set. Nobody is
+// blocked while bans.enabled is off.
+func (b *Bans) Blocks(user *gumble.User) bool {
+	if !viper.GetBool("bans.enabled") {
+		return false
+	}
+	if user.Muted && viper.GetBool("bans.include_muted") {
+		return true
+	}
+	return b.isBanned(user.Name, user.Hash, time.Now())
+}
+
+// isBanned returns true if a ban in effect at `now` names the user `name` or
+// their certificate hash `hash`.
+func (b *Bans) isBanned(name, hash string, now time.Time) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for _, ban := range b.entries {
+		if ban.Duration > 0 && now.After(ban.Start.Add(ban.Duration)) {
+			continue
+		}
+		if (ban.Name != "" && strings.EqualFold(ban.Name, name)) || (ban.Hash != "" && ban.Hash == hash) {
+			return true
+		}
+	}
+	return false
+}
+
+// Refresh asks the server for its ban list, which arrives as a ban list
+// event.
+func (b *Bans) Refresh() {
+	if !viper.GetBool("bans.enabled") || DJ.Client == nil {
+		return
+	}
+	DJ.Client.Do(func() {
+		DJ.Client.RequestBanList()
+	})
+}
+
+// RefreshPeriodically refreshes the ban list every bans.refresh_interval
+// minutes, as the server does not announce when users are unbanned. Only one
+// refresh loop runs, however often the bot reconnects.
+func (b *Bans) RefreshPeriodically() {
+	b.mutex.Lock()
+	if b.refreshing || viper.GetInt("bans.refresh_interval") <= 0 {
+		b.mutex.Unlock()
+		return
+	}
+	b.refreshing = true
+	b.mutex.Unlock()
+
+	for {
+		time.Sleep(time.Duration(viper.GetInt("bans.refresh_interval")) * time.Minute)
+		b.Refresh()
+	}
+}
@@ -0,0 +1,72 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/bans_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type BansTestSuite struct {
+	suite.Suite
+	Bans *Bans
+}
+
+func (suite *BansTestSuite) SetupTest() {
+	viper.Set("bans.enabled", true)
+	viper.Set("bans.include_muted", true)
+	suite.Bans = NewBans()
+	var list gumble.BanList
+	list.Add(net.IPv4(10, 0, 0, 1), net.CIDRMask(32, 32), "spam", 0).Name = "Spammer"
+	hashBan := list.Add(net.IPv4(10, 0, 0, 2), net.CIDRMask(32, 32), "", 0)
+	hashBan.Hash = "abc123"
+	expired := list.Add(net.IPv4(10, 0, 0, 3), net.CIDRMask(32, 32), "", time.Hour)
+	expired.Name = "Forgiven"
+	expired.Start = time.Now().Add(-2 * time.Hour)
+	suite.Bans.Update(list)
+}
+
+func (suite *BansTestSuite) TearDownTest() {
+	viper.Set("bans.enabled", false)
+}
+
+func (suite *BansTestSuite) TestBlocksBannedNames() {
+	suite.True(suite.Bans.Blocks(&gumble.User{Name: "spammer"}))
+	suite.False(suite.Bans.Blocks(&gumble.User{Name: "Listener"}))
+}
+
+func (suite *BansTestSuite) TestBlocksBannedCertificates() {
+	suite.True(suite.Bans.Blocks(&gumble.User{Name: "NewName", Hash: "abc123"}))
+}
+
+func (suite *BansTestSuite) TestIgnoresExpiredBans() {
+	suite.False(suite.Bans.Blocks(&gumble.User{Name: "Forgiven"}))
+}
+
+func (suite *BansTestSuite) TestBlocksMutedUsers() {
+	suite.True(suite.Bans.Blocks(&gumble.User{Name: "Listener", Muted: true}))
+
+	viper.Set("bans.include_muted", false)
+
+	suite.False(suite.Bans.Blocks(&gumble.User{Name: "Listener", Muted: true}))
+}
+
+func (suite *BansTestSuite) TestBlocksNobodyWhenDisabled() {
+	viper.Set("bans.enabled", false)
+
+	suite.False(suite.Bans.Blocks(&gumble.User{Name: "Spammer"}))
+}
+
+func TestBansTestSuite(t *testing.T) {
+	suite.Run(t, new(BansTestSuite))
+}
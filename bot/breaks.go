@@ -0,0 +1,100 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/breaks.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumbleffmpeg"
+	"github.com/spf13/viper"
+)
+
+// Breaks keeps track of how long audio has been playing without interruption
+// so that a short break can be taken after every breaks.interval minutes.
+type Breaks struct {
+	playtime time.Duration
+	mutex    sync.Mutex
+}
+
+// NewBreaks returns a Breaks with no playtime recorded.
+func NewBreaks() *Breaks {
+	return &Breaks{}
+}
+
+// AddPlaytime records that audio has played for the given duration. Returns
+// true if a break is due, in which case the recorded playtime is reset.
+func (b *Breaks) AddPlaytime(played time.Duration) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	interval := time.Duration(viper.GetInt("breaks.interval")) * time.Minute
+	if interval <= 0 {
+		return false
+	}
+	b.playtime += played
+	if b.playtime < interval {
+		return false
+	}
+	b.playtime = 0
+	return true
+}
+
+// Reset clears the recorded playtime, such as when the queue runs out of
+// tracks and playback is no longer continuous.
+func (b *Breaks) Reset() {
+	b.mutex.Lock()
+	b.playtime = 0
+	b.mutex.Unlock()
+}
+
+// TakeBreakIfDue records the playtime of the track that just finished and, if
+// a break is due, announces it, plays the configured jingle and waits until
+// the break is over.
+func (b *Breaks) TakeBreakIfDue(played time.Duration) {
+	if !b.AddPlaytime(played) || DJ.Queue.Length() < 2 {
+		return
+	}
+
+	duration := time.Duration(viper.GetInt("breaks.duration")) * time.Second
+	logrus.WithFields(logrus.Fields{
+		"duration": duration.String(),
+	}).Infoln("Taking a break...")
+	DJ.Notify("break_started", "", fmt.Sprintf(viper.GetString("breaks.messages.break_started"), duration.String()))
+
+	start := time.Now()
+	if jingle := os.ExpandEnv(viper.GetString("breaks.jingle")); jingle != "" {
+		if err := playFile(jingle); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"file":  jingle,
+				"error": err.Error(),
+			}).Warnln("Could not play the break jingle.")
+		}
+	}
+	if remaining := duration - time.Since(start); remaining > 0 {
+		time.Sleep(remaining)
+	}
+}
+
+// playFile plays the audio file at `path` at the current volume and waits
+// until it has finished.
+func playFile(path string) error {
+	stream := gumbleffmpeg.New(DJ.Client, gumbleffmpeg.SourceFile(path))
+	stream.Volume = DJ.Volume
+	if viper.GetString("defaults.player_command") == "avconv" {
+		stream.Command = "avconv"
+	}
+	if err := stream.Play(); err != nil {
+		return err
+	}
+	stream.Wait()
+	return nil
+}
@@ -0,0 +1,53 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/breaks_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type BreaksTestSuite struct {
+	suite.Suite
+	Breaks *Breaks
+}
+
+func (suite *BreaksTestSuite) SetupTest() {
+	suite.Breaks = NewBreaks()
+	viper.Set("breaks.interval", 10)
+}
+
+func (suite *BreaksTestSuite) TearDownSuite() {
+	viper.Set("breaks.interval", 0)
+}
+
+func (suite *BreaksTestSuite) TestAddPlaytimeWhenDisabled() {
+	viper.Set("breaks.interval", 0)
+
+	suite.False(suite.Breaks.AddPlaytime(time.Hour), "No break should be due when breaks are disabled.")
+}
+
+func (suite *BreaksTestSuite) TestAddPlaytimeUntilBreakIsDue() {
+	suite.False(suite.Breaks.AddPlaytime(6 * time.Minute))
+	suite.True(suite.Breaks.AddPlaytime(5*time.Minute), "A break should be due after ten minutes of playback.")
+	suite.False(suite.Breaks.AddPlaytime(5*time.Minute), "The playtime should be reset after a break.")
+}
+
+func (suite *BreaksTestSuite) TestReset() {
+	suite.Breaks.AddPlaytime(9 * time.Minute)
+	suite.Breaks.Reset()
+
+	suite.False(suite.Breaks.AddPlaytime(2 * time.Minute), "The playtime should have been cleared.")
+}
+
+func TestBreaksTestSuite(t *testing.T) {
+	suite.Run(t, new(BreaksTestSuite))
+}
@@ -0,0 +1,190 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/buildinfo.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// latestReleaseURL is the GitHub API endpoint that describes the latest
+// release of a repository.
+var latestReleaseURL = "https://api.github.com/repos/%s/releases/latest"
+
+// releaseClient is used to look up the latest release.
+var releaseClient = &http.Client{Timeout: 10 * time.Second}
+
+// BuildInfo describes the running bot and its environment.
+type BuildInfo struct {
+	Version    string
+	Commit     string
+	GoVersion  string
+	Downloader string
+	Services   []string
+}
+
+// Updates remembers the newest release found by the update check, and the
+// release the admins were last told about.
+type Updates struct {
+	latest    string
+	announced string
+	mutex     sync.Mutex
+}
+
+// NewUpdates returns an Updates that has not found any release yet.
+func NewUpdates() *Updates {
+	return &Updates{}
+}
+
+// BuildInfo returns the version and commit the bot was built from, the Go
+// version it was built with, the version of youtube-dl found at runtime and
+// the services that are enabled.
+func (dj *MumbleDJ) BuildInfo() BuildInfo {
+	info := BuildInfo{
+		Version:    dj.Version,
+		Commit:     dj.Commit,
+		GoVersion:  runtime.Version(),
+		Downloader: "unknown",
+	}
+	if info.Commit == "" {
+		info.Commit = "unknown"
+	}
+	if output, err := exec.Command(downloaderCommand(), "--version").Output(); err == nil {
+		info.Downloader = downloaderCommand() + " " + strings.TrimSpace(string(output))
+	}
+	info.Services = dj.ServiceNames()
+	return info
+}
+
+// Latest returns the newest release found by the update check if it is newer
+// than the running version, or an empty string otherwise.
+func (u *Updates) Latest() string {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	if u.latest == "" || !isNewerVersion(u.latest, DJ.Version) {
+		return ""
+	}
+	return u.latest
+}
+
+// Check looks up the latest release of updates.repository on GitHub, if
+// updates.check is enabled, and sends a private message to the admins in the
+// bot's channel when it is newer than the running version. The check runs
+// whenever the bot connects, but admins are only told once about each release.
+func (u *Updates) Check() {
+	if !viper.GetBool("updates.check") {
+		return
+	}
+	latest, err := fetchLatestRelease(viper.GetString("updates.repository"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"repository": viper.GetString("updates.repository"),
+			"error":      err.Error(),
+		}).Warnln("Could not check for a new release.")
+		return
+	}
+
+	u.mutex.Lock()
+	u.latest = latest
+	u.mutex.Unlock()
+	if !isNewerVersion(latest, DJ.Version) || !u.announce(latest) {
+		return
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"version": DJ.Version,
+		"latest":  latest,
+	}).Infoln("A new release is available.")
+	if DJ.Client == nil {
+		return
+	}
+	message := fmt.Sprintf(viper.GetString("updates.messages.update_available"), latest, DJ.Version,
+		"https://github.com/"+viper.GetString("updates.repository")+"/releases/latest")
+	for _, name := range viper.GetStringSlice("admins.names") {
+		DJ.SendPrivateMessageToName(name, message)
+	}
+}
+
+// announce returns true if the admins have not been told about the release
+// `latest` yet, and remembers that they have.
+func (u *Updates) announce(latest string) bool {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	if u.announced == latest {
+		return false
+	}
+	u.announced = latest
+	return true
+}
+
+// fetchLatestRelease returns the tag of the latest release of the GitHub
+// repository `repository`, e.g. "matthieugrieger/mumbledj".
+func fetchLatestRelease(repository string) (string, error) {
+	response, err := releaseClient.Get(fmt.Sprintf(latestReleaseURL, repository))
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("GitHub returned status %s", response.Status)
+	}
+
+	var release struct {
+		TagName string `json:"tag_name"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&release); err != nil {
+		return "", err
+	}
+	if release.TagName == "" {
+		return "", fmt.Errorf("The latest release of %s has no tag", repository)
+	}
+	return release.TagName, nil
+}
+
+// isNewerVersion returns true if the version `a`, such as "v3.2.1", is newer
+// than the version `b`. Missing components count as 0, and anything after a
+// "-" is ignored.
+func isNewerVersion(a, b string) bool {
+	partsA, partsB := versionParts(a), versionParts(b)
+	for len(partsA) < len(partsB) {
+		partsA = append(partsA, 0)
+	}
+	for len(partsB) < len(partsA) {
+		partsB = append(partsB, 0)
+	}
+	for i := range partsA {
+		if partsA[i] != partsB[i] {
+			return partsA[i] > partsB[i]
+		}
+	}
+	return false
+}
+
+// versionParts splits a version such as "v3.2.1" into its numeric components.
+func versionParts(version string) []int {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.Index(version, "-"); i != -1 {
+		version = version[:i]
+	}
+	var parts []int
+	for _, part := range strings.Split(version, ".") {
+		number, _ := strconv.Atoi(part)
+		parts = append(parts, number)
+	}
+	return parts
+}
@@ -0,0 +1,102 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/buildinfo_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type BuildInfoTestSuite struct {
+	suite.Suite
+}
+
+func (suite *BuildInfoTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+	DJ.Version = "v3.2.1"
+}
+
+func (suite *BuildInfoTestSuite) TearDownTest() {
+	viper.Set("updates.check", false)
+	latestReleaseURL = "https://api.github.com/repos/%s/releases/latest"
+}
+
+func (suite *BuildInfoTestSuite) TestBuildInfo() {
+	info := DJ.BuildInfo()
+
+	suite.Equal("v3.2.1", info.Version)
+	suite.Equal("unknown", info.Commit, "A missing commit should be reported as unknown.")
+	suite.NotEmpty(info.GoVersion)
+}
+
+func (suite *BuildInfoTestSuite) TestIsNewerVersion() {
+	suite.True(isNewerVersion("v3.3.0", "v3.2.1"))
+	suite.True(isNewerVersion("v3.2.1.1", "v3.2.1"))
+	suite.True(isNewerVersion("v10.0.0", "v9.9.9"))
+	suite.False(isNewerVersion("v3.2.1", "v3.2.1"))
+	suite.False(isNewerVersion("3.2", "v3.2.0"))
+	suite.False(isNewerVersion("v3.1.9", "v3.2.1"))
+	suite.False(isNewerVersion("v3.2.1-rc1", "v3.2.1"))
+}
+
+func (suite *BuildInfoTestSuite) TestCheckFindsNewerRelease() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("/repos/owner/repo/releases/latest", r.URL.Path)
+		fmt.Fprint(w, `{"tag_name": "v3.3.0"}`)
+	}))
+	defer server.Close()
+	latestReleaseURL = server.URL + "/repos/%s/releases/latest"
+	viper.Set("updates.check", true)
+	viper.Set("updates.repository", "owner/repo")
+
+	DJ.Updates.Check()
+
+	suite.Equal("v3.3.0", DJ.Updates.Latest())
+}
+
+func (suite *BuildInfoTestSuite) TestCheckAnnouncesReleaseOnce() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"tag_name": "v3.3.0"}`)
+	}))
+	defer server.Close()
+	latestReleaseURL = server.URL + "/repos/%s/releases/latest"
+	viper.Set("updates.check", true)
+
+	DJ.Updates.Check()
+
+	suite.False(DJ.Updates.announce("v3.3.0"), "Admins should not be told about a release again when the bot reconnects.")
+	suite.True(DJ.Updates.announce("v3.4.0"), "Admins should be told about a newer release.")
+}
+
+func (suite *BuildInfoTestSuite) TestLatestIgnoresOlderRelease() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"tag_name": "v3.2.1"}`)
+	}))
+	defer server.Close()
+	latestReleaseURL = server.URL + "/repos/%s/releases/latest"
+	viper.Set("updates.check", true)
+
+	DJ.Updates.Check()
+
+	suite.Empty(DJ.Updates.Latest())
+}
+
+func (suite *BuildInfoTestSuite) TestCheckWhenDisabled() {
+	DJ.Updates.Check()
+
+	suite.Empty(DJ.Updates.Latest())
+}
+
+func TestBuildInfoTestSuite(t *testing.T) {
+	suite.Run(t, new(BuildInfoTestSuite))
+}
@@ -9,11 +9,12 @@ package bot
 
 import (
 	"errors"
-	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/Sirupsen/logrus"
@@ -46,6 +47,11 @@ func (a SortFilesByAge) Less(i, j int) bool {
 type Cache struct {
 	NumAudioFiles int
 	TotalFileSize int64
+	results       map[string][]CachedTrack
+	// tracks indexes the cached tracks by the keys of their links. It is
+	// built the first time a track is looked up.
+	tracks map[string]CachedTrack
+	mutex  sync.Mutex
 }
 
 // NewCache creates an empty Cache and returns it.
@@ -53,6 +59,7 @@ func NewCache() *Cache {
 	return &Cache{
 		NumAudioFiles: 0,
 		TotalFileSize: 0,
+		results:       make(map[string][]CachedTrack),
 	}
 }
 
@@ -86,6 +93,14 @@ func (c *Cache) CleanPeriodically() {
 		logrus.Infoln("Checking cache for expired files...")
 		files, _ := ioutil.ReadDir(os.ExpandEnv(viper.GetString("cache.directory")))
 		for _, file := range files {
+			// Sidecars are removed together with their audio file, or on their own
+			// once the audio file is gone.
+			if isSidecar(file.Name()) {
+				if _, err := os.Stat(cachePath(strings.TrimSuffix(file.Name(), sidecarExtension))); os.IsNotExist(err) {
+					os.Remove(cachePath(file.Name()))
+				}
+				continue
+			}
 			// It is safe to check the modification time because when audio files are
 			// played their modification time is updated. This ensures that audio
 			// files will not get deleted while they are playing, assuming a reasonable
@@ -95,19 +110,22 @@ func (c *Cache) CleanPeriodically() {
 				logrus.WithFields(logrus.Fields{
 					"expired_file": file.Name(),
 				}).Infoln("Removing expired cache entry.")
-				os.Remove(fmt.Sprintf("%s/%s", os.ExpandEnv(viper.GetString("cache.directory")), file.Name()))
+				removeCachedFile(file.Name())
 			}
 		}
 	}
 }
 
-// DeleteOldest deletes the oldest file in the cache.
+// DeleteOldest deletes the oldest audio file in the cache along with its
+// sidecar.
 func (c *Cache) DeleteOldest() error {
 	files, _ := ioutil.ReadDir(os.ExpandEnv(viper.GetString("cache.directory")))
-	if len(files) > 0 {
-		sort.Sort(SortFilesByAge(files))
-		os.Remove(fmt.Sprintf("%s/%s", os.ExpandEnv(viper.GetString("cache.directory")), files[0].Name()))
-		return nil
+	sort.Sort(SortFilesByAge(files))
+	for _, file := range files {
+		if !isSidecar(file.Name()) {
+			removeCachedFile(file.Name())
+			return nil
+		}
 	}
 	return errors.New("There are no files currently cached")
 }
@@ -135,10 +153,23 @@ func (c *Cache) DeleteAll() error {
 }
 
 func (c *Cache) getCurrentStatistics() (int, int64) {
-	var totalSize int64
+	var (
+		numAudioFiles int
+		totalSize     int64
+	)
 	files, _ := ioutil.ReadDir(os.ExpandEnv(viper.GetString("cache.directory")))
 	for _, file := range files {
+		if !isSidecar(file.Name()) {
+			numAudioFiles++
+		}
 		totalSize += file.Size()
 	}
-	return len(files), totalSize
+	return numAudioFiles, totalSize
+}
+
+// removeCachedFile removes the audio file with name `name` and its sidecar from
+// the cache directory.
+func removeCachedFile(name string) {
+	os.Remove(cachePath(name))
+	os.Remove(cachePath(name + sidecarExtension))
 }
@@ -9,11 +9,15 @@ package bot
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 
 	"github.com/Sirupsen/logrus"
 	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
 )
 
 // SetDefaultConfig sets default values for all configuration options.
@@ -21,6 +25,30 @@ func SetDefaultConfig() {
 	// API key defaults.
 	viper.SetDefault("api_keys.youtube", "")
 	viper.SetDefault("api_keys.soundcloud", "")
+	viper.SetDefault("api_keys.jamendo", "")
+
+	// Login defaults.
+	viper.SetDefault("logins.youtube.cookies", "")
+	viper.SetDefault("logins.niconico.username", "")
+	viper.SetDefault("logins.niconico.password", "")
+
+	// Funkwhale defaults.
+	viper.SetDefault("funkwhale.url", "")
+	viper.SetDefault("funkwhale.token", "")
+	viper.SetDefault("funkwhale.pods", []string{})
+
+	// Jellyfin defaults.
+	viper.SetDefault("jellyfin.url", "")
+	viper.SetDefault("jellyfin.api_key", "")
+
+	// Plex defaults.
+	viper.SetDefault("plex.url", "")
+	viper.SetDefault("plex.token", "")
+
+	// Subsonic defaults.
+	viper.SetDefault("subsonic.url", "")
+	viper.SetDefault("subsonic.username", "")
+	viper.SetDefault("subsonic.password", "")
 
 	// General defaults.
 	viper.SetDefault("defaults.comment", "Hello! I am a bot. Type !help for a list of commands.")
@@ -31,9 +59,41 @@ func SetDefaultConfig() {
 	viper.SetDefault("queue.track_skip_ratio", 0.5)
 	viper.SetDefault("queue.playlist_skip_ratio", 0.5)
 	viper.SetDefault("queue.max_track_duration", 0)
+	viper.SetDefault("queue.max_track_duration_overrides.twitch", 0)
 	viper.SetDefault("queue.max_tracks_per_playlist", 50)
+	viper.SetDefault("queue.max_queue_duration", 0)
+	viper.SetDefault("queue.recently_played_window", 0)
+	viper.SetDefault("queue.boost_reorder", false)
+	viper.SetDefault("queue.boost_max_jump", 3)
 	viper.SetDefault("queue.automatic_shuffle_on", false)
+	viper.SetDefault("queue.event_mode_on", false)
+	viper.SetDefault("queue.event_hosts", []string{})
 	viper.SetDefault("queue.announce_new_tracks", true)
+	viper.SetDefault("queue.notify_submitters", false)
+	viper.SetDefault("queue.announce_privately", false)
+	viper.SetDefault("queue.shuffle_added_playlists", false)
+	viper.SetDefault("queue.split_chapters", false)
+	viper.SetDefault("queue.default_queue_name", "main")
+	viper.SetDefault("queue.watchdog_timeout", 30)
+	viper.SetDefault("queue.departed_submitters", "keep")
+	viper.SetDefault("queue.departed_grace", 120)
+	viper.SetDefault("queue.when_empty", "silent")
+	viper.SetDefault("queue.fallback_stream", "")
+	viper.SetDefault("queue.empty_channel", "")
+	viper.SetDefault("queue.rejoin_on_play", true)
+	viper.SetDefault("queue.title_scrub_patterns", []string{
+		`(?i)\s*[\(\[][^\)\]]*\b(official|video|audio|lyrics?|visuali[sz]er|hd|hq|4k|remastered)\b[^\)\]]*[\)\]]`,
+		`(?i)\s+-\s+(official\s+)?(music\s+video|lyrics?|audio)\s*$`,
+		`\s+\|.*$`,
+	})
+	viper.SetDefault("queue.messages.now_playing", "Your track <i>%s</i> is now playing!")
+	viper.SetDefault("queue.messages.now_playing_short", "Now playing: <i>%s</i> (%s), added by <b>%s</b>.")
+	viper.SetDefault("queue.messages.track_failed", "Your track <i>%s</i> could not be played and has been skipped: %s")
+	viper.SetDefault("queue.messages.live", "live")
+	viper.SetDefault("queue.messages.track_note", "Last time: <i>\"%s\"</i> (noted by <b>%s</b>)")
+	viper.SetDefault("queue.messages.radio_now_playing", "Now playing on <b>%s</b>: <i>%s</i>")
+	viper.SetDefault("queue.messages.departed_removed", "Removed <b>%d</b> track(s) added by <b>%s</b>, who has left.")
+	viper.SetDefault("queue.messages.departed_demoted", "Moved <b>%d</b> track(s) added by <b>%s</b>, who has left, to the back of the queue.")
 
 	// Connection defaults.
 	viper.SetDefault("connection.address", "127.0.0.1")
@@ -48,6 +108,11 @@ func SetDefaultConfig() {
 	viper.SetDefault("connection.retry_enabled", true)
 	viper.SetDefault("connection.retry_attempts", 10)
 	viper.SetDefault("connection.retry_interval", 5)
+	viper.SetDefault("connection.keep_queue", true)
+	viper.SetDefault("connection.reconnect_prefetch", 3)
+	viper.SetDefault("connection.fallback_servers", []string{})
+	viper.SetDefault("connection.failover_after", 60)
+	viper.SetDefault("connection.return_interval", 300)
 
 	// Cache defaults.
 	viper.SetDefault("cache.enabled", false)
@@ -55,35 +120,229 @@ func SetDefaultConfig() {
 	viper.SetDefault("cache.expire_time", 24)
 	viper.SetDefault("cache.check_interval", 5)
 	viper.SetDefault("cache.directory", "$HOME/.cache/mumbledj")
+	viper.SetDefault("cache.measure_loudness", true)
+
+	viper.SetDefault("downloads.command", "youtube-dl")
+	viper.SetDefault("downloads.mode", "auto")
+	viper.SetDefault("downloads.generic_fallback", false)
+	viper.SetDefault("downloads.search_fallback", true)
+	viper.SetDefault("downloads.metadata_preference", "api")
+	viper.SetDefault("downloads.geo_bypass", true)
+	viper.SetDefault("downloads.geo_proxy", "")
+
+	// State defaults.
+	viper.SetDefault("state.file", "$HOME/.config/mumbledj/state.json")
+
+	// Note defaults.
+	viper.SetDefault("notes.file", "$HOME/.config/mumbledj/notes.json")
+
+	// Account defaults.
+	viper.SetDefault("accounts.youtube.client_id", "")
+	viper.SetDefault("accounts.youtube.client_secret", "")
+	viper.SetDefault("accounts.file", "$HOME/.config/mumbledj/accounts.json")
+	viper.SetDefault("accounts.messages.linked", "Your YouTube account has been linked. You can now add your liked videos with !addliked.")
+	viper.SetDefault("accounts.messages.link_failed", "Your YouTube account could not be linked: %s")
+
+	viper.SetDefault("updates.check", false)
+	viper.SetDefault("updates.repository", "matthieugrieger/mumbledj")
+	viper.SetDefault("updates.messages.update_available", "A new version of MumbleDJ is available: <b>%s</b> (running <b>%s</b>). See <a href=\"%s\">the release notes</a>.")
+
+	viper.SetDefault("seed.source", "")
+	viper.SetDefault("seed.autoplay", true)
+	viper.SetDefault("seed.shuffle", false)
+
+	// AutoStop defaults.
+	viper.SetDefault("autostop.time", "")
+	viper.SetDefault("autostop.warning", 300)
+	viper.SetDefault("autostop.fade_duration", 10)
+	viper.SetDefault("autostop.messages.warning", "Heads up! Music will stop at <b>%s</b>, in about <b>%d</b> minutes.")
+	viper.SetDefault("autostop.messages.stopped", "It's time! Playback has been paused for the scheduled stop.")
+
+	// Breaks defaults.
+	viper.SetDefault("breaks.interval", 0)
+	viper.SetDefault("breaks.duration", 60)
+	viper.SetDefault("breaks.jingle", "")
+	viper.SetDefault("breaks.messages.break_started", "Time for a short break! The music will continue in <b>%s</b>.")
+
+	// Vote window defaults.
+	viper.SetDefault("vote_window.duration", 0)
+	viper.SetDefault("vote_window.veto_ratio", 0.5)
+	viper.SetDefault("vote_window.messages.window_opened", "Up next: <i>%s</i>, added by <b>%s</b>. Type !veto within <b>%s</b> to skip it.")
+	viper.SetDefault("vote_window.messages.track_vetoed", "<i>%s</i> has been vetoed and will not be played.")
+
+	// Thumbnail defaults.
+	viper.SetDefault("thumbnails.embed", true)
+	viper.SetDefault("thumbnails.width", 150)
+
+	// SponsorBlock defaults.
+	viper.SetDefault("sponsorblock.enabled", false)
+	viper.SetDefault("sponsorblock.categories", []string{"sponsor", "intro", "outro"})
+	viper.SetDefault("sponsorblock.api_url", "https://sponsor.ajay.app")
+
+	// Premiere defaults.
+	viper.SetDefault("premieres.auto_queue", true)
+	viper.SetDefault("premieres.max_wait", 120)
+	viper.SetDefault("premieres.messages.premiere_watched", "<b>%s</b> added <i>%s</i>, which starts in <b>%s</b>. It will be queued once it starts.")
+	viper.SetDefault("premieres.messages.premiere_watched_unscheduled", "<b>%s</b> added <i>%s</i>, which has not started yet. It will be queued once it starts.")
+	viper.SetDefault("premieres.messages.premiere_too_far_error", "<i>%s</i> starts in <b>%s</b>, which is too far ahead to wait for. Add it again once it has started.")
+	viper.SetDefault("premieres.messages.premiere_watched_error", "<i>%s</i> will already be queued once it starts.")
+	viper.SetDefault("premieres.messages.premiere_queued", "<i>%s</i> has started and was added to the queue for <b>%s</b>.")
+	viper.SetDefault("premieres.messages.premiere_failed", "<i>%s</i> could not be queued after it was due to start: %s")
+
+	// Output defaults.
+	viper.SetDefault("output.monitor", "off")
+	viper.SetDefault("output.monitor_device", "default")
+	viper.SetDefault("output.latency", "normal")
+
+	// Guest defaults.
+	viper.SetDefault("guests.require_code", false)
+	viper.SetDefault("guests.code_length", 6)
+	viper.SetDefault("guests.code_lifetime", 60)
+
+	// Quota defaults.
+	viper.SetDefault("quota.youtube_daily_units", 10000)
+	viper.SetDefault("quota.low_priority_reserve", 2000)
+
+	// API defaults.
+	viper.SetDefault("api.address", "")
+
+	// Development defaults.
+	viper.SetDefault("dev.fixtures_directory", "")
+	viper.SetDefault("dev.record", false)
+	viper.SetDefault("dev.dummy_audio", "")
+	viper.SetDefault("dev.local_server", false)
+
+	// Intro defaults.
+	viper.SetDefault("intros.command", "")
+	viper.SetDefault("intros.default", false)
+	viper.SetDefault("intros.text", "This one goes out from %s!")
+
+	// Language defaults.
+	viper.SetDefault("language.directory", "$HOME/.config/mumbledj/languages")
+
+	// Import defaults.
+	viper.SetDefault("import.sections", []string{"admins.names", "commands", "queue", "volume"})
+	viper.SetDefault("import.save_to_config", false)
+
+	// Notification defaults.
+	viper.SetDefault("notifications.events.track_started", []string{"channel"})
+	viper.SetDefault("notifications.events.track_failed", []string{"private"})
+	viper.SetDefault("notifications.events.track_note", []string{"channel"})
+	viper.SetDefault("notifications.events.break_started", []string{"channel"})
+	viper.SetDefault("notifications.events.vote_window_opened", []string{"channel"})
+	viper.SetDefault("notifications.events.track_vetoed", []string{"channel"})
+	viper.SetDefault("notifications.events.premiere_queued", []string{"channel"})
+	viper.SetDefault("notifications.events.autostop_warning", []string{"channel"})
+	viper.SetDefault("notifications.events.autostop_stopped", []string{"channel"})
+	viper.SetDefault("notifications.events.radio_title_changed", []string{"channel"})
+	viper.SetDefault("notifications.events.submitter_departed", []string{"channel"})
+	viper.SetDefault("notifications.webhook_url", "")
+	viper.SetDefault("notifications.discord_webhook_url", "")
 
 	// Volume defaults.
 	viper.SetDefault("volume.default", 0.2)
 	viper.SetDefault("volume.lowest", 0.01)
 	viper.SetDefault("volume.highest", 0.8)
+	viper.SetDefault("volume.schedule", []string{})
+	viper.SetDefault("volume.schedule_fade", 60)
 
 	// Admins defaults.
 	viper.SetDefault("admins.enabled", true)
 	viper.SetDefault("admins.names", []string{"SuperUser"})
 
+	// Tier defaults.
+	viper.SetDefault("tiers.enabled", false)
+	viper.SetDefault("tiers.registered", "")
+	viper.SetDefault("tiers.unregistered", "guests")
+	viper.SetDefault("tiers.names", []string{"guests", "listeners"})
+	viper.SetDefault("tiers.guests.members", []string{})
+	viper.SetDefault("tiers.guests.denied_commands", []string{"skip", "skipplaylist", "veto"})
+	viper.SetDefault("tiers.listeners.members", []string{})
+	viper.SetDefault("tiers.listeners.denied_commands", []string{"add", "addnext"})
+	viper.SetDefault("tiers.messages.command_denied_error", "Users in the <b>%s</b> tier cannot use this command.")
+
+	// Ban defaults.
+	viper.SetDefault("bans.enabled", false)
+	viper.SetDefault("bans.include_muted", true)
+	viper.SetDefault("bans.refresh_interval", 10)
+
 	// Command defaults.
 	viper.SetDefault("commands.prefix", "!")
+	viper.SetDefault("commands.alternate_prefixes", []string{"/dj "})
 	viper.SetDefault("commands.common_messages.no_tracks_error", "There are no tracks in the queue.")
 	viper.SetDefault("commands.common_messages.caching_disabled_error", "Caching is currently disabled.")
+	viper.SetDefault("commands.common_messages.missing_argument_error", "The <b>%s</b> argument is missing.")
+	viper.SetDefault("commands.common_messages.invalid_argument_error", "\"%s\" is not a valid value for <b>%s</b>.")
+	viper.SetDefault("commands.common_messages.unknown_flag_error", "The option <b>%s</b> does not exist.")
+	viper.SetDefault("commands.common_messages.too_many_arguments_error", "Too many arguments were supplied: %s")
+	viper.SetDefault("commands.common_messages.usage", "Usage: %s")
+	viper.SetDefault("commands.common_messages.unsupported_url_error", "<i>%s</i> is not a link to a supported site. Links from these sites can be added: %s.")
 
 	viper.SetDefault("commands.add.aliases", []string{"add", "a"})
 	viper.SetDefault("commands.add.is_admin", false)
+	viper.SetDefault("commands.add.search_plain_text", true)
+	viper.SetDefault("commands.add.search_service", "YouTube")
 	viper.SetDefault("commands.add.description", "Adds a track or playlist from a media site to the queue.")
-	viper.SetDefault("commands.add.messages.no_url_error", "A URL must be supplied with the add command.")
+	viper.SetDefault("commands.add.messages.next_not_allowed_error", "You do not have permission to add tracks as the next item.")
+	viper.SetDefault("commands.add.messages.no_url_error", "A URL or the name of a song must be supplied with the add command.")
 	viper.SetDefault("commands.add.messages.no_valid_tracks_error", "No valid tracks were found with the provided URL(s).")
 	viper.SetDefault("commands.add.messages.tracks_too_long_error", "Your track(s) were either too long or an error occurred while processing them. No track(s) have been added.")
 	viper.SetDefault("commands.add.messages.one_track_added", "<b>%s</b> added <b>1</b> track to the queue:<br><i>%s</i> from %s")
 	viper.SetDefault("commands.add.messages.many_tracks_added", "<b>%s</b> added <b>%d</b> tracks to the queue.")
 	viper.SetDefault("commands.add.messages.num_tracks_too_long", "<br><b>%d</b> tracks could not be added due to error or because they are too long.")
+	viper.SetDefault("commands.add.messages.tracks_suggested", "<b>%s</b> suggested <b>%d</b> track(s) for the party as suggestion(s) <b>%d</b> to <b>%d</b>. Vote for your favorites with !upvote.")
+	viper.SetDefault("commands.add.messages.queue_duration_limit_error", "The queue is full for now! It may hold at most <b>%s</b> of music. Please try again once a few tracks have played.")
+	viper.SetDefault("commands.add.messages.num_tracks_over_duration_limit", "<br><b>%d</b> tracks could not be added because the queue is full.")
+	viper.SetDefault("commands.add.messages.all_tracks_recently_played_error", "Every track from the provided playlist(s) was played recently, so none have been added.")
+	viper.SetDefault("commands.add.messages.num_tracks_recently_played", "<br><b>%d</b> tracks were left out because they were played recently.")
+	viper.SetDefault("commands.add.messages.url_found", "<br>%s: <b>%d</b> track(s) found")
+	viper.SetDefault("commands.add.messages.url_failed", "<br>%s: <i>%s</i>")
+	viper.SetDefault("commands.add.messages.guest_code_required_error", "Guests must add a guest code from an admin after the URL with their first request, such as: !add URL CODE")
+
+	viper.SetDefault("commands.addchannel.aliases", []string{"addchannel", "ac"})
+	viper.SetDefault("commands.addchannel.is_admin", false)
+	viper.SetDefault("commands.addchannel.description", "Adds the latest uploads of a YouTube channel to the queue.")
+	viper.SetDefault("commands.addchannel.default_count", 10)
+	viper.SetDefault("commands.addchannel.messages.no_url_error", "A channel URL must be supplied with the addchannel command.")
+	viper.SetDefault("commands.addchannel.messages.not_channel_error", "The provided URL is not a channel of an enabled service.")
+	viper.SetDefault("commands.addchannel.messages.invalid_count_error", "The number of uploads to add must be at least 1.")
+
+	viper.SetDefault("commands.addliked.aliases", []string{"addliked", "liked"})
+	viper.SetDefault("commands.addliked.is_admin", false)
+	viper.SetDefault("commands.addliked.description", "Adds the videos you liked on YouTube to the queue, after linking your YouTube account. Use --unlink to forget your account.")
+	viper.SetDefault("commands.addliked.default_count", 25)
+	viper.SetDefault("commands.addliked.messages.not_configured_error", "Linking YouTube accounts has not been set up on this bot.")
+	viper.SetDefault("commands.addliked.messages.not_registered_error", "You must be registered on the server to link a YouTube account.")
+	viper.SetDefault("commands.addliked.messages.invalid_count_error", "The number of videos to add must be at least 1.")
+	viper.SetDefault("commands.addliked.messages.link_instructions", "To link your YouTube account, go to <a href=\"%s\">%s</a> and enter the code <b>%s</b>. Run the command again once you are told that your account is linked.")
+	viper.SetDefault("commands.addliked.messages.link_pending_error", "You are already linking your YouTube account. Enter the code you were sent, or wait for it to expire.")
+	viper.SetDefault("commands.addliked.messages.not_linked_error", "You have not linked a YouTube account.")
+	viper.SetDefault("commands.addliked.messages.unlinked", "Your YouTube account has been unlinked.")
 
 	viper.SetDefault("commands.addnext.aliases", []string{"addnext", "an"})
 	viper.SetDefault("commands.addnext.is_admin", true)
 	viper.SetDefault("commands.addnext.description", "Adds a track or playlist from a media site as the next item in the queue.")
 
+	viper.SetDefault("commands.boost.aliases", []string{"boost", "b"})
+	viper.SetDefault("commands.boost.is_admin", false)
+	viper.SetDefault("commands.boost.description", "Boosts a track in the queue by its position.")
+	viper.SetDefault("commands.boost.messages.no_position_error", "The position of a track in the queue must be supplied.")
+	viper.SetDefault("commands.boost.messages.invalid_position_error", "Only tracks after the current track may be boosted. Use !listtracks to find a track's position.")
+	viper.SetDefault("commands.boost.messages.own_track_error", "You cannot boost your own track.")
+	viper.SetDefault("commands.boost.messages.already_boosted_error", "You have already boosted this track.")
+	viper.SetDefault("commands.boost.messages.boosted", "<b>%s</b> boosted <i>%s</i>. It now has <b>%d</b> boost(s) and is at position <b>%d</b> in the queue.")
+
+	viper.SetDefault("commands.cached.aliases", []string{"cached", "library", "lib"})
+	viper.SetDefault("commands.cached.is_admin", false)
+	viper.SetDefault("commands.cached.description", "Searches the cached tracks, or adds one of the results to the queue by its number.")
+	viper.SetDefault("commands.cached.max_results", 10)
+	viper.SetDefault("commands.cached.messages.no_results_error", "No cached tracks match your search.")
+	viper.SetDefault("commands.cached.messages.no_result_error", "There is no search result with that number. Search the cache first with !cached followed by some search terms.")
+	viper.SetDefault("commands.cached.messages.results_header", "Cached tracks matching your search. Add one to the queue with !cached followed by its number:<br>")
+	viper.SetDefault("commands.cached.messages.result_listing", "<b>%d</b>: %s (%s)<br>")
+	viper.SetDefault("commands.cached.messages.more_results", "<i>...and %d more. Add search terms to narrow down the results.</i>")
+	viper.SetDefault("commands.cached.messages.track_added", "<b>%s</b> added <i>%s</i> from the cache to the queue.")
+
 	viper.SetDefault("commands.cachesize.aliases", []string{"cachesize", "cs"})
 	viper.SetDefault("commands.cachesize.is_admin", true)
 	viper.SetDefault("commands.cachesize.description", "Outputs the file size of the cache in MiB if caching is enabled.")
@@ -93,6 +352,25 @@ func SetDefaultConfig() {
 	viper.SetDefault("commands.currenttrack.is_admin", false)
 	viper.SetDefault("commands.currenttrack.description", "Outputs information about the current track in the queue if one exists.")
 	viper.SetDefault("commands.currenttrack.messages.current_track", "The current track is <i>%s</i>, added by <b>%s</b>.")
+	viper.SetDefault("commands.currenttrack.messages.current_track_with_artist", "The current track is <i>%s</i> by <b>%s</b>, added by <b>%s</b>.")
+	viper.SetDefault("commands.currenttrack.messages.current_radio_track", "The current station is <i>%s</i>, playing <i>%s</i>, added by <b>%s</b>.")
+
+	viper.SetDefault("commands.eventmode.aliases", []string{"eventmode", "em"})
+	viper.SetDefault("commands.eventmode.is_admin", true)
+	viper.SetDefault("commands.eventmode.description", "Turns event mode on or off. While event mode is on, tracks added by hosts are played next.")
+	viper.SetDefault("commands.eventmode.messages.invalid_argument_error", "Event mode can only be turned \"on\" or \"off\".")
+	viper.SetDefault("commands.eventmode.messages.status_on", "Event mode is currently on.")
+	viper.SetDefault("commands.eventmode.messages.status_off", "Event mode is currently off.")
+	viper.SetDefault("commands.eventmode.messages.event_mode_on", "Event mode is now on! Tracks added by <b>%s</b> will be played next.")
+	viper.SetDefault("commands.eventmode.messages.event_mode_off", "Event mode is now off. All tracks are queued normally again.")
+
+	viper.SetDefault("commands.failures.aliases", []string{"failures", "fails"})
+	viper.SetDefault("commands.failures.is_admin", true)
+	viper.SetDefault("commands.failures.description", "Outputs a list of recent commands that were not recognized, were denied, or returned an error.")
+	viper.SetDefault("commands.failures.history_size", 20)
+	viper.SetDefault("commands.failures.messages.invalid_integer_error", "An invalid integer was supplied.")
+	viper.SetDefault("commands.failures.messages.no_failures", "No commands have failed recently.")
+	viper.SetDefault("commands.failures.messages.failure_listing", "[%s] <b>%s</b>: <i>%s</i> - %s<br>")
 
 	viper.SetDefault("commands.forceskip.aliases", []string{"forceskip", "fs"})
 	viper.SetDefault("commands.forceskip.is_admin", true)
@@ -105,28 +383,82 @@ func SetDefaultConfig() {
 	viper.SetDefault("commands.forceskipplaylist.messages.no_playlist_error", "The current track is not part of a playlist.")
 	viper.SetDefault("commands.forceskipplaylist.messages.playlist_skipped", "The current playlist has been forcibly skipped by <b>%s</b>.")
 
+	viper.SetDefault("commands.guestcode.aliases", []string{"guestcode", "gc"})
+	viper.SetDefault("commands.guestcode.is_admin", true)
+	viper.SetDefault("commands.guestcode.description", "Generates a one-time code that a guest supplies with their first request.")
+	viper.SetDefault("commands.guestcode.messages.code_generated", "Guest code: <b>%s</b>. It can be used once within the next <b>%d</b> minutes, by adding it after the URL of the guest's first request.")
+	viper.SetDefault("commands.guestcode.messages.codes_not_required", "<br>Note that guest codes are currently not required, as guests.require_code is disabled.")
+
 	viper.SetDefault("commands.help.aliases", []string{"help", "h"})
 	viper.SetDefault("commands.help.is_admin", false)
 	viper.SetDefault("commands.help.description", "Outputs this list of commands.")
 	viper.SetDefault("commands.help.messages.commands_header", "<br><b>Commands:</b><br>")
 	viper.SetDefault("commands.help.messages.admin_commands_header", "<br><b>Admin Commands:</b><br>")
 
+	viper.SetDefault("commands.hold.aliases", []string{"hold", "hd"})
+	viper.SetDefault("commands.hold.is_admin", false)
+	viper.SetDefault("commands.hold.description", "Puts the music on hold for a meeting, pausing the current track until the music is taken off hold.")
+	viper.SetDefault("commands.hold.messages.already_held_error", "The music is already on hold.")
+	viper.SetDefault("commands.hold.messages.held", "<b>%s</b> has put the music on hold. It will pick up where it left off once someone types !unhold.")
+
+	viper.SetDefault("commands.import.aliases", []string{"import"})
+	viper.SetDefault("commands.import.is_admin", true)
+	viper.SetDefault("commands.import.description", "Imports aliases and settings from a configuration file exported by another MumbleDJ instance.")
+	viper.SetDefault("commands.import.messages.no_source_error", "A file path or URL to import from must be supplied.")
+	viper.SetDefault("commands.import.messages.settings_imported", "Imported <b>%d</b> settings from %s.")
+
 	viper.SetDefault("commands.joinme.aliases", []string{"joinme", "join"})
 	viper.SetDefault("commands.joinme.is_admin", true)
 	viper.SetDefault("commands.joinme.description", "Moves MumbleDJ into your current channel if not playing audio to someone else.")
 	viper.SetDefault("commands.joinme.messages.others_are_listening_error", "Users in another channel are listening to me.")
 	viper.SetDefault("commands.joinme.messages.in_your_channel", "I am now in your channel!")
 
+	viper.SetDefault("commands.jump.aliases", []string{"jump"})
+	viper.SetDefault("commands.jump.is_admin", false)
+	viper.SetDefault("commands.jump.description", "Plays the current track from the start of a song in its tracklist.")
+	viper.SetDefault("commands.jump.messages.not_allowed_error", "Only the submitter of the current track or an admin can jump within it.")
+	viper.SetDefault("commands.jump.messages.invalid_number_error", "There is no song <b>%d</b> in the tracklist. Use !tracklist to see it.")
+	viper.SetDefault("commands.jump.messages.jumped", "<b>%s</b> jumped to <i>%s</i>.")
+
+	viper.SetDefault("commands.karaoke.aliases", []string{"karaoke", "kar"})
+	viper.SetDefault("commands.karaoke.is_admin", false)
+	viper.SetDefault("commands.karaoke.description", "Searches for a karaoke or instrumental version of the current track and adds it as the next item in the queue.")
+	viper.SetDefault("commands.karaoke.search_terms", "karaoke instrumental")
+	viper.SetDefault("commands.karaoke.messages.no_search_service_error", "None of the enabled services support searching.")
+	viper.SetDefault("commands.karaoke.messages.no_results_error", "No karaoke or instrumental version of the current track could be found.")
+	viper.SetDefault("commands.karaoke.messages.karaoke_added", "<b>%s</b> added <i>%s</i> as the next track. Get ready to sing along to <i>%s</i>!")
+
 	viper.SetDefault("commands.kill.aliases", []string{"kill", "k"})
 	viper.SetDefault("commands.kill.is_admin", true)
 	viper.SetDefault("commands.kill.description", "Stops the bot and cleans its cache directory.")
 
+	viper.SetDefault("commands.lang.aliases", []string{"lang", "language"})
+	viper.SetDefault("commands.lang.is_admin", false)
+	viper.SetDefault("commands.lang.description", "Sets the language of private replies to you, or lists the available languages.")
+	viper.SetDefault("commands.lang.messages.unavailable_error", "That language is not available. Use !lang to see the available languages.")
+	viper.SetDefault("commands.lang.messages.current_language", "Your language is <b>%s</b>. Available languages: %s")
+	viper.SetDefault("commands.lang.messages.language_set", "Private replies will now be sent to you in <b>%s</b>.")
+	viper.SetDefault("commands.lang.messages.language_reset", "Private replies will now be sent to you in the server's language.")
+
 	viper.SetDefault("commands.listtracks.aliases", []string{"listtracks", "listsongs", "list", "l"})
 	viper.SetDefault("commands.listtracks.is_admin", false)
 	viper.SetDefault("commands.listtracks.description", "Outputs a list of the tracks currently in the queue.")
 	viper.SetDefault("commands.listtracks.messages.invalid_integer_error", "An invalid integer was supplied.")
 	viper.SetDefault("commands.listtracks.messages.track_listing", "<b>%d</b>: <i>%s</i>, added by <b>%s</b>.<br>")
 
+	viper.SetDefault("commands.monitor.aliases", []string{"monitor", "mon"})
+	viper.SetDefault("commands.monitor.is_admin", true)
+	viper.SetDefault("commands.monitor.description", "Plays the audio sent to Mumble on a local \"pulse\" or \"alsa\" device as well, or turns this \"off\".")
+	viper.SetDefault("commands.monitor.messages.invalid_device_error", "The monitor device must be \"pulse\", \"alsa\" or \"off\".")
+	viper.SetDefault("commands.monitor.messages.current_device", "The monitor output is currently <b>%s</b>.")
+	viper.SetDefault("commands.monitor.messages.device_changed", "The monitor output is now <b>%s</b>.")
+
+	viper.SetDefault("commands.more.aliases", []string{"more"})
+	viper.SetDefault("commands.more.is_admin", false)
+	viper.SetDefault("commands.more.description", "Adds the next batch of tracks of the last playlist you added that was cut off.")
+	viper.SetDefault("commands.more.messages.no_playlist_error", "You have not added a playlist that has more tracks to add.")
+	viper.SetDefault("commands.more.messages.invalid_count_error", "The number of tracks to add must be at least 1.")
+
 	viper.SetDefault("commands.move.aliases", []string{"move", "m"})
 	viper.SetDefault("commands.move.is_admin", true)
 	viper.SetDefault("commands.move.description", "Moves the bot into the Mumble channel provided via argument.")
@@ -134,12 +466,42 @@ func SetDefaultConfig() {
 	viper.SetDefault("commands.move.messages.channel_doesnt_exist_error", "The provided channel does not exist.")
 	viper.SetDefault("commands.move.messages.move_successful", "You have successfully moved the bot to <b>%s</b>.")
 
+	viper.SetDefault("commands.movetoqueue.aliases", []string{"movetoqueue", "mtq"})
+	viper.SetDefault("commands.movetoqueue.is_admin", true)
+	viper.SetDefault("commands.movetoqueue.description", "Moves a track to another named queue, such as \"!movetoqueue 3 chill\".")
+	viper.SetDefault("commands.movetoqueue.messages.same_queue_error", "The track is already in that queue.")
+	viper.SetDefault("commands.movetoqueue.messages.unknown_queue_error", "There is no queue named <b>%s</b>. Use !usequeue to list the queues.")
+	viper.SetDefault("commands.movetoqueue.messages.invalid_position_error", "There is no track at position <b>%d</b> of that queue. The current track cannot be moved.")
+	viper.SetDefault("commands.movetoqueue.messages.moved", "<b>%s</b> moved <i>%s</i> to the queue <b>%s</b>.")
+
+	viper.SetDefault("commands.movetrack.aliases", []string{"movetrack", "mt"})
+	viper.SetDefault("commands.movetrack.is_admin", true)
+	viper.SetDefault("commands.movetrack.description", "Moves a track to another position in the queue, such as \"!movetrack 5 2\".")
+	viper.SetDefault("commands.movetrack.messages.invalid_position_error", "Both positions must be those of tracks in the queue, as shown by !listtracks. The current track cannot be moved.")
+	viper.SetDefault("commands.movetrack.messages.moved", "<b>%s</b> moved <i>%s</i> from position <b>%d</b> to position <b>%d</b> in the queue.")
+
 	viper.SetDefault("commands.nexttrack.aliases", []string{"nexttrack", "nextsong", "next"})
 	viper.SetDefault("commands.nexttrack.is_admin", false)
 	viper.SetDefault("commands.nexttrack.description", "Outputs information about the next track in the queue if one exists.")
 	viper.SetDefault("commands.nexttrack.messages.current_track_only_error", "The current track is the only track in the queue.")
 	viper.SetDefault("commands.nexttrack.messages.next_track", "The next track is <i>%s</i>, added by <b>%s</b>.")
 
+	viper.SetDefault("commands.note.aliases", []string{"note"})
+	viper.SetDefault("commands.note.is_admin", true)
+	viper.SetDefault("commands.note.description", "Attaches a note to the current track, which is shown when it plays again. Shows the current note without text, or removes it with --clear.")
+	viper.SetDefault("commands.note.max_length", 200)
+	viper.SetDefault("commands.note.messages.no_note_error", "The current track has no note.")
+	viper.SetDefault("commands.note.messages.note_too_long_error", "Notes cannot be longer than %d characters.")
+	viper.SetDefault("commands.note.messages.note_saved", "Your note has been attached to <i>%s</i>.")
+	viper.SetDefault("commands.note.messages.note_cleared", "The note on <i>%s</i> has been removed.")
+	viper.SetDefault("commands.note.messages.note_listing", "Note on <i>%s</i>: <i>\"%s\"</i> (noted by <b>%s</b>)")
+
+	viper.SetDefault("commands.notify.aliases", []string{"notify", "remindme"})
+	viper.SetDefault("commands.notify.is_admin", false)
+	viper.SetDefault("commands.notify.description", "Toggles private messages letting you know when a track you added begins playing.")
+	viper.SetDefault("commands.notify.messages.notifications_on", "You will now be sent a private message when a track you added begins playing.")
+	viper.SetDefault("commands.notify.messages.notifications_off", "You will no longer be sent a private message when a track you added begins playing.")
+
 	viper.SetDefault("commands.numcached.aliases", []string{"numcached", "nc"})
 	viper.SetDefault("commands.numcached.is_admin", true)
 	viper.SetDefault("commands.numcached.description", "Outputs the number of tracks cached on disk if caching is enabled.")
@@ -151,12 +513,63 @@ func SetDefaultConfig() {
 	viper.SetDefault("commands.numtracks.messages.one_track", "There is currently <b>1</b> track in the queue.")
 	viper.SetDefault("commands.numtracks.messages.plural_tracks", "There are currently <b>%d</b> tracks in the queue.")
 
+	viper.SetDefault("commands.party.aliases", []string{"party"})
+	viper.SetDefault("commands.party.is_admin", true)
+	viper.SetDefault("commands.party.description", "Transmits audio to the given channels as well for a listening party, or ends the listening party with \"end\".")
+	viper.SetDefault("commands.party.messages.no_channel_error", "Please specify the channels to hold the listening party in, or \"end\" to end it.")
+	viper.SetDefault("commands.party.messages.no_valid_channels_error", "None of the given channels exist or allow the bot to transmit to them.")
+	viper.SetDefault("commands.party.messages.no_party_error", "There is no listening party to end.")
+	viper.SetDefault("commands.party.messages.party_started", "<b>%s</b> has started a listening party! Audio is now also transmitted to: <b>%s</b>.")
+	viper.SetDefault("commands.party.messages.channels_skipped", "<br>These channels were left out because they do not exist or do not allow the bot to transmit to them: <b>%s</b>.")
+	viper.SetDefault("commands.party.messages.party_ended", "<b>%s</b> has ended the listening party.")
+
 	viper.SetDefault("commands.pause.aliases", []string{"pause"})
 	viper.SetDefault("commands.pause.is_admin", false)
 	viper.SetDefault("commands.pause.description", "Pauses audio playback.")
 	viper.SetDefault("commands.pause.messages.no_audio_error", "Either the audio is already paused, or there are no tracks in the queue.")
 	viper.SetDefault("commands.pause.messages.paused", "<b>%s</b> has paused audio playback.")
 
+	viper.SetDefault("commands.plan.aliases", []string{"plan"})
+	viper.SetDefault("commands.plan.is_admin", true)
+	viper.SetDefault("commands.plan.description", "Starts planning a party. Added tracks are collected as suggestions and voted on instead of being queued.")
+	viper.SetDefault("commands.plan.messages.already_planning_error", "A party is already being planned.")
+	viper.SetDefault("commands.plan.messages.planning_started", "<b>%s</b> has started planning a party! Suggest tracks with !add and vote for them with !upvote.")
+
+	viper.SetDefault("commands.plex.aliases", []string{"plex"})
+	viper.SetDefault("commands.plex.is_admin", false)
+	viper.SetDefault("commands.plex.description", "Searches the Plex server for a track and adds the best match to the queue.")
+	viper.SetDefault("commands.plex.messages.no_query_error", "Search terms must be supplied with the plex command.")
+	viper.SetDefault("commands.plex.messages.plex_disabled_error", "The Plex service is not enabled.")
+
+	viper.SetDefault("commands.preview.aliases", []string{"preview", "pv"})
+	viper.SetDefault("commands.preview.is_admin", false)
+	viper.SetDefault("commands.preview.description", "Sends you the title, duration and thumbnail of the track or playlist at the provided URL without adding it to the queue.")
+	viper.SetDefault("commands.preview.messages.no_url_error", "A URL must be supplied with the preview command.")
+	viper.SetDefault("commands.preview.messages.no_valid_tracks_error", "No valid tracks were found with the provided URL.")
+	viper.SetDefault("commands.preview.messages.track_preview", "<b><a href=\"%s\">%s</a></b> (%s) from %s")
+	viper.SetDefault("commands.preview.messages.track_author", "<br>By %s")
+	viper.SetDefault("commands.preview.messages.playlist_preview", "<br>This is the first track of the playlist <b>%s</b>, which has <b>%d</b> track(s) lasting <b>%s</b> in total.")
+
+	viper.SetDefault("commands.privateannounce.aliases", []string{"privateannounce", "pa"})
+	viper.SetDefault("commands.privateannounce.is_admin", false)
+	viper.SetDefault("commands.privateannounce.description", "Toggles whether the full announcement for tracks you added is sent only to you, with a short line in the channel instead.")
+	viper.SetDefault("commands.privateannounce.messages.private_on", "Announcements for your tracks will now be sent privately to you, with a short line in the channel.")
+	viper.SetDefault("commands.privateannounce.messages.private_off", "Announcements for your tracks will now be sent to the whole channel.")
+
+	viper.SetDefault("commands.purgeuser.aliases", []string{"purgeuser", "pu"})
+	viper.SetDefault("commands.purgeuser.is_admin", true)
+	viper.SetDefault("commands.purgeuser.description", "Removes all tracks in the queue that were added by the user provided via argument.")
+	viper.SetDefault("commands.purgeuser.messages.no_user_error", "The name of the user whose tracks should be removed must be supplied.")
+	viper.SetDefault("commands.purgeuser.messages.no_tracks_error", "There are no tracks in the queue added by <b>%s</b>.")
+	viper.SetDefault("commands.purgeuser.messages.tracks_purged", "<b>%s</b> has removed <b>%d</b> track(s) added by <b>%s</b> from the queue.")
+
+	viper.SetDefault("commands.quota.aliases", []string{"quota"})
+	viper.SetDefault("commands.quota.is_admin", true)
+	viper.SetDefault("commands.quota.description", "Outputs the estimated YouTube API usage of today.")
+	viper.SetDefault("commands.quota.messages.usage", "Today the bot has used an estimated <b>%d</b> of <b>%d</b> YouTube API units in <b>%d</b> calls. <b>%d</b> units remain.")
+	viper.SetDefault("commands.quota.messages.usage_unlimited", "Today the bot has used an estimated <b>%d</b> YouTube API units in <b>%d</b> calls. No budget is set.")
+	viper.SetDefault("commands.quota.messages.low_priority_deferred", "<br>The budget is running low, so long playlists are cut short to save the rest for requests.")
+
 	viper.SetDefault("commands.register.aliases", []string{"register", "reg"})
 	viper.SetDefault("commands.register.is_admin", true)
 	viper.SetDefault("commands.register.description", "Registers the bot on the server.")
@@ -168,28 +581,68 @@ func SetDefaultConfig() {
 	viper.SetDefault("commands.reload.description", "Reloads the configuration file.")
 	viper.SetDefault("commands.reload.messages.reloaded", "The configuration file has been successfully reloaded.")
 
+	viper.SetDefault("commands.remove.aliases", []string{"remove", "rm"})
+	viper.SetDefault("commands.remove.is_admin", false)
+	viper.SetDefault("commands.remove.description", "Removes a track from the queue by its position, such as \"!remove 3\".")
+	viper.SetDefault("commands.remove.messages.invalid_position_error", "There is no track at that position after the current one, as shown by !listtracks. Use !skip to skip the current track.")
+	viper.SetDefault("commands.remove.messages.not_allowed_error", "Only the submitter of a track or an admin can remove it from the queue.")
+	viper.SetDefault("commands.remove.messages.removed", "<b>%s</b> removed <i>%s</i> from position <b>%d</b> in the queue.")
+
 	viper.SetDefault("commands.reset.aliases", []string{"reset", "re"})
 	viper.SetDefault("commands.reset.is_admin", true)
 	viper.SetDefault("commands.reset.description", "Resets the queue by removing all queue items.")
 	viper.SetDefault("commands.reset.messages.queue_reset", "<b>%s</b> has reset the queue.")
 
+	viper.SetDefault("commands.restart.aliases", []string{"restart"})
+	viper.SetDefault("commands.restart.is_admin", true)
+	viper.SetDefault("commands.restart.description", "Saves the queue and restarts the bot.")
+	viper.SetDefault("commands.restart.messages.restarting", "<b>%s</b> is restarting the bot. Be right back!")
+
 	viper.SetDefault("commands.resume.aliases", []string{"resume"})
 	viper.SetDefault("commands.resume.is_admin", false)
 	viper.SetDefault("commands.resume.description", "Resumes audio playback.")
 	viper.SetDefault("commands.resume.messages.audio_error", "Either the audio is already playing, or there are no tracks in the queue.")
 	viper.SetDefault("commands.resume.messages.resumed", "<b>%s</b> has resumed audio playback.")
 
+	viper.SetDefault("commands.scsearch.aliases", []string{"scsearch", "scs"})
+	viper.SetDefault("commands.scsearch.is_admin", false)
+	viper.SetDefault("commands.scsearch.description", "Searches SoundCloud for a track and adds the top match to the queue.")
+	viper.SetDefault("commands.scsearch.messages.no_query_error", "Search terms must be supplied with the scsearch command.")
+	viper.SetDefault("commands.scsearch.messages.soundcloud_disabled_error", "The SoundCloud service is not enabled.")
+
 	viper.SetDefault("commands.setcomment.aliases", []string{"setcomment", "comment", "sc"})
 	viper.SetDefault("commands.setcomment.is_admin", true)
 	viper.SetDefault("commands.setcomment.description", "Sets the comment displayed next to MumbleDJ's username in Mumble.")
 	viper.SetDefault("commands.setcomment.messages.comment_removed", "The comment for the bot has been successfully removed.")
 	viper.SetDefault("commands.setcomment.messages.comment_changed", "The comment for the bot has been successfully changed to the following: %s")
 
+	viper.SetDefault("commands.setdefault.aliases", []string{"setdefault", "defaults"})
+	viper.SetDefault("commands.setdefault.is_admin", false)
+	viper.SetDefault("commands.setdefault.description", "Sets your personal defaults, such as shuffle=on or announcements=pm, or lists them.")
+	viper.SetDefault("commands.setdefault.messages.current_defaults", "Your defaults: %s")
+	viper.SetDefault("commands.setdefault.messages.defaults_set", "Your defaults have been saved: %s")
+	viper.SetDefault("commands.setdefault.messages.invalid_option_error", "\"%s\" is not a valid default. Choose from: %s")
+
+	viper.SetDefault("commands.shoutout.aliases", []string{"shoutout", "intro"})
+	viper.SetDefault("commands.shoutout.is_admin", false)
+	viper.SetDefault("commands.shoutout.description", "Toggles a short spoken shout-out to you before the tracks you added begin playing.")
+	viper.SetDefault("commands.shoutout.messages.intros_on", "Your tracks will now be introduced with a shout-out to you.")
+	viper.SetDefault("commands.shoutout.messages.intros_off", "Your tracks will no longer be introduced with a shout-out.")
+
 	viper.SetDefault("commands.shuffle.aliases", []string{"shuffle", "shuf", "sh"})
 	viper.SetDefault("commands.shuffle.is_admin", true)
-	viper.SetDefault("commands.shuffle.description", "Randomizes the tracks currently in the queue.")
+	viper.SetDefault("commands.shuffle.description", "Randomizes the tracks currently in the queue, keeping the current track in place.")
+	viper.SetDefault("commands.shuffle.vote_ratio", 0.5)
 	viper.SetDefault("commands.shuffle.messages.not_enough_tracks_error", "There are not enough tracks in the queue to execute a shuffle.")
-	viper.SetDefault("commands.shuffle.messages.shuffled", "The audio queue has been shuffled.")
+	viper.SetDefault("commands.shuffle.messages.invalid_seed_error", "An invalid seed was supplied. Seeds must be whole numbers.")
+	viper.SetDefault("commands.shuffle.messages.shuffled", "The audio queue has been shuffled with seed <b>%d</b>.")
+	viper.SetDefault("commands.shuffle.messages.vote_added", "<b>%s</b> has voted to shuffle the queue (%d of %d votes needed).")
+	viper.SetDefault("commands.shuffle.messages.already_voted_error", "You have already voted to shuffle the queue.")
+
+	viper.SetDefault("commands.shutdown.aliases", []string{"shutdown"})
+	viper.SetDefault("commands.shutdown.is_admin", true)
+	viper.SetDefault("commands.shutdown.description", "Saves the queue and shuts down the bot.")
+	viper.SetDefault("commands.shutdown.messages.shutting_down", "<b>%s</b> has shut down the bot. Goodbye!")
 
 	viper.SetDefault("commands.skip.aliases", []string{"skip", "s"})
 	viper.SetDefault("commands.skip.is_admin", false)
@@ -206,16 +659,80 @@ func SetDefaultConfig() {
 	viper.SetDefault("commands.skipplaylist.messages.voted", "<b>%s</b> has voted to skip the current playlist.")
 	viper.SetDefault("commands.skipplaylist.messages.submitter_voted", "<b>%s</b>, the submitter of this playlist, has voted to skip. Skipping immediately.")
 
+	viper.SetDefault("commands.startparty.aliases", []string{"startparty"})
+	viper.SetDefault("commands.startparty.is_admin", true)
+	viper.SetDefault("commands.startparty.description", "Finishes planning the party and adds the suggested tracks to the queue, most upvoted first.")
+	viper.SetDefault("commands.startparty.messages.not_planning_error", "No party is being planned.")
+	viper.SetDefault("commands.startparty.messages.party_started", "<b>%s</b> has started the party! <b>%d</b> suggested track(s) have been added to the queue, most upvoted first.")
+
+	viper.SetDefault("commands.stopat.aliases", []string{"stopat", "sa"})
+	viper.SetDefault("commands.stopat.is_admin", true)
+	viper.SetDefault("commands.stopat.description", "Schedules playback to stop at a time of day (HH:MM), or cancels the scheduled stop with \"off\".")
+	viper.SetDefault("commands.stopat.messages.parsing_error", "The stop time must be a 24-hour time in HH:MM format, such as 23:30.")
+	viper.SetDefault("commands.stopat.messages.no_stop", "No stop is currently scheduled.")
+	viper.SetDefault("commands.stopat.messages.current_stop", "Playback is scheduled to stop at <b>%s</b>.")
+	viper.SetDefault("commands.stopat.messages.scheduled", "<b>%s</b> has scheduled playback to stop at <b>%s</b>.")
+	viper.SetDefault("commands.stopat.messages.cancelled", "<b>%s</b> has cancelled the scheduled stop.")
+
+	viper.SetDefault("commands.stoplive.aliases", []string{"stoplive", "sl", "stop"})
+	viper.SetDefault("commands.stoplive.is_admin", true)
+	viper.SetDefault("commands.stoplive.description", "Stops relaying the current live stream or internet radio station.")
+	viper.SetDefault("commands.stoplive.messages.not_live_error", "The current track is not a live stream or radio station.")
+	viper.SetDefault("commands.stoplive.messages.live_stopped", "The live stream has been stopped by <b>%s</b>.")
+
 	viper.SetDefault("commands.toggleshuffle.aliases", []string{"toggleshuffle", "toggleshuf", "togshuf", "tsh"})
 	viper.SetDefault("commands.toggleshuffle.is_admin", true)
 	viper.SetDefault("commands.toggleshuffle.description", "Toggles automatic track shuffling on/off.")
 	viper.SetDefault("commands.toggleshuffle.messages.toggled_off", "Automatic shuffling has been toggled off.")
 	viper.SetDefault("commands.toggleshuffle.messages.toggled_on", "Automatic shuffling has been toggled on.")
 
+	viper.SetDefault("commands.tracklist.aliases", []string{"tracklist", "chapters"})
+	viper.SetDefault("commands.tracklist.is_admin", false)
+	viper.SetDefault("commands.tracklist.description", "Lists the songs within the current track, such as an album uploaded as a single video.")
+	viper.SetDefault("commands.tracklist.messages.no_tracklist_error", "The current track has no tracklist.")
+	viper.SetDefault("commands.tracklist.messages.tracklist_header", "Songs in <i>%s</i>. Jump to one with !jump followed by its number:<br>")
+	viper.SetDefault("commands.tracklist.messages.chapter_listing", "<b>%d</b>: %s <i>%s</i><br>")
+	viper.SetDefault("commands.tracklist.messages.current_chapter_listing", "<b>%d</b>: %s <b><i>%s</i></b> (now playing)<br>")
+
+	viper.SetDefault("commands.unhold.aliases", []string{"unhold", "uh"})
+	viper.SetDefault("commands.unhold.is_admin", false)
+	viper.SetDefault("commands.unhold.description", "Takes the music off hold and fades it back in.")
+	viper.SetDefault("commands.unhold.fade", 5)
+	viper.SetDefault("commands.unhold.messages.not_held_error", "The music is not on hold.")
+	viper.SetDefault("commands.unhold.messages.unheld", "<b>%s</b> has taken the music off hold after %s.")
+
+	viper.SetDefault("commands.upvote.aliases", []string{"upvote", "up"})
+	viper.SetDefault("commands.upvote.is_admin", false)
+	viper.SetDefault("commands.upvote.description", "Upvotes a suggested track while a party is being planned, or lists the suggestions if no number is given.")
+	viper.SetDefault("commands.upvote.messages.not_planning_error", "No party is being planned.")
+	viper.SetDefault("commands.upvote.messages.no_suggestions_error", "No tracks have been suggested yet.")
+	viper.SetDefault("commands.upvote.messages.invalid_number_error", "The number of an existing suggestion must be supplied.")
+	viper.SetDefault("commands.upvote.messages.already_voted_error", "You have already upvoted this suggestion.")
+	viper.SetDefault("commands.upvote.messages.suggestion_listing", "<b>%d</b>: <i>%s</i>, suggested by <b>%s</b> (<b>%d</b> votes).<br>")
+	viper.SetDefault("commands.upvote.messages.upvoted", "<b>%s</b> upvoted <i>%s</i>. It now has <b>%d</b> vote(s).")
+
+	viper.SetDefault("commands.usequeue.aliases", []string{"usequeue", "uq"})
+	viper.SetDefault("commands.usequeue.is_admin", true)
+	viper.SetDefault("commands.usequeue.description", "Switches which named queue feeds playback, creating it if needed, or lists the queues.")
+	viper.SetDefault("commands.usequeue.messages.queues_header", "<b>Queues:</b>")
+	viper.SetDefault("commands.usequeue.messages.queue_listing", "<br><b>%s</b>: %d track(s)")
+	viper.SetDefault("commands.usequeue.messages.active_queue_listing", "<br><b>%s</b>: %d track(s) coming up (playing)")
+	viper.SetDefault("commands.usequeue.messages.already_active_error", "The queue <b>%s</b> is already playing.")
+	viper.SetDefault("commands.usequeue.messages.switched", "<b>%s</b> switched to the queue <b>%s</b>. <b>%d</b> track(s) are coming up.")
+
 	viper.SetDefault("commands.version.aliases", []string{"version"})
 	viper.SetDefault("commands.version.is_admin", false)
-	viper.SetDefault("commands.version.description", "Outputs the current version of MumbleDJ.")
+	viper.SetDefault("commands.version.description", "Outputs the version of MumbleDJ and details about its build and environment.")
 	viper.SetDefault("commands.version.messages.version", "MumbleDJ version: <b>%s</b>")
+	viper.SetDefault("commands.version.messages.build_info", "<br>Commit: <b>%s</b><br>Go version: <b>%s</b><br>Downloader: <b>%s</b><br>Enabled services: <b>%s</b>")
+	viper.SetDefault("commands.version.messages.update_available", "<br>A new version is available: <b>%s</b>")
+
+	viper.SetDefault("commands.veto.aliases", []string{"veto"})
+	viper.SetDefault("commands.veto.is_admin", false)
+	viper.SetDefault("commands.veto.description", "Places a vote against the upcoming track while the window between tracks is open.")
+	viper.SetDefault("commands.veto.messages.no_window_error", "There is no upcoming track to veto right now.")
+	viper.SetDefault("commands.veto.messages.already_vetoed_error", "You have already vetoed the upcoming track.")
+	viper.SetDefault("commands.veto.messages.vetoed", "<b>%s</b> has vetoed <i>%s</i>.")
 
 	viper.SetDefault("commands.volume.aliases", []string{"volume", "vol", "v"})
 	viper.SetDefault("commands.volume.is_admin", false)
@@ -224,6 +741,18 @@ func SetDefaultConfig() {
 	viper.SetDefault("commands.volume.messages.out_of_range_error", "Volumes must be between the values <b>%.2f</b> and <b>%.2f</b>.")
 	viper.SetDefault("commands.volume.messages.current_volume", "The current volume is <b>%.2f</b>.")
 	viper.SetDefault("commands.volume.messages.volume_changed", "<b>%s</b> has changed the volume to <b>%.2f</b>.")
+
+	viper.SetDefault("commands.volumeschedule.aliases", []string{"volumeschedule", "vs"})
+	viper.SetDefault("commands.volumeschedule.is_admin", true)
+	viper.SetDefault("commands.volumeschedule.description", "Schedules the volume to change at a time of day (HH:MM volume), removes a scheduled volume with \"remove HH:MM\", or lists the scheduled volumes.")
+	viper.SetDefault("commands.volumeschedule.messages.parsing_error", "Please provide a 24-hour time in HH:MM format followed by a volume, such as <b>22:00 0.1</b>.")
+	viper.SetDefault("commands.volumeschedule.messages.out_of_range_error", "Volumes must be between the values <b>%.2f</b> and <b>%.2f</b>.")
+	viper.SetDefault("commands.volumeschedule.messages.no_schedule", "No volumes are scheduled.")
+	viper.SetDefault("commands.volumeschedule.messages.schedule_header", "<b>Scheduled volumes:</b>")
+	viper.SetDefault("commands.volumeschedule.messages.schedule_entry", "<br><b>%s</b>: %.2f")
+	viper.SetDefault("commands.volumeschedule.messages.not_scheduled_error", "No volume is scheduled at <b>%s</b>.")
+	viper.SetDefault("commands.volumeschedule.messages.scheduled", "<b>%s</b> has scheduled the volume to change to <b>%.2f</b> at <b>%s</b>.")
+	viper.SetDefault("commands.volumeschedule.messages.removed", "<b>%s</b> has removed the scheduled volume at <b>%s</b>.")
 }
 
 // ReadConfigFile reads in the config file and updates the configuration accordingly.
@@ -257,3 +786,47 @@ func CheckForDuplicateAliases() error {
 
 	return nil
 }
+
+// WriteConfigFile writes the current configuration values, with `settings`
+// applied on top of them, to a YAML configuration file located at `path`.
+// `header` is written as a comment at the top of the file.
+func WriteConfigFile(path, header string, settings map[string]interface{}) error {
+	allSettings := make(map[string]interface{})
+	for _, key := range viper.AllKeys() {
+		allSettings[key] = viper.Get(key)
+	}
+	for key, value := range settings {
+		allSettings[key] = value
+	}
+
+	data, err := yaml.Marshal(nestSettings(allSettings))
+	if err != nil {
+		return err
+	}
+	data = append([]byte(fmt.Sprintf("# MumbleDJ\n# %s\n\n", header)), data...)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// nestSettings converts a map of dot-separated keys into nested maps suitable
+// for marshaling into YAML.
+func nestSettings(settings map[string]interface{}) map[string]interface{} {
+	nested := make(map[string]interface{})
+	for key, value := range settings {
+		parts := strings.Split(key, ".")
+		current := nested
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := current[part].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				current[part] = next
+			}
+			current = next
+		}
+		current[parts[len(parts)-1]] = value
+	}
+	return nested
+}
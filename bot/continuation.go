@@ -0,0 +1,65 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/continuation.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"sync"
+
+	"github.com/matthieugrieger/mumbledj/interfaces"
+)
+
+// Continuation marks where a playlist that was cut off at
+// queue.max_tracks_per_playlist continues.
+type Continuation struct {
+	Playlist interfaces.Playlist
+	// Token is where the service continues the playlist, such as the token
+	// of a page of results. Its meaning is up to the service.
+	Token string
+}
+
+// Continuations remembers, for each user, the last playlist they added that
+// was cut off, so that more of its tracks can be added with the more command.
+type Continuations struct {
+	byUser map[string]Continuation
+	mutex  sync.Mutex
+}
+
+// NewContinuations returns a Continuations that holds no playlists.
+func NewContinuations() *Continuations {
+	return &Continuations{
+		byUser: make(map[string]Continuation),
+	}
+}
+
+// Set remembers that the playlist added by the user with the given name
+// continues at `continuation`, replacing any playlist they added before.
+func (c *Continuations) Set(name string, continuation Continuation) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.byUser[name] = continuation
+}
+
+// Get returns where the last playlist that was cut off for the user with the
+// given name continues. The second return value is false if there is none.
+func (c *Continuations) Get(name string) (Continuation, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	continuation, ok := c.byUser[name]
+	return continuation, ok
+}
+
+// Clear forgets the playlist of the user with the given name, such as once
+// all of its tracks have been added.
+func (c *Continuations) Clear(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.byUser, name)
+}
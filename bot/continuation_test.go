@@ -0,0 +1,61 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/continuation_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ContinuationsTestSuite struct {
+	suite.Suite
+	Continuations *Continuations
+}
+
+func (suite *ContinuationsTestSuite) SetupTest() {
+	suite.Continuations = NewContinuations()
+}
+
+func (suite *ContinuationsTestSuite) TestGetWithoutPlaylist() {
+	_, ok := suite.Continuations.Get("test")
+
+	suite.False(ok)
+}
+
+func (suite *ContinuationsTestSuite) TestSetReplacesPlaylist() {
+	suite.Continuations.Set("test", Continuation{Playlist: &Playlist{ID: "first"}, Token: "a"})
+	suite.Continuations.Set("test", Continuation{Playlist: &Playlist{ID: "second"}, Token: "b"})
+
+	continuation, ok := suite.Continuations.Get("test")
+
+	suite.True(ok)
+	suite.Equal("second", continuation.Playlist.GetID())
+	suite.Equal("b", continuation.Token)
+}
+
+func (suite *ContinuationsTestSuite) TestContinuationsArePerUser() {
+	suite.Continuations.Set("test", Continuation{Playlist: &Playlist{ID: "first"}, Token: "a"})
+
+	_, ok := suite.Continuations.Get("other")
+
+	suite.False(ok)
+}
+
+func (suite *ContinuationsTestSuite) TestClear() {
+	suite.Continuations.Set("test", Continuation{Playlist: &Playlist{ID: "first"}, Token: "a"})
+
+	suite.Continuations.Clear("test")
+	_, ok := suite.Continuations.Get("test")
+
+	suite.False(ok)
+}
+
+func TestContinuationsTestSuite(t *testing.T) {
+	suite.Run(t, new(ContinuationsTestSuite))
+}
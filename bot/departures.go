@@ -0,0 +1,138 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/departures.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// What happens to the queued tracks of users who leave, set in
+// queue.departed_submitters.
+const (
+	// DepartedKeep leaves the tracks of users who leave in the queue.
+	DepartedKeep = "keep"
+	// DepartedRemove removes the tracks of users who leave from the queue.
+	DepartedRemove = "remove"
+	// DepartedDemote moves the tracks of users who leave to the back of the
+	// queue.
+	DepartedDemote = "demote"
+)
+
+// Departures removes or demotes the queued tracks of users who disconnect or
+// leave the bot's channel. Users are given queue.departed_grace seconds to
+// come back first, so that a dropped connection does not cost them their
+// place in the queue.
+type Departures struct {
+	timers map[string]*time.Timer
+	mutex  sync.Mutex
+}
+
+// NewDepartures returns a Departures that is not waiting on anyone.
+func NewDepartures() *Departures {
+	return &Departures{
+		timers: make(map[string]*time.Timer),
+	}
+}
+
+// Left is called when the user `name` disconnects or leaves the bot's channel.
+// Once the grace period has passed, their tracks are dealt with according to
+// queue.departed_submitters unless they have come back.
+func (d *Departures) Left(name string) {
+	if mode := viper.GetString("queue.departed_submitters"); mode != DepartedRemove && mode != DepartedDemote {
+		return
+	}
+	grace := time.Duration(viper.GetInt("queue.departed_grace")) * time.Second
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if timer, ok := d.timers[name]; ok {
+		timer.Stop()
+	}
+	d.timers[name] = time.AfterFunc(grace, func() {
+		d.mutex.Lock()
+		delete(d.timers, name)
+		d.mutex.Unlock()
+		if DJ.Client == nil {
+			d.Apply(name)
+			return
+		}
+		// The timer runs outside of gumble's event goroutine.
+		DJ.Client.Do(func() {
+			if !isListening(name) {
+				d.Apply(name)
+			}
+		})
+	})
+}
+
+// Returned is called when the user `name` connects or joins the bot's channel,
+// and keeps their tracks in the queue if they had left.
+func (d *Departures) Returned(name string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if timer, ok := d.timers[name]; ok {
+		timer.Stop()
+		delete(d.timers, name)
+	}
+}
+
+// Apply removes or demotes the queued tracks of the user `name` according to
+// queue.departed_submitters, and returns how many were affected. The current
+// track is always left to finish.
+func (d *Departures) Apply(name string) int {
+	var (
+		affected int
+		message  string
+	)
+	switch viper.GetString("queue.departed_submitters") {
+	case DepartedRemove:
+		affected, _ = DJ.Queue.RemoveSubmitter(name)
+		message = "queue.messages.departed_removed"
+	case DepartedDemote:
+		affected = DJ.Queue.DemoteSubmitter(name)
+		message = "queue.messages.departed_demoted"
+	}
+	if affected == 0 {
+		return 0
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"user":       name,
+		"num_tracks": affected,
+		"mode":       viper.GetString("queue.departed_submitters"),
+	}).Infoln("Dealt with the queued tracks of a user who left.")
+	DJ.Notify("submitter_departed", name, fmt.Sprintf(viper.GetString(message), affected, name))
+	return affected
+}
+
+// isListening returns true if the user `name` is connected and in the bot's
+// channel or a channel linked by the running listening party. It must be
+// called from gumble's event goroutine or within DJ.Client.Do.
+func isListening(name string) bool {
+	if DJ.Client == nil || DJ.Client.Self == nil {
+		return false
+	}
+	user := DJ.Client.Users.Find(name)
+	if user == nil || user.Channel == nil {
+		return false
+	}
+	if user.Channel == DJ.Client.Self.Channel {
+		return true
+	}
+	for _, channel := range DJ.Party.Channels() {
+		if user.Channel.Name == channel {
+			return true
+		}
+	}
+	return false
+}
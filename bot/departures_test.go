@@ -0,0 +1,98 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/departures_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/layeh/gumble/gumbleffmpeg"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeparturesTestSuite struct {
+	suite.Suite
+}
+
+func (suite *DeparturesTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+	// Trick the queue into thinking audio is already playing to avoid
+	// attempting to play tracks that don't exist.
+	DJ.AudioStream = new(gumbleffmpeg.Stream)
+	viper.Set("notifications.events.submitter_departed", []string{})
+	viper.Set("queue.departed_submitters", DepartedKeep)
+	viper.Set("queue.departed_grace", 120)
+
+	DJ.Queue.AppendTrack(&Track{ID: "a", Submitter: "Bob"})
+	DJ.Queue.AppendTrack(&Track{ID: "b", Submitter: "Bob"})
+	DJ.Queue.AppendTrack(&Track{ID: "c", Submitter: "Alice"})
+	DJ.Queue.AppendTrack(&Track{ID: "d", Submitter: "bob"})
+	DJ.Queue.AppendTrack(&Track{ID: "e", Submitter: "Alice"})
+}
+
+func (suite *DeparturesTestSuite) queueIDs() []string {
+	var ids []string
+	DJ.Queue.Traverse(func(i int, t interfaces.Track) {
+		ids = append(ids, t.GetID())
+	})
+	return ids
+}
+
+func (suite *DeparturesTestSuite) TestApplyKeep() {
+	suite.Zero(DJ.Departures.Apply("Bob"))
+	suite.Equal([]string{"a", "b", "c", "d", "e"}, suite.queueIDs())
+}
+
+func (suite *DeparturesTestSuite) TestApplyRemove() {
+	viper.Set("queue.departed_submitters", DepartedRemove)
+
+	suite.Equal(2, DJ.Departures.Apply("Bob"))
+	suite.Equal([]string{"a", "c", "e"}, suite.queueIDs(), "The current track should be left to finish.")
+}
+
+func (suite *DeparturesTestSuite) TestApplyDemote() {
+	viper.Set("queue.departed_submitters", DepartedDemote)
+
+	suite.Equal(2, DJ.Departures.Apply("Bob"))
+	suite.Equal([]string{"a", "c", "e", "b", "d"}, suite.queueIDs())
+}
+
+func (suite *DeparturesTestSuite) TestLeftWhenKeeping() {
+	DJ.Departures.Left("Bob")
+
+	suite.Empty(DJ.Departures.timers, "Nothing should be scheduled when tracks are kept.")
+}
+
+func (suite *DeparturesTestSuite) TestReturnedWithinGrace() {
+	viper.Set("queue.departed_submitters", DepartedRemove)
+
+	DJ.Departures.Left("Bob")
+	suite.Len(DJ.Departures.timers, 1)
+	DJ.Departures.Returned("Bob")
+
+	suite.Empty(DJ.Departures.timers)
+	suite.Equal(5, DJ.Queue.Length(), "The tracks of a user who came back should be kept.")
+}
+
+func (suite *DeparturesTestSuite) TestLeftAfterGrace() {
+	viper.Set("queue.departed_submitters", DepartedRemove)
+	viper.Set("queue.departed_grace", 0)
+
+	DJ.Departures.Left("Bob")
+
+	for i := 0; i < 100 && DJ.Queue.Length() == 5; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	suite.Equal(3, DJ.Queue.Length(), "The tracks of a user who did not come back should be removed.")
+}
+
+func TestDeparturesTestSuite(t *testing.T) {
+	suite.Run(t, new(DeparturesTestSuite))
+}
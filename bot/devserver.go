@@ -0,0 +1,251 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/devserver.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"html"
+	"io"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/golang/protobuf/proto"
+	"github.com/layeh/gumble/gumble"
+	"github.com/layeh/gumble/gumble/MumbleProto"
+	"github.com/layeh/gumble/gumbleutil"
+)
+
+// Mumble packet types handled by the development server.
+const (
+	devPacketAuthenticate = 2
+	devPacketPing         = 3
+	devPacketTextMessage  = 11
+)
+
+// Sessions of the users on the development server.
+const (
+	devDeveloperSession = 1
+	devBotSession       = 2
+)
+
+// DevUsername is the name of the user who sends the messages typed into the
+// development server.
+const DevUsername = "Developer"
+
+// DevServer stands in for a Mumble server when dev.local_server is enabled,
+// so that the bot can be run without one. Its only channel holds the bot and
+// a registered user named DevUsername, whose messages are read line by line
+// from the input. Messages the bot sends are written to the output as plain
+// text, and the audio it plays is discarded.
+type DevServer struct {
+	listener net.Listener
+	lines    chan string
+	out      io.Writer
+}
+
+// StartDevServer starts a development server on a random local port that
+// reads messages from `in` and writes the bot's messages to `out`.
+func StartDevServer(in io.Reader, out io.Writer) (*DevServer, error) {
+	certificate, err := devCertificate()
+	if err != nil {
+		return nil, err
+	}
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+	})
+	if err != nil {
+		return nil, err
+	}
+	server := &DevServer{
+		listener: listener,
+		lines:    make(chan string),
+		out:      out,
+	}
+	go server.read(in)
+	go server.serve()
+	return server, nil
+}
+
+// Address returns the address of the server as "address:port".
+func (s *DevServer) Address() string {
+	return s.listener.Addr().String()
+}
+
+// Close stops accepting connections.
+func (s *DevServer) Close() error {
+	return s.listener.Close()
+}
+
+// read passes each line of `in` on to the connected bot.
+func (s *DevServer) read(in io.Reader) {
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			s.lines <- line
+		}
+	}
+}
+
+// serve accepts connections until the server is closed. The bot may connect
+// again after it has been disconnected.
+func (s *DevServer) serve() {
+	for {
+		connection, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(gumble.NewConn(connection))
+	}
+}
+
+// handle speaks just enough of the Mumble protocol with a connected bot.
+func (s *DevServer) handle(conn *gumble.Conn) {
+	defer conn.Close()
+	type packet struct {
+		packetType uint16
+		data       []byte
+	}
+	packets := make(chan packet)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(packets)
+		for {
+			packetType, data, err := conn.ReadPacket()
+			if err != nil {
+				return
+			}
+			select {
+			case packets <- packet{packetType, append([]byte(nil), data...)}:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case received, ok := <-packets:
+			if !ok {
+				return
+			}
+			if err := s.handlePacket(conn, received.packetType, received.data); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"error": err.Error(),
+				}).Warnln("The development server could not answer the bot.")
+				return
+			}
+		case line := <-s.lines:
+			conn.WriteProto(&MumbleProto.TextMessage{
+				Actor:     proto.Uint32(devDeveloperSession),
+				ChannelId: []uint32{0},
+				Message:   proto.String(html.EscapeString(line)),
+			})
+		}
+	}
+}
+
+// handlePacket answers a packet sent by the bot. Packets that need no answer,
+// such as audio, are ignored.
+func (s *DevServer) handlePacket(conn *gumble.Conn, packetType uint16, data []byte) error {
+	switch packetType {
+	case devPacketAuthenticate:
+		var packet MumbleProto.Authenticate
+		if err := proto.Unmarshal(data, &packet); err != nil {
+			return err
+		}
+		return s.welcome(conn, packet.GetUsername())
+	case devPacketPing:
+		var packet MumbleProto.Ping
+		if err := proto.Unmarshal(data, &packet); err != nil {
+			return err
+		}
+		return conn.WriteProto(&MumbleProto.Ping{Timestamp: packet.Timestamp})
+	case devPacketTextMessage:
+		var packet MumbleProto.TextMessage
+		if err := proto.Unmarshal(data, &packet); err != nil {
+			return err
+		}
+		message := gumbleutil.PlainText(&gumble.TextMessage{Message: packet.GetMessage()})
+		if len(packet.Session) > 0 {
+			fmt.Fprintf(s.out, "(private) %s\n", message)
+		} else {
+			fmt.Fprintln(s.out, message)
+		}
+	}
+	return nil
+}
+
+// welcome sends the bot the state of the server and completes its connection.
+func (s *DevServer) welcome(conn *gumble.Conn, username string) error {
+	messages := []proto.Message{
+		&MumbleProto.CodecVersion{
+			Alpha:       proto.Int32(0),
+			Beta:        proto.Int32(0),
+			PreferAlpha: proto.Bool(false),
+			Opus:        proto.Bool(true),
+		},
+		&MumbleProto.ChannelState{
+			ChannelId: proto.Uint32(0),
+			Name:      proto.String("Root"),
+		},
+		&MumbleProto.UserState{
+			Session:   proto.Uint32(devDeveloperSession),
+			Name:      proto.String(DevUsername),
+			UserId:    proto.Uint32(1),
+			ChannelId: proto.Uint32(0),
+		},
+		&MumbleProto.UserState{
+			Session:   proto.Uint32(devBotSession),
+			Name:      proto.String(username),
+			ChannelId: proto.Uint32(0),
+		},
+		&MumbleProto.ServerSync{
+			Session:     proto.Uint32(devBotSession),
+			WelcomeText: proto.String("MumbleDJ development server"),
+		},
+	}
+	for _, message := range messages {
+		if err := conn.WriteProto(message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// devCertificate generates the self-signed certificate of the development
+// server. The bot does not verify it.
+func devCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "MumbleDJ development server"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(365 * 24 * time.Hour),
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{
+		Certificate: [][]byte{certificate},
+		PrivateKey:  key,
+	}, nil
+}
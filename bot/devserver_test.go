@@ -0,0 +1,114 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/devserver_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"bytes"
+	"crypto/tls"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/layeh/gumble/gumbleutil"
+	"github.com/stretchr/testify/suite"
+)
+
+type DevServerTestSuite struct {
+	suite.Suite
+	Server   *DevServer
+	Input    *io.PipeWriter
+	Output   *syncBuffer
+	Client   *gumble.Client
+	Messages chan *gumble.TextMessageEvent
+}
+
+// syncBuffer is a bytes.Buffer that is safe to write to from the server while
+// the test reads it.
+type syncBuffer struct {
+	buffer bytes.Buffer
+	mutex  sync.Mutex
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.buffer.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.buffer.String()
+}
+
+func (suite *DevServerTestSuite) SetupTest() {
+	var input *io.PipeReader
+	input, suite.Input = io.Pipe()
+	suite.Output = new(syncBuffer)
+	suite.Server, _ = StartDevServer(input, suite.Output)
+	suite.Messages = make(chan *gumble.TextMessageEvent, 1)
+
+	config := gumble.NewConfig()
+	config.Username = "MumbleDJ"
+	config.Attach(gumbleutil.Listener{
+		TextMessage: func(e *gumble.TextMessageEvent) {
+			suite.Messages <- e
+		},
+	})
+	var err error
+	suite.Client, err = gumble.DialWithDialer(new(net.Dialer), suite.Server.Address(), config, &tls.Config{InsecureSkipVerify: true})
+	suite.Require().Nil(err)
+}
+
+func (suite *DevServerTestSuite) TearDownTest() {
+	suite.Client.Disconnect()
+	suite.Input.Close()
+	suite.Server.Close()
+}
+
+func (suite *DevServerTestSuite) TestConnects() {
+	suite.Equal("MumbleDJ", suite.Client.Self.Name)
+	suite.NotNil(suite.Client.Channels[0])
+	developer := suite.Client.Users.Find(DevUsername)
+	suite.NotNil(developer)
+	suite.True(developer.IsRegistered())
+	suite.NotNil(suite.Client.AudioEncoder, "Audio should be encoded as for a real server.")
+}
+
+func (suite *DevServerTestSuite) TestSendsTypedLines() {
+	go suite.Input.Write([]byte("!add https://example.com/?a=1&t=30\n"))
+
+	select {
+	case e := <-suite.Messages:
+		suite.Equal(DevUsername, e.Sender.Name)
+		suite.Equal("!add https://example.com/?a=1&t=30", gumbleutil.PlainText(&e.TextMessage))
+	case <-time.After(5 * time.Second):
+		suite.Fail("The typed line was not sent to the bot.")
+	}
+}
+
+func (suite *DevServerTestSuite) TestPrintsMessages() {
+	suite.Client.Do(func() {
+		suite.Client.Self.Channel.Send("<b>Now playing</b>", false)
+		suite.Client.Self.Channel.Users[devDeveloperSession].Send("Only for you")
+	})
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) && strings.Count(suite.Output.String(), "\n") < 2 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	suite.Equal("Now playing\n(private) Only for you\n", suite.Output.String())
+}
+
+func TestDevServerTestSuite(t *testing.T) {
+	suite.Run(t, new(DevServerTestSuite))
+}
@@ -0,0 +1,144 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/draft.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/matthieugrieger/mumbledj/interfaces"
+)
+
+// Draft collects suggested tracks while a party is being planned. Users vote
+// for the suggestions they want to hear, and once planning is finished the
+// tracks are handed to the queue ordered by votes.
+type Draft struct {
+	Suggestions []*Suggestion
+	active      bool
+	mutex       sync.RWMutex
+}
+
+// Suggestion is a track in the draft along with the names of the users who
+// have upvoted it.
+type Suggestion struct {
+	Track  interfaces.Track
+	Voters []string
+}
+
+// NewDraft returns an inactive, empty Draft.
+func NewDraft() *Draft {
+	return &Draft{
+		Suggestions: make([]*Suggestion, 0),
+	}
+}
+
+// Start begins a planning phase with an empty draft.
+func (d *Draft) Start() error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.active {
+		return errors.New("A party is already being planned")
+	}
+	d.active = true
+	d.Suggestions = d.Suggestions[:0]
+	return nil
+}
+
+// IsActive returns true if a party is currently being planned.
+func (d *Draft) IsActive() bool {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return d.active
+}
+
+// Length returns the number of suggestions in the draft.
+func (d *Draft) Length() int {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return len(d.Suggestions)
+}
+
+// Add appends tracks to the draft and returns the 1-based number of the first
+// track added.
+func (d *Draft) Add(tracks ...interfaces.Track) int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	first := len(d.Suggestions) + 1
+	for _, track := range tracks {
+		d.Suggestions = append(d.Suggestions, &Suggestion{
+			Track:  track,
+			Voters: make([]string, 0),
+		})
+	}
+	return first
+}
+
+// Upvote records a vote by `voter` for the suggestion with the given 1-based
+// number. Each user may vote for a suggestion only once.
+func (d *Draft) Upvote(number int, voter string) (*Suggestion, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if number < 1 || number > len(d.Suggestions) {
+		return nil, fmt.Errorf("There is no suggestion number %d", number)
+	}
+	suggestion := d.Suggestions[number-1]
+	for _, name := range suggestion.Voters {
+		if name == voter {
+			return nil, fmt.Errorf("%s has already upvoted suggestion %d", voter, number)
+		}
+	}
+	suggestion.Voters = append(suggestion.Voters, voter)
+	return suggestion, nil
+}
+
+// Traverse is a traversal function for Draft. Allows a visit function to be
+// passed in which performs the specified action on each suggestion.
+func (d *Draft) Traverse(visit func(i int, s *Suggestion)) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	for i, suggestion := range d.Suggestions {
+		visit(i, suggestion)
+	}
+}
+
+// Finish ends the planning phase and returns the suggested tracks sorted by
+// number of votes. Tracks with the same number of votes keep the order in
+// which they were suggested.
+func (d *Draft) Finish() ([]interfaces.Track, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if !d.active {
+		return nil, errors.New("No party is being planned")
+	}
+
+	sort.Stable(byVotes(d.Suggestions))
+	tracks := make([]interfaces.Track, len(d.Suggestions))
+	for i, suggestion := range d.Suggestions {
+		tracks[i] = suggestion.Track
+	}
+
+	d.active = false
+	d.Suggestions = make([]*Suggestion, 0)
+	return tracks, nil
+}
+
+// byVotes sorts suggestions from most to fewest votes.
+type byVotes []*Suggestion
+
+func (s byVotes) Len() int           { return len(s) }
+func (s byVotes) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byVotes) Less(i, j int) bool { return len(s[i].Voters) > len(s[j].Voters) }
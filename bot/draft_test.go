@@ -0,0 +1,86 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/draft_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DraftTestSuite struct {
+	suite.Suite
+	Draft *Draft
+}
+
+func (suite *DraftTestSuite) SetupTest() {
+	suite.Draft = NewDraft()
+	suite.Draft.Start()
+}
+
+func (suite *DraftTestSuite) TestStartWhenAlreadyActive() {
+	suite.NotNil(suite.Draft.Start(), "An error should be returned if a party is already being planned.")
+}
+
+func (suite *DraftTestSuite) TestAddReturnsFirstNumber() {
+	suite.Equal(1, suite.Draft.Add(&Track{ID: "first"}, &Track{ID: "second"}))
+	suite.Equal(3, suite.Draft.Add(&Track{ID: "third"}))
+}
+
+func (suite *DraftTestSuite) TestUpvote() {
+	suite.Draft.Add(&Track{ID: "first"})
+
+	suggestion, err := suite.Draft.Upvote(1, "test")
+
+	suite.Nil(err, "No error should be returned.")
+	suite.Equal(1, len(suggestion.Voters))
+}
+
+func (suite *DraftTestSuite) TestUpvoteTwice() {
+	suite.Draft.Add(&Track{ID: "first"})
+	suite.Draft.Upvote(1, "test")
+
+	_, err := suite.Draft.Upvote(1, "test")
+
+	suite.NotNil(err, "A user should not be able to vote twice for the same suggestion.")
+}
+
+func (suite *DraftTestSuite) TestUpvoteInvalidNumber() {
+	suite.Draft.Add(&Track{ID: "first"})
+
+	_, err := suite.Draft.Upvote(2, "test")
+
+	suite.NotNil(err, "An error should be returned for a suggestion that does not exist.")
+}
+
+func (suite *DraftTestSuite) TestFinishSortsByVotes() {
+	suite.Draft.Add(&Track{ID: "first"}, &Track{ID: "second"}, &Track{ID: "third"})
+	suite.Draft.Upvote(3, "a")
+	suite.Draft.Upvote(3, "b")
+	suite.Draft.Upvote(2, "a")
+
+	tracks, err := suite.Draft.Finish()
+
+	suite.Nil(err, "No error should be returned.")
+	suite.Equal("third", tracks[0].GetID())
+	suite.Equal("second", tracks[1].GetID())
+	suite.Equal("first", tracks[2].GetID())
+	suite.False(suite.Draft.IsActive(), "The planning phase should be over.")
+}
+
+func (suite *DraftTestSuite) TestFinishWhenInactive() {
+	suite.Draft.Finish()
+
+	_, err := suite.Draft.Finish()
+
+	suite.NotNil(err, "An error should be returned if no party is being planned.")
+}
+
+func TestDraftTestSuite(t *testing.T) {
+	suite.Run(t, new(DraftTestSuite))
+}
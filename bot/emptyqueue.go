@@ -0,0 +1,286 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/emptyqueue.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// The settings of queue.when_empty.
+const (
+	// EmptySilent stops playing until tracks are added.
+	EmptySilent = "silent"
+	// EmptyAutoplay adds a track by the author of the last track.
+	EmptyAutoplay = "autoplay"
+	// EmptyLoopPlaylist adds the tracks of the last playlist again.
+	EmptyLoopPlaylist = "loop_playlist"
+	// EmptyFallback adds queue.fallback_stream, such as a radio station.
+	EmptyFallback = "fallback"
+	// EmptyLeave moves the bot to queue.empty_channel until there is something
+	// to play again.
+	EmptyLeave = "leave"
+)
+
+// minRefillInterval is how long the tracks added when the queue runs out must
+// last before the queue is filled again. Otherwise, tracks that cannot be
+// played would be added over and over.
+const minRefillInterval = 30 * time.Second
+
+// autoplayCandidates is the number of search results an autoplayed track is
+// chosen from.
+const autoplayCandidates = 10
+
+// EmptyQueue decides what happens when the queue runs out, as set in
+// queue.when_empty.
+type EmptyQueue struct {
+	playlistID     string
+	playlistTracks []interfaces.Track
+	lastFill       time.Time
+	// leftFrom is the path of the channel the bot left for
+	// queue.empty_channel, if it has left one.
+	leftFrom []string
+	left     bool
+	mutex    sync.Mutex
+}
+
+// NewEmptyQueue returns an EmptyQueue that has not seen any track.
+func NewEmptyQueue() *EmptyQueue {
+	return &EmptyQueue{}
+}
+
+// Remember notes that `t` has begun playing, so that its playlist can be
+// played again. Tracks that are not part of a playlist are ignored, and so are
+// tracks that are already remembered, such as those of a looped playlist.
+func (e *EmptyQueue) Remember(t interfaces.Track) {
+	playlist := t.GetPlaylist()
+	if playlist == nil {
+		return
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if playlist.GetID() != e.playlistID {
+		e.playlistID = playlist.GetID()
+		e.playlistTracks = nil
+	}
+	for _, remembered := range e.playlistTracks {
+		if historyKey(remembered) == historyKey(t) {
+			return
+		}
+	}
+	e.playlistTracks = append(e.playlistTracks, t)
+}
+
+// Fill acts on queue.when_empty once the queue has run out after playing
+// `last`. Nothing is added if the tracks added the previous time ran out
+// within minRefillInterval.
+func (e *EmptyQueue) Fill(last interfaces.Track) {
+	mode := viper.GetString("queue.when_empty")
+	if mode == EmptySilent || mode == "" {
+		return
+	}
+	// Nothing is added when leaving, so there is nothing to run out again.
+	if mode == EmptyLeave {
+		if err := e.leave(); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"when_empty": mode,
+				"error":      err.Error(),
+			}).Warnln("Could not act on the queue running out.")
+		}
+		return
+	}
+
+	e.mutex.Lock()
+	if time.Since(e.lastFill) < minRefillInterval {
+		e.mutex.Unlock()
+		logrus.WithFields(logrus.Fields{
+			"when_empty": mode,
+		}).Warnln("The queue ran out again right after it was filled. Staying silent until tracks are added.")
+		return
+	}
+	e.lastFill = time.Now()
+	playlistTracks := append([]interfaces.Track(nil), e.playlistTracks...)
+	e.mutex.Unlock()
+
+	var (
+		tracks []interfaces.Track
+		err    error
+	)
+	switch mode {
+	case EmptyAutoplay:
+		tracks, err = autoplayTracks(last)
+	case EmptyLoopPlaylist:
+		tracks = playlistTracks
+	case EmptyFallback:
+		tracks, err = fallbackTracks()
+	default:
+		err = errors.New("Unknown queue.when_empty setting")
+	}
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"when_empty": mode,
+			"error":      err.Error(),
+		}).Warnln("Could not act on the queue running out.")
+		return
+	}
+
+	for _, track := range tracks {
+		if err := DJ.Queue.AppendTrack(track); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"title": track.GetTitle(),
+				"error": err.Error(),
+			}).Infoln("Could not add a track after the queue ran out.")
+		}
+	}
+}
+
+// autoplayTracks searches the service of `last` for tracks by its author and
+// returns the first one that was not played recently.
+func autoplayTracks(last interfaces.Track) ([]interfaces.Track, error) {
+	if last == nil || last.GetAuthor() == "" {
+		return nil, errors.New("The last track has no author to search for")
+	}
+	service, err := DJ.GetSearchService(last.GetService())
+	if err != nil {
+		return nil, err
+	}
+	results, err := service.SearchTracks(last.GetAuthor(), botUser(), autoplayCandidates)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		if result.GetID() != last.GetID() && !DJ.History.RecentlyPlayed(result) && !result.IsLive() {
+			return []interfaces.Track{result}, nil
+		}
+	}
+	return nil, errors.New("No track by the same author was found that was not played recently")
+}
+
+// fallbackTracks returns the tracks found at queue.fallback_stream.
+func fallbackTracks() ([]interfaces.Track, error) {
+	url := viper.GetString("queue.fallback_stream")
+	if url == "" {
+		return nil, errors.New("No fallback stream is configured in queue.fallback_stream")
+	}
+	service, err := DJ.GetService(url)
+	if err == ErrUnsupportedURL {
+		// Internet radio streams are only recognized by connecting to them.
+		if probed, ok := DJ.ProbeService(url, botUser()); ok {
+			service, err = probed, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return service.GetTracks(url, botUser())
+}
+
+// leave moves the bot to queue.empty_channel, or to the root channel if none is
+// set, and remembers the channel it left so that Return can move it back.
+func (e *EmptyQueue) leave() error {
+	if DJ.Client == nil || DJ.Client.Self == nil {
+		return errors.New("The bot is not connected")
+	}
+	var err error
+	DJ.Client.Do(func() {
+		lobby := DJ.Client.Channels.Find(emptyChannelPath()...)
+		if lobby == nil {
+			err = errors.New("The channel set in queue.empty_channel does not exist")
+			return
+		}
+		self := DJ.Client.Self
+		if self.Channel == lobby {
+			return
+		}
+		e.mutex.Lock()
+		e.leftFrom, e.left = channelPath(self.Channel), true
+		e.mutex.Unlock()
+		self.Move(lobby)
+	})
+	return err
+}
+
+// Return moves the bot back to the channel it left when the queue ran out, if
+// queue.rejoin_on_play is enabled. The bot stays where it is if someone has
+// moved it out of queue.empty_channel in the meantime.
+func (e *EmptyQueue) Return() {
+	e.mutex.Lock()
+	path, left := e.leftFrom, e.left
+	e.leftFrom, e.left = nil, false
+	e.mutex.Unlock()
+	if !left || !viper.GetBool("queue.rejoin_on_play") || DJ.Client == nil || DJ.Client.Self == nil {
+		return
+	}
+
+	DJ.Client.Do(func() {
+		self := DJ.Client.Self
+		if self.Channel != DJ.Client.Channels.Find(emptyChannelPath()...) {
+			return
+		}
+		if channel := DJ.Client.Channels.Find(path...); channel != nil {
+			self.Move(channel)
+		}
+	})
+}
+
+// LeaveIfIdle moves the bot to queue.empty_channel if queue.when_empty is
+// "leave" and there is nothing to play, such as right after connecting.
+func (e *EmptyQueue) LeaveIfIdle() {
+	if viper.GetString("queue.when_empty") != EmptyLeave || DJ.Queue.Length() > 0 {
+		return
+	}
+	if err := e.leave(); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Warnln("Could not move to the channel set in queue.empty_channel.")
+	}
+}
+
+// emptyChannelPath returns the path of queue.empty_channel. The root channel
+// has a nil path.
+func emptyChannelPath() []string {
+	if channel := viper.GetString("queue.empty_channel"); channel != "" {
+		return strings.Split(channel, "/")
+	}
+	return nil
+}
+
+// channelPath returns the names of the channels leading from the root channel
+// to `channel`, as taken by gumble.Channels.Find. The root channel has a nil
+// path.
+func channelPath(channel *gumble.Channel) []string {
+	var path []string
+	for ; channel != nil && channel.Parent != nil; channel = channel.Parent {
+		path = append([]string{channel.Name}, path...)
+	}
+	return path
+}
+
+// botUser returns the bot's own user, which submits the tracks the bot adds by
+// itself.
+func botUser() *gumble.User {
+	if DJ.Client != nil && DJ.Client.Self != nil {
+		return DJ.Client.Self
+	}
+	return &gumble.User{Name: viper.GetString("connection.username")}
+}
+
+// IsBotUser returns true if `user` is the bot's own user. The tracks it
+// submits come from the configuration rather than from a user, so services
+// may let it queue what only admins may queue otherwise.
+func IsBotUser(user *gumble.User) bool {
+	return user != nil && user.Name == botUser().Name
+}
@@ -0,0 +1,125 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/emptyqueue_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/layeh/gumble/gumbleffmpeg"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type EmptyQueueTestSuite struct {
+	suite.Suite
+}
+
+func (suite *EmptyQueueTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+	// Keep added tracks from being played.
+	DJ.AudioStream = new(gumbleffmpeg.Stream)
+	viper.Set("queue.when_empty", EmptyLoopPlaylist)
+}
+
+func (suite *EmptyQueueTestSuite) TearDownTest() {
+	viper.Set("queue.when_empty", EmptySilent)
+}
+
+func (suite *EmptyQueueTestSuite) TestLoopsLastPlaylist() {
+	first := &Playlist{ID: "first"}
+	second := &Playlist{ID: "second"}
+	DJ.EmptyQueue.Remember(&Track{ID: "a", Playlist: first})
+	DJ.EmptyQueue.Remember(&Track{ID: "b", Playlist: second})
+	DJ.EmptyQueue.Remember(&Track{ID: "single"})
+	DJ.EmptyQueue.Remember(&Track{ID: "c", Playlist: second})
+
+	DJ.EmptyQueue.Fill(nil)
+
+	suite.Equal(2, DJ.Queue.Length())
+	suite.Equal("b", DJ.Queue.GetTrack(0).GetID())
+	suite.Equal("c", DJ.Queue.GetTrack(1).GetID())
+}
+
+func (suite *EmptyQueueTestSuite) TestLoopsPlaylistWithoutGrowing() {
+	playlist := &Playlist{ID: "loop"}
+	a := &Track{ID: "a", Playlist: playlist}
+	b := &Track{ID: "b", Playlist: playlist}
+
+	for cycle := 0; cycle < 2; cycle++ {
+		// The tracks that were added again are remembered again as they play.
+		DJ.EmptyQueue.Remember(a)
+		DJ.EmptyQueue.Remember(b)
+		DJ.Queue.Reset()
+		DJ.EmptyQueue.lastFill = time.Time{}
+
+		DJ.EmptyQueue.Fill(nil)
+
+		suite.Equal(2, DJ.Queue.Length(), "Each loop should add the playlist once.")
+	}
+}
+
+func (suite *EmptyQueueTestSuite) TestDoesNotRefillRightAway() {
+	DJ.EmptyQueue.Remember(&Track{ID: "a", Playlist: &Playlist{ID: "first"}})
+
+	DJ.EmptyQueue.Fill(nil)
+	DJ.Queue.Reset()
+	DJ.EmptyQueue.Fill(nil)
+
+	suite.Zero(DJ.Queue.Length(), "Tracks that ran out right away should not be added again.")
+}
+
+func (suite *EmptyQueueTestSuite) TestStaysSilent() {
+	viper.Set("queue.when_empty", EmptySilent)
+	DJ.EmptyQueue.Remember(&Track{ID: "a", Playlist: &Playlist{ID: "first"}})
+
+	DJ.EmptyQueue.Fill(nil)
+
+	suite.Zero(DJ.Queue.Length())
+}
+
+func (suite *EmptyQueueTestSuite) TestAddsFallbackStream() {
+	viper.Set("queue.when_empty", EmptyFallback)
+	viper.Set("queue.fallback_stream", "http://radio.example.com:8000/live")
+	defer viper.Set("queue.fallback_stream", "")
+	DJ.AvailableServices = []interfaces.Service{stationService{formatService{name: "Radio"}}}
+
+	DJ.EmptyQueue.Fill(nil)
+
+	suite.Equal(1, DJ.Queue.Length(), "A stream that is only recognized by connecting to it should be added.")
+	suite.Equal("http://radio.example.com:8000/live", DJ.Queue.GetTrack(0).GetURL())
+}
+
+func (suite *EmptyQueueTestSuite) TestChannelPath() {
+	root := &gumble.Channel{Name: "Root"}
+	music := &gumble.Channel{Name: "Music", Parent: root}
+	lobby := &gumble.Channel{Name: "Waiting Room", Parent: music}
+
+	suite.Nil(channelPath(root))
+	suite.Equal([]string{"Music", "Waiting Room"}, channelPath(lobby))
+}
+
+// stationService recognizes any link as a station by probing it, but like the
+// Radio service only lets admins and the bot itself queue stations.
+type stationService struct {
+	formatService
+}
+
+func (s stationService) ProbeURL(url string, submitter *gumble.User) bool {
+	return DJ.IsAdmin(submitter) || IsBotUser(submitter)
+}
+
+func (s stationService) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	return []interfaces.Track{Track{ID: "station", URL: url, Submitter: submitter.Name, Live: true}}, nil
+}
+
+func TestEmptyQueueTestSuite(t *testing.T) {
+	suite.Run(t, new(EmptyQueueTestSuite))
+}
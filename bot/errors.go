@@ -0,0 +1,163 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/errors.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+)
+
+// ContextError is implemented by errors that carry more context than a single
+// message. Error() returns a short message that is suitable for sending in
+// chat, while Fields() returns everything needed to diagnose the problem from
+// the logs.
+type ContextError interface {
+	error
+	Fields() logrus.Fields
+}
+
+// ErrorFields returns the log fields that describe `err`. Errors that carry
+// context provide their own fields, all other errors are described by their
+// message alone.
+func ErrorFields(err error) logrus.Fields {
+	if contextErr, ok := err.(ContextError); ok {
+		fields := contextErr.Fields()
+		fields["error"] = err.Error()
+		return fields
+	}
+	return logrus.Fields{
+		"error": err.Error(),
+	}
+}
+
+// TrackError is returned when a particular track cannot be processed.
+type TrackError struct {
+	Service string
+	TrackID string
+	Message string
+	Err     error
+}
+
+// Error returns a short description of the error.
+func (e *TrackError) Error() string {
+	return e.Message
+}
+
+// Fields returns the log fields that describe the error.
+func (e *TrackError) Fields() logrus.Fields {
+	fields := logrus.Fields{
+		"service":  e.Service,
+		"track_id": e.TrackID,
+	}
+	if e.Err != nil {
+		fields["cause"] = e.Err.Error()
+	}
+	return fields
+}
+
+// DownloadError is returned when youtube-dl fails to download a track.
+type DownloadError struct {
+	Service string
+	TrackID string
+	URL     string
+	Command string
+	Output  string
+	Reason  string
+	Err     error
+}
+
+// Error returns a short description of the error. If youtube-dl reported a
+// reason for the failure, it is included.
+func (e *DownloadError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("The %s track %s could not be downloaded: %s", e.Service, e.TrackID, e.Reason)
+	}
+	return fmt.Sprintf("The %s track %s could not be downloaded", e.Service, e.TrackID)
+}
+
+// Fields returns the log fields that describe the error, including the full
+// output of youtube-dl.
+func (e *DownloadError) Fields() logrus.Fields {
+	fields := logrus.Fields{
+		"service":  e.Service,
+		"track_id": e.TrackID,
+		"url":      e.URL,
+		"command":  e.Command,
+		"output":   e.Output,
+	}
+	if e.Err != nil {
+		fields["cause"] = e.Err.Error()
+	}
+	return fields
+}
+
+// APIError is returned when a service's API responds with an error.
+type APIError struct {
+	Service    string
+	StatusCode int
+	Status     string
+	Message    string
+}
+
+// Error returns a short description of the error.
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("The %s API returned an error: %s", e.Service, e.Message)
+	}
+	return fmt.Sprintf("The %s API returned an error: %s", e.Service, e.Status)
+}
+
+// Fields returns the log fields that describe the error.
+func (e *APIError) Fields() logrus.Fields {
+	return logrus.Fields{
+		"service":     e.Service,
+		"status_code": e.StatusCode,
+		"status":      e.Status,
+		"message":     e.Message,
+	}
+}
+
+// UpcomingError is returned when a track is a live stream or premiere that
+// has not started yet.
+type UpcomingError struct {
+	Service string
+	TrackID string
+	Title   string
+	// StartsAt is when the track is scheduled to start, or the zero time if
+	// that is not known.
+	StartsAt time.Time
+}
+
+// Error returns a short description of the error, including when the track
+// starts if that is known.
+func (e *UpcomingError) Error() string {
+	if e.StartsAt.IsZero() {
+		return fmt.Sprintf("\"%s\" is a live stream or premiere that has not started yet", e.Title)
+	}
+	return fmt.Sprintf("\"%s\" is a live stream or premiere that has not started yet. It starts in %s", e.Title, e.StartsIn())
+}
+
+// StartsIn returns how long it is until the track starts, to the minute.
+func (e *UpcomingError) StartsIn() string {
+	wait := time.Until(e.StartsAt).Round(time.Minute)
+	if wait < 0 {
+		wait = 0
+	}
+	return wait.String()
+}
+
+// Fields returns the log fields that describe the error.
+func (e *UpcomingError) Fields() logrus.Fields {
+	return logrus.Fields{
+		"service":   e.Service,
+		"track_id":  e.TrackID,
+		"starts_at": e.StartsAt,
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/errors_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ErrorsTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ErrorsTestSuite) TestErrorFieldsWithPlainError() {
+	fields := ErrorFields(errors.New("plain"))
+
+	suite.Equal(1, len(fields), "Only the error message should be present.")
+	suite.Equal("plain", fields["error"])
+}
+
+func (suite *ErrorsTestSuite) TestErrorFieldsWithDownloadError() {
+	err := &DownloadError{
+		Service: "YouTube",
+		TrackID: "KQY9zrjPBjo",
+		Output:  "ERROR: This video is unavailable.",
+	}
+	fields := ErrorFields(err)
+
+	suite.Equal(err.Error(), fields["error"])
+	suite.Equal("KQY9zrjPBjo", fields["track_id"])
+	suite.Equal("ERROR: This video is unavailable.", fields["output"], "The full output should be logged.")
+	suite.NotContains(err.Error(), "unavailable", "The output should not be included in the short message.")
+}
+
+func (suite *ErrorsTestSuite) TestDownloadErrorIncludesReason() {
+	err := &DownloadError{
+		Service: "YouTube",
+		TrackID: "KQY9zrjPBjo",
+		Reason:  "Video unavailable",
+	}
+
+	suite.Contains(err.Error(), "Video unavailable")
+}
+
+func (suite *ErrorsTestSuite) TestParseYouTubeDLError() {
+	output := "[youtube] KQY9zrjPBjo: Downloading webpage\n" +
+		"WARNING: unable to extract uploader id\n" +
+		"ERROR: [youtube] KQY9zrjPBjo: Sign in to confirm your age; please report this issue on https://yt-dl.org/bug\n"
+
+	suite.Equal("Sign in to confirm your age", parseYouTubeDLError(output))
+	suite.Equal("Video unavailable", parseYouTubeDLError("ERROR: Video unavailable"))
+	suite.Equal("", parseYouTubeDLError("WARNING: nothing went wrong\n"), "Output without errors should produce no reason.")
+}
+
+func (suite *ErrorsTestSuite) TestAPIErrorMessage() {
+	withMessage := &APIError{Service: "YouTube", StatusCode: 403, Status: "403 Forbidden", Message: "Quota exceeded"}
+	withoutMessage := &APIError{Service: "YouTube", StatusCode: 403, Status: "403 Forbidden"}
+
+	suite.Contains(withMessage.Error(), "Quota exceeded")
+	suite.Contains(withoutMessage.Error(), "403 Forbidden")
+	suite.Equal(403, ErrorFields(withMessage)["status_code"])
+}
+
+func (suite *ErrorsTestSuite) TestUpcomingErrorMessage() {
+	scheduled := &UpcomingError{Title: "Premiere", StartsAt: time.Now().Add(90*time.Minute + 10*time.Second)}
+	unscheduled := &UpcomingError{Title: "Premiere"}
+
+	suite.Equal("1h30m0s", scheduled.StartsIn())
+	suite.Contains(scheduled.Error(), "It starts in 1h30m0s")
+	suite.NotContains(unscheduled.Error(), "It starts in")
+}
+
+func TestErrorsTestSuite(t *testing.T) {
+	suite.Run(t, new(ErrorsTestSuite))
+}
@@ -0,0 +1,41 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/events.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// IsEventHost returns true if event mode is on and the user with the given
+// name is listed in queue.event_hosts.
+func IsEventHost(name string) bool {
+	if !viper.GetBool("queue.event_mode_on") {
+		return false
+	}
+	for _, host := range viper.GetStringSlice("queue.event_hosts") {
+		if name == host {
+			return true
+		}
+	}
+	return false
+}
+
+// EventInsertPosition returns the position in the queue at which a track
+// added by an event host should be inserted: after the current track and any
+// host tracks already waiting directly behind it, so that hosts' tracks keep
+// the order in which they were added.
+func (dj *MumbleDJ) EventInsertPosition() int {
+	position := 0
+	dj.Queue.Traverse(func(i int, t interfaces.Track) {
+		if i == 0 || (i == position && IsEventHost(t.GetSubmitter())) {
+			position = i + 1
+		}
+	})
+	return position
+}
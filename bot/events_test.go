@@ -0,0 +1,69 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/events_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventsTestSuite struct {
+	suite.Suite
+}
+
+func (suite *EventsTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+	viper.Set("queue.event_mode_on", true)
+	viper.Set("queue.event_hosts", []string{"host"})
+}
+
+func (suite *EventsTestSuite) TearDownTest() {
+	viper.Set("queue.event_mode_on", false)
+	viper.Set("queue.event_hosts", []string{})
+}
+
+func (suite *EventsTestSuite) TestIsEventHost() {
+	suite.True(IsEventHost("host"))
+	suite.False(IsEventHost("guest"))
+
+	viper.Set("queue.event_mode_on", false)
+
+	suite.False(IsEventHost("host"), "Nobody should be a host while event mode is off.")
+}
+
+func (suite *EventsTestSuite) TestEventInsertPositionWithEmptyQueue() {
+	suite.Equal(0, DJ.EventInsertPosition())
+}
+
+func (suite *EventsTestSuite) TestEventInsertPositionAfterHostTracks() {
+	queue := DJ.Queue.(*Queue)
+	queue.Queue = append(queue.Queue,
+		Track{ID: "current", Submitter: "guest"},
+		Track{ID: "host1", Submitter: "host"},
+		Track{ID: "guest1", Submitter: "guest"},
+		Track{ID: "host2", Submitter: "host"},
+	)
+
+	suite.Equal(2, DJ.EventInsertPosition())
+}
+
+func (suite *EventsTestSuite) TestEventInsertPositionAfterCurrentTrack() {
+	queue := DJ.Queue.(*Queue)
+	queue.Queue = append(queue.Queue,
+		Track{ID: "current", Submitter: "host"},
+		Track{ID: "guest1", Submitter: "guest"},
+	)
+
+	suite.Equal(1, DJ.EventInsertPosition())
+}
+
+func TestEventsTestSuite(t *testing.T) {
+	suite.Run(t, new(EventsTestSuite))
+}
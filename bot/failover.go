@@ -0,0 +1,189 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/failover.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// probeTimeout is how long the bot waits for the primary server to answer
+// when checking whether it can return to it.
+const probeTimeout = 5 * time.Second
+
+// Failover moves the bot to the servers in connection.fallback_servers when
+// the server it is on cannot be reached for connection.failover_after
+// seconds, carrying the queue along. While the bot is on a fallback server, it
+// checks every connection.return_interval seconds whether the primary server
+// is back, and returns to it once it is.
+type Failover struct {
+	current   int
+	switching bool
+	returning bool
+	mutex     sync.Mutex
+}
+
+// NewFailover returns a Failover for a bot on the primary server.
+func NewFailover() *Failover {
+	return &Failover{}
+}
+
+// Servers returns the primary server followed by the fallback servers, each
+// as "address:port".
+func (f *Failover) Servers() []string {
+	primary := net.JoinHostPort(viper.GetString("connection.address"), viper.GetString("connection.port"))
+	return append([]string{primary}, viper.GetStringSlice("connection.fallback_servers")...)
+}
+
+// Current returns the server the bot is on, or is connecting to.
+func (f *Failover) Current() string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	servers := f.Servers()
+	return servers[f.current%len(servers)]
+}
+
+// OnPrimary returns true if the bot is on the primary server.
+func (f *Failover) OnPrimary() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.current%len(f.Servers()) == 0
+}
+
+// Next moves on to the next server, starting over with the primary server
+// after the last fallback server, and returns it.
+func (f *Failover) Next() string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	servers := f.Servers()
+	f.current = (f.current + 1) % len(servers)
+	return servers[f.current]
+}
+
+// Switching returns true, once, after the bot has disconnected on purpose to
+// return to the primary server, so that the disconnection is not taken for a
+// lost connection.
+func (f *Failover) Switching() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	switching := f.switching
+	f.switching = false
+	return switching
+}
+
+// Connected is called once the bot has connected to a server. If it is a
+// fallback server, the bot starts checking whether it can return to the
+// primary server.
+func (f *Failover) Connected() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.current%len(f.Servers()) == 0 || f.returning {
+		return
+	}
+	f.returning = true
+	go f.returnToPrimary()
+}
+
+// returnToPrimary waits until the primary server can be reached, and then
+// moves the bot back to it with its queue.
+func (f *Failover) returnToPrimary() {
+	defer func() {
+		f.mutex.Lock()
+		f.returning = false
+		f.mutex.Unlock()
+	}()
+
+	primary := f.Servers()[0]
+	for {
+		time.Sleep(time.Duration(viper.GetInt("connection.return_interval")) * time.Second)
+		if f.OnPrimary() {
+			return
+		}
+		connection, err := net.DialTimeout("tcp", primary, probeTimeout)
+		if err != nil {
+			continue
+		}
+		connection.Close()
+
+		logrus.WithFields(logrus.Fields{
+			"server": primary,
+		}).Infoln("The primary server is reachable again. Returning to it...")
+		f.mutex.Lock()
+		f.current = 0
+		f.switching = true
+		f.mutex.Unlock()
+		DJ.Reconnect.Disconnected()
+		DJ.Client.Disconnect()
+		go DJ.reconnectOrExit()
+		return
+	}
+}
+
+// dial connects to `server`, given as "address:port".
+func (dj *MumbleDJ) dial(server string) (*gumble.Client, error) {
+	host, _, err := net.SplitHostPort(server)
+	if err != nil {
+		return nil, err
+	}
+	// The certificate of each server is checked against its own address.
+	config := &tls.Config{
+		InsecureSkipVerify: dj.TLSConfig.InsecureSkipVerify,
+		Certificates:       dj.TLSConfig.Certificates,
+	}
+	if !config.InsecureSkipVerify {
+		config.ServerName = host
+	}
+	return gumble.DialWithDialer(new(net.Dialer), server, dj.GumbleConfig, config)
+}
+
+// reconnect connects to the server the bot is on again, trying
+// connection.retry_attempts times every connection.retry_interval seconds. If
+// fallback servers are configured, the bot moves on to the next server once
+// the attempts are used up or the server has been unreachable for
+// connection.failover_after seconds. An error is returned once no server
+// could be reached.
+func (dj *MumbleDJ) reconnect() error {
+	servers := len(dj.Failover.Servers())
+	failoverAfter := time.Duration(viper.GetInt("connection.failover_after")) * time.Second
+	for tried := 0; tried < servers; tried++ {
+		server := dj.Failover.Current()
+		since := time.Now()
+		for retries := 0; retries < viper.GetInt("connection.retry_attempts"); retries++ {
+			logrus.WithFields(logrus.Fields{
+				"server": server,
+			}).Infoln("Retrying connection...")
+			if client, err := dj.dial(server); err == nil {
+				dj.Client = client
+				logrus.WithFields(logrus.Fields{
+					"server": server,
+				}).Infoln("Successfully reconnected to the server!")
+				dj.Failover.Connected()
+				return nil
+			}
+			if servers > 1 && time.Since(since) >= failoverAfter {
+				break
+			}
+			time.Sleep(time.Duration(viper.GetInt("connection.retry_interval")) * time.Second)
+		}
+		if servers > 1 {
+			next := dj.Failover.Next()
+			logrus.WithFields(logrus.Fields{
+				"server": server,
+				"next":   next,
+			}).Warnln("The server is unreachable. Moving on to the next server...")
+		}
+	}
+	return errors.New("No server could be reached")
+}
@@ -0,0 +1,71 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/failover_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type FailoverTestSuite struct {
+	suite.Suite
+	Failover *Failover
+}
+
+func (suite *FailoverTestSuite) SetupTest() {
+	suite.Failover = NewFailover()
+	viper.Set("connection.address", "primary.example.com")
+	viper.Set("connection.port", "64738")
+	viper.Set("connection.fallback_servers", []string{"backup.example.com:64738", "other.example.com:1234"})
+}
+
+func (suite *FailoverTestSuite) TearDownTest() {
+	viper.Set("connection.address", "127.0.0.1")
+	viper.Set("connection.fallback_servers", []string{})
+}
+
+func (suite *FailoverTestSuite) TestServers() {
+	suite.Equal([]string{"primary.example.com:64738", "backup.example.com:64738", "other.example.com:1234"},
+		suite.Failover.Servers())
+}
+
+func (suite *FailoverTestSuite) TestStartsOnPrimary() {
+	suite.True(suite.Failover.OnPrimary())
+	suite.Equal("primary.example.com:64738", suite.Failover.Current())
+}
+
+func (suite *FailoverTestSuite) TestNextWrapsAround() {
+	suite.Equal("backup.example.com:64738", suite.Failover.Next())
+	suite.False(suite.Failover.OnPrimary())
+	suite.Equal("other.example.com:1234", suite.Failover.Next())
+	suite.Equal("primary.example.com:64738", suite.Failover.Next(), "The primary server should follow the last fallback server.")
+	suite.True(suite.Failover.OnPrimary())
+}
+
+func (suite *FailoverTestSuite) TestCurrentWhenFallbacksAreRemoved() {
+	suite.Failover.Next()
+	suite.Failover.Next()
+	viper.Set("connection.fallback_servers", []string{})
+
+	suite.Equal("primary.example.com:64738", suite.Failover.Current())
+}
+
+func (suite *FailoverTestSuite) TestSwitchingIsReportedOnce() {
+	suite.False(suite.Failover.Switching())
+
+	suite.Failover.switching = true
+
+	suite.True(suite.Failover.Switching())
+	suite.False(suite.Failover.Switching())
+}
+
+func TestFailoverTestSuite(t *testing.T) {
+	suite.Run(t, new(FailoverTestSuite))
+}
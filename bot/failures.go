@@ -0,0 +1,71 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/failures.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// CommandFailure describes a command that could not be executed, either
+// because it was not recognized, the user lacked permission, or the command
+// itself returned an error.
+type CommandFailure struct {
+	Time    time.Time
+	User    string
+	Message string
+	Reason  string
+}
+
+// Failures keeps a bounded history of recent command failures so admins can
+// find out why the bot did not respond to somebody.
+type Failures struct {
+	entries []CommandFailure
+	mutex   sync.RWMutex
+}
+
+// NewFailures returns an empty Failures.
+func NewFailures() *Failures {
+	return &Failures{
+		entries: make([]CommandFailure, 0),
+	}
+}
+
+// Record adds a failure to the history, discarding the oldest failures once
+// more than commands.failures.history_size are stored.
+func (f *Failures) Record(user, message, reason string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.entries = append(f.entries, CommandFailure{
+		Time:    time.Now(),
+		User:    user,
+		Message: message,
+		Reason:  reason,
+	})
+	if size := viper.GetInt("commands.failures.history_size"); size >= 0 && len(f.entries) > size {
+		f.entries = f.entries[len(f.entries)-size:]
+	}
+}
+
+// Recent returns up to `n` of the most recent failures, newest first.
+func (f *Failures) Recent(n int) []CommandFailure {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	if n > len(f.entries) {
+		n = len(f.entries)
+	}
+	recent := make([]CommandFailure, 0, n)
+	for i := len(f.entries) - 1; i >= len(f.entries)-n; i-- {
+		recent = append(recent, f.entries[i])
+	}
+	return recent
+}
@@ -0,0 +1,68 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/failures_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type FailuresTestSuite struct {
+	suite.Suite
+	Failures *Failures
+}
+
+func (suite *FailuresTestSuite) SetupTest() {
+	suite.Failures = NewFailures()
+	viper.Set("commands.failures.history_size", 3)
+}
+
+func (suite *FailuresTestSuite) TearDownTest() {
+	viper.Set("commands.failures.history_size", 20)
+}
+
+func (suite *FailuresTestSuite) TestRecentIsNewestFirst() {
+	suite.Failures.Record("test1", "skip", "reason1")
+	suite.Failures.Record("test2", "kill", "reason2")
+
+	recent := suite.Failures.Recent(10)
+
+	suite.Len(recent, 2)
+	suite.Equal("test2", recent[0].User)
+	suite.Equal("kill", recent[0].Message)
+	suite.Equal("reason2", recent[0].Reason)
+	suite.Equal("test1", recent[1].User)
+}
+
+func (suite *FailuresTestSuite) TestRecentLimitsCount() {
+	suite.Failures.Record("test1", "skip", "reason")
+	suite.Failures.Record("test2", "skip", "reason")
+
+	recent := suite.Failures.Recent(1)
+
+	suite.Len(recent, 1)
+	suite.Equal("test2", recent[0].User)
+}
+
+func (suite *FailuresTestSuite) TestRecordDiscardsOldest() {
+	for _, name := range []string{"test1", "test2", "test3", "test4"} {
+		suite.Failures.Record(name, "skip", "reason")
+	}
+
+	recent := suite.Failures.Recent(10)
+
+	suite.Len(recent, 3)
+	suite.Equal("test4", recent[0].User)
+	suite.Equal("test2", recent[2].User)
+}
+
+func TestFailuresTestSuite(t *testing.T) {
+	suite.Run(t, new(FailuresTestSuite))
+}
@@ -0,0 +1,139 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/fixtures.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// IsReplayMode returns true if the bot is running in development replay mode,
+// in which API responses are served from recorded fixtures and downloads are
+// replaced by a dummy audio file. Replay mode is enabled by setting
+// dev.fixtures_directory without enabling dev.record.
+func IsReplayMode() bool {
+	return viper.GetString("dev.fixtures_directory") != "" && !viper.GetBool("dev.record")
+}
+
+// HTTPGet performs a GET request on the provided URL. In replay mode the
+// response is read from the fixture recorded for the URL instead. If
+// dev.record is enabled, successful responses are saved as fixtures so that
+// they can be replayed later without API keys.
+func HTTPGet(url string) (*http.Response, error) {
+	if viper.GetString("dev.fixtures_directory") == "" {
+		return http.Get(url)
+	}
+
+	fixture := fixturePath(url)
+	if !viper.GetBool("dev.record") {
+		body, err := ioutil.ReadFile(fixture)
+		if err != nil {
+			return nil, fmt.Errorf("No fixture has been recorded for %s", fixtureURL(url))
+		}
+		return &http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(body)),
+		}, nil
+	}
+
+	response, err := http.Get(url)
+	if err != nil || response.StatusCode != http.StatusOK {
+		return response, err
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(fixture), 0777); err == nil {
+		err = ioutil.WriteFile(fixture, body, 0644)
+	}
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"url":   fixtureURL(url),
+			"error": err.Error(),
+		}).Warnln("Could not record fixture.")
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return response, nil
+}
+
+// copyDummyAudio writes the file configured in dev.dummy_audio to `path`,
+// standing in for a track download in replay mode.
+func copyDummyAudio(path string) error {
+	dummy := os.ExpandEnv(viper.GetString("dev.dummy_audio"))
+	if dummy == "" {
+		return errors.New("dev.dummy_audio must be set to play tracks in replay mode")
+	}
+	in, err := os.Open(dummy)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+		return err
+	}
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	_, err = io.Copy(out, in)
+	return err
+}
+
+// credentialParameters are the query parameters that carry API keys, tokens
+// and logins. Subsonic's t and s change on every request.
+var credentialParameters = []string{"key", "client_id", "t", "s", "u", "p", "api_key", "token", "X-Plex-Token"}
+
+// fixturePath returns the path of the fixture for `url`. Credentials are left
+// out of the fixture name so that recorded fixtures can be shared and replayed
+// without API keys.
+func fixturePath(url string) string {
+	hash := sha1.Sum([]byte(fixtureURL(url)))
+	return filepath.Join(os.ExpandEnv(viper.GetString("dev.fixtures_directory")), hex.EncodeToString(hash[:])+".json")
+}
+
+// fixtureURL returns `url` without its credential query parameters, whether
+// or not they are configured.
+func fixtureURL(url string) string {
+	parsed, err := neturl.Parse(redactAPIKeys(url))
+	if err != nil {
+		return redactAPIKeys(url)
+	}
+	query := parsed.Query()
+	for _, parameter := range credentialParameters {
+		query.Del(parameter)
+	}
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
+
+// redactAPIKeys replaces the configured API keys in `url` with a placeholder.
+func redactAPIKeys(url string) string {
+	for _, setting := range []string{"api_keys.youtube", "api_keys.soundcloud", "api_keys.jamendo", "funkwhale.token", "jellyfin.api_key", "plex.token"} {
+		if key := viper.GetString(setting); key != "" {
+			url = strings.Replace(url, key, "API_KEY", -1)
+		}
+	}
+	return url
+}
@@ -0,0 +1,108 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/fixtures_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type FixturesTestSuite struct {
+	suite.Suite
+	Dir    string
+	Server *httptest.Server
+}
+
+func (suite *FixturesTestSuite) SetupTest() {
+	suite.Dir, _ = ioutil.TempDir("", "mumbledj-fixtures")
+	suite.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"title": "recorded"}`)
+	}))
+	viper.Set("dev.fixtures_directory", suite.Dir)
+	viper.Set("api_keys.youtube", "secret")
+}
+
+func (suite *FixturesTestSuite) TearDownTest() {
+	suite.Server.Close()
+	os.RemoveAll(suite.Dir)
+	viper.Set("dev.fixtures_directory", "")
+	viper.Set("dev.record", false)
+	viper.Set("dev.dummy_audio", "")
+	viper.Set("api_keys.youtube", "")
+}
+
+func (suite *FixturesTestSuite) TestRecordThenReplay() {
+	url := suite.Server.URL + "/videos?key=secret"
+	viper.Set("dev.record", true)
+
+	response, err := HTTPGet(url)
+	suite.Nil(err)
+	body, _ := ioutil.ReadAll(response.Body)
+	suite.Equal(`{"title": "recorded"}`, string(body), "The recorded response should still be returned.")
+
+	suite.Server.Close()
+	viper.Set("dev.record", false)
+	viper.Set("api_keys.youtube", "another")
+
+	response, err = HTTPGet(suite.Server.URL + "/videos?key=another")
+	suite.Nil(err, "The fixture should be found regardless of the API key.")
+	body, _ = ioutil.ReadAll(response.Body)
+	suite.Equal(`{"title": "recorded"}`, string(body))
+}
+
+func (suite *FixturesTestSuite) TestFixturePathWithoutCredentials() {
+	recorded := fixturePath("https://api.example.com/rest/search3?query=song&u=me&t=aaaa&s=1111&key=secret")
+	viper.Set("api_keys.youtube", "")
+
+	suite.Equal(recorded, fixturePath("https://api.example.com/rest/search3?query=song&u=me&t=bbbb&s=2222&key=other"),
+		"Credentials should not change the fixture, even if they are not configured.")
+	suite.Equal(recorded, fixturePath("https://api.example.com/rest/search3?query=song"))
+	suite.NotEqual(recorded, fixturePath("https://api.example.com/rest/search3?query=other"))
+}
+
+func (suite *FixturesTestSuite) TestReplayWithoutFixture() {
+	_, err := HTTPGet(suite.Server.URL + "/videos?key=secret")
+
+	suite.NotNil(err)
+	suite.NotContains(err.Error(), "secret", "API keys should not appear in the error.")
+}
+
+func (suite *FixturesTestSuite) TestIsReplayMode() {
+	suite.True(IsReplayMode())
+
+	viper.Set("dev.record", true)
+	suite.False(IsReplayMode(), "Recording should make real API calls.")
+
+	viper.Set("dev.fixtures_directory", "")
+	viper.Set("dev.record", false)
+	suite.False(IsReplayMode())
+}
+
+func (suite *FixturesTestSuite) TestCopyDummyAudio() {
+	dummy := filepath.Join(suite.Dir, "dummy.mp3")
+	ioutil.WriteFile(dummy, []byte("audio"), 0644)
+	viper.Set("dev.dummy_audio", dummy)
+	target := filepath.Join(suite.Dir, "cache", "track.track")
+
+	suite.Nil(copyDummyAudio(target))
+
+	data, _ := ioutil.ReadFile(target)
+	suite.Equal("audio", string(data))
+}
+
+func TestFixturesTestSuite(t *testing.T) {
+	suite.Run(t, new(FixturesTestSuite))
+}
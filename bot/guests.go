@@ -0,0 +1,120 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/guests.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// guestCodeAlphabet is the set of characters guest codes are made of. Letters
+// and digits that are easily confused are left out.
+const guestCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// ErrGuestCodeRequired is returned when a guest makes their first request
+// without a valid guest code.
+var ErrGuestCodeRequired = errors.New("A valid guest code is required")
+
+// Guests keeps track of the one-time codes admins hand out to guests, and of
+// the guests who have redeemed one. While guests.require_code is enabled,
+// users who are not registered on the server must supply a code with their
+// first request.
+type Guests struct {
+	codes    map[string]time.Time
+	verified map[string]bool
+	mutex    sync.Mutex
+}
+
+// NewGuests returns a Guests with no codes.
+func NewGuests() *Guests {
+	return &Guests{
+		codes:    make(map[string]time.Time),
+		verified: make(map[string]bool),
+	}
+}
+
+// NewCode generates a one-time guest code that expires after
+// guests.code_lifetime minutes.
+func (g *Guests) NewCode() (string, error) {
+	return g.newCode(time.Now())
+}
+
+func (g *Guests) newCode(now time.Time) (string, error) {
+	code := make([]byte, viper.GetInt("guests.code_length"))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(guestCodeAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		code[i] = guestCodeAlphabet[n.Int64()]
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.removeExpired(now)
+	g.codes[string(code)] = now.Add(time.Duration(viper.GetInt("guests.code_lifetime")) * time.Minute)
+	return string(code), nil
+}
+
+// NeedsCode returns true if `user` must supply a guest code with their next
+// request.
+func (g *Guests) NeedsCode(user *gumble.User) bool {
+	if !viper.GetBool("guests.require_code") || user.IsRegistered() || DJ.IsAdmin(user) {
+		return false
+	}
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return !g.verified[user.Name]
+}
+
+// CheckRequest checks the arguments of a request made by `user`. If the user
+// must supply a guest code, the last argument is redeemed as one and the
+// remaining arguments are returned. ErrGuestCodeRequired is returned if no
+// valid code was supplied.
+func (g *Guests) CheckRequest(user *gumble.User, args []string) ([]string, error) {
+	if !g.NeedsCode(user) {
+		return args, nil
+	}
+	if len(args) < 2 || !g.redeem(user.Name, args[len(args)-1], time.Now()) {
+		return nil, ErrGuestCodeRequired
+	}
+	return args[:len(args)-1], nil
+}
+
+// redeem uses up `code` for the guest with name `name`. Returns false if the
+// code does not exist or has expired.
+func (g *Guests) redeem(name, code string, now time.Time) bool {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.removeExpired(now)
+
+	code = strings.ToUpper(code)
+	if _, ok := g.codes[code]; !ok {
+		return false
+	}
+	delete(g.codes, code)
+	g.verified[name] = true
+	return true
+}
+
+// removeExpired removes codes that can no longer be redeemed. The mutex must be
+// held by the caller.
+func (g *Guests) removeExpired(now time.Time) {
+	for code, expiry := range g.codes {
+		if !now.Before(expiry) {
+			delete(g.codes, code)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/guests_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type GuestsTestSuite struct {
+	suite.Suite
+	Guests *Guests
+	Guest  *gumble.User
+}
+
+func (suite *GuestsTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+	suite.Guests = NewGuests()
+	suite.Guest = &gumble.User{Name: "guest"}
+	viper.Set("guests.require_code", true)
+	viper.Set("guests.code_length", 6)
+	viper.Set("guests.code_lifetime", 60)
+	viper.Set("admins.names", []string{"SuperUser"})
+}
+
+func (suite *GuestsTestSuite) TearDownTest() {
+	viper.Set("guests.require_code", false)
+}
+
+func (suite *GuestsTestSuite) TestNewCode() {
+	code, err := suite.Guests.NewCode()
+
+	suite.Nil(err)
+	suite.Len(code, 6)
+}
+
+func (suite *GuestsTestSuite) TestNeedsCode() {
+	suite.True(suite.Guests.NeedsCode(suite.Guest))
+	suite.False(suite.Guests.NeedsCode(&gumble.User{Name: "SuperUser"}))
+	suite.False(suite.Guests.NeedsCode(&gumble.User{Name: "member", UserID: 5}))
+
+	viper.Set("guests.require_code", false)
+	suite.False(suite.Guests.NeedsCode(suite.Guest))
+}
+
+func (suite *GuestsTestSuite) TestCheckRequest() {
+	code, _ := suite.Guests.NewCode()
+
+	_, err := suite.Guests.CheckRequest(suite.Guest, []string{"https://example.com/song.mp3"})
+	suite.Equal(ErrGuestCodeRequired, err)
+
+	args, err := suite.Guests.CheckRequest(suite.Guest, []string{"https://example.com/song.mp3", code})
+	suite.Nil(err)
+	suite.Equal([]string{"https://example.com/song.mp3"}, args)
+
+	// Later requests do not need a code.
+	args, err = suite.Guests.CheckRequest(suite.Guest, []string{"https://example.com/other.mp3"})
+	suite.Nil(err)
+	suite.Len(args, 1)
+}
+
+func (suite *GuestsTestSuite) TestCodesCanOnlyBeUsedOnce() {
+	code, _ := suite.Guests.NewCode()
+
+	suite.True(suite.Guests.redeem("first", code, time.Now()))
+	suite.False(suite.Guests.redeem("second", code, time.Now()))
+}
+
+func (suite *GuestsTestSuite) TestCodesExpire() {
+	now := time.Now()
+	code, _ := suite.Guests.newCode(now)
+
+	suite.False(suite.Guests.redeem("guest", code, now.Add(time.Hour)))
+}
+
+func TestGuestsTestSuite(t *testing.T) {
+	suite.Run(t, new(GuestsTestSuite))
+}
@@ -0,0 +1,155 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/history.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// History remembers when tracks were last played so that tracks which were
+// played recently can be left out when a playlist is added again. It also
+// keeps the notes attached to songs, which are saved to notes.file so that
+// they are shown whenever the song plays again, even after a restart.
+type History struct {
+	played      map[string]time.Time
+	notes       map[string]Note
+	notesLoaded bool
+	mutex       sync.RWMutex
+}
+
+// Note is a remark attached to a song, such as "crowd favorite, play louder".
+type Note struct {
+	Text   string    `json:"text"`
+	Author string    `json:"author"`
+	Added  time.Time `json:"added"`
+}
+
+// NewHistory returns an empty History.
+func NewHistory() *History {
+	return &History{
+		played: make(map[string]time.Time),
+		notes:  make(map[string]Note),
+	}
+}
+
+// Record notes that the track has just begun playing. Tracks played longer
+// ago than queue.recently_played_window are forgotten.
+func (h *History) Record(t interfaces.Track) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	now := time.Now()
+	window := recentlyPlayedWindow()
+	for key, played := range h.played {
+		if now.Sub(played) > window {
+			delete(h.played, key)
+		}
+	}
+	if window > 0 {
+		h.played[historyKey(t)] = now
+	}
+}
+
+// RecentlyPlayed returns true if the track was played within the last
+// queue.recently_played_window minutes.
+func (h *History) RecentlyPlayed(t interfaces.Track) bool {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	played, ok := h.played[historyKey(t)]
+	return ok && time.Since(played) <= recentlyPlayedWindow()
+}
+
+// FilterPlaylistTracks removes playlist tracks that were played recently from
+// `tracks` and returns the remaining tracks along with the number removed.
+// Tracks that are not part of a playlist are always kept.
+func (h *History) FilterPlaylistTracks(tracks []interfaces.Track) ([]interfaces.Track, int) {
+	if recentlyPlayedWindow() <= 0 {
+		return tracks, 0
+	}
+
+	filtered := make([]interfaces.Track, 0, len(tracks))
+	for _, track := range tracks {
+		if track.GetPlaylist() == nil || !h.RecentlyPlayed(track) {
+			filtered = append(filtered, track)
+		}
+	}
+	return filtered, len(tracks) - len(filtered)
+}
+
+// Note returns the note attached to the track, if any.
+func (h *History) Note(t interfaces.Track) (Note, bool) {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	note, ok := h.notes[historyKey(t)]
+	return note, ok
+}
+
+// SetNote attaches the note `text` by `author` to the track, replacing any
+// note it had, and saves the notes. An empty `text` removes the note.
+func (h *History) SetNote(t interfaces.Track, text, author string) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if text == "" {
+		delete(h.notes, historyKey(t))
+	} else {
+		h.notes[historyKey(t)] = Note{Text: text, Author: author, Added: time.Now()}
+	}
+	return h.saveNotes()
+}
+
+// LoadNotes reads the notes saved to notes.file, if it exists. The notes are
+// only loaded once, so that notes added since are kept when the bot
+// reconnects.
+func (h *History) LoadNotes() error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if h.notesLoaded {
+		return nil
+	}
+	h.notesLoaded = true
+	data, err := ioutil.ReadFile(os.ExpandEnv(viper.GetString("notes.file")))
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &h.notes)
+}
+
+// saveNotes writes the notes to notes.file. The caller must hold the mutex.
+func (h *History) saveNotes() error {
+	data, err := json.Marshal(h.notes)
+	if err != nil {
+		return err
+	}
+	filePath := os.ExpandEnv(viper.GetString("notes.file"))
+	if err := os.MkdirAll(filepath.Dir(filePath), 0777); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filePath, data, 0644)
+}
+
+func historyKey(t interfaces.Track) string {
+	return t.GetService() + ":" + t.GetID()
+}
+
+func recentlyPlayedWindow() time.Duration {
+	return time.Duration(viper.GetInt("queue.recently_played_window")) * time.Minute
+}
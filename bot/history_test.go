@@ -0,0 +1,128 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/history_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type HistoryTestSuite struct {
+	suite.Suite
+	History  *History
+	Playlist *Playlist
+	Dir      string
+}
+
+func (suite *HistoryTestSuite) SetupTest() {
+	suite.History = NewHistory()
+	suite.Playlist = &Playlist{ID: "playlist"}
+	viper.Set("queue.recently_played_window", 60)
+	suite.Dir, _ = ioutil.TempDir("", "mumbledj-history")
+	viper.Set("notes.file", filepath.Join(suite.Dir, "notes.json"))
+}
+
+func (suite *HistoryTestSuite) TearDownTest() {
+	viper.Set("queue.recently_played_window", 0)
+	os.RemoveAll(suite.Dir)
+}
+
+func (suite *HistoryTestSuite) TestRecentlyPlayed() {
+	played := Track{ID: "played", Service: "YouTube"}
+	other := Track{ID: "played", Service: "SoundCloud"}
+
+	suite.History.Record(played)
+
+	suite.True(suite.History.RecentlyPlayed(played))
+	suite.False(suite.History.RecentlyPlayed(other), "Tracks from other services with the same ID should not match.")
+}
+
+func (suite *HistoryTestSuite) TestRecentlyPlayedExpires() {
+	played := Track{ID: "played", Service: "YouTube"}
+	suite.History.played[historyKey(played)] = time.Now().Add(-2 * time.Hour)
+
+	suite.False(suite.History.RecentlyPlayed(played))
+}
+
+func (suite *HistoryTestSuite) TestFilterPlaylistTracks() {
+	playedInPlaylist := Track{ID: "1", Service: "YouTube", Playlist: suite.Playlist}
+	newInPlaylist := Track{ID: "2", Service: "YouTube", Playlist: suite.Playlist}
+	playedAlone := Track{ID: "3", Service: "YouTube"}
+	suite.History.Record(playedInPlaylist)
+	suite.History.Record(playedAlone)
+
+	tracks, numRemoved := suite.History.FilterPlaylistTracks([]interfaces.Track{playedInPlaylist, newInPlaylist, playedAlone})
+
+	suite.Equal(1, numRemoved)
+	suite.Equal([]interfaces.Track{newInPlaylist, playedAlone}, tracks)
+}
+
+func (suite *HistoryTestSuite) TestFilterPlaylistTracksWhenDisabled() {
+	played := Track{ID: "1", Service: "YouTube", Playlist: suite.Playlist}
+	suite.History.Record(played)
+	viper.Set("queue.recently_played_window", 0)
+
+	tracks, numRemoved := suite.History.FilterPlaylistTracks([]interfaces.Track{played})
+
+	suite.Equal(0, numRemoved)
+	suite.Len(tracks, 1)
+}
+
+func (suite *HistoryTestSuite) TestSetNote() {
+	track := Track{ID: "1", Service: "YouTube"}
+	other := Track{ID: "1", Service: "SoundCloud"}
+
+	suite.Nil(suite.History.SetNote(track, "crowd favorite, play louder", "user"))
+
+	note, ok := suite.History.Note(track)
+	suite.True(ok)
+	suite.Equal("crowd favorite, play louder", note.Text)
+	suite.Equal("user", note.Author)
+	_, ok = suite.History.Note(other)
+	suite.False(ok, "Tracks from other services with the same ID should not share notes.")
+}
+
+func (suite *HistoryTestSuite) TestSetEmptyNoteRemovesNote() {
+	track := Track{ID: "1", Service: "YouTube"}
+	suite.History.SetNote(track, "play louder", "user")
+
+	suite.Nil(suite.History.SetNote(track, "", "user"))
+
+	_, ok := suite.History.Note(track)
+	suite.False(ok)
+}
+
+func (suite *HistoryTestSuite) TestNotesAreSavedAndLoaded() {
+	track := Track{ID: "1", Service: "YouTube"}
+	suite.History.SetNote(track, "play louder", "user")
+
+	history := NewHistory()
+	suite.Nil(history.LoadNotes())
+
+	note, ok := history.Note(track)
+	suite.True(ok)
+	suite.Equal("play louder", note.Text)
+}
+
+func (suite *HistoryTestSuite) TestLoadNotesWithoutFile() {
+	suite.Nil(suite.History.LoadNotes())
+
+	_, ok := suite.History.Note(Track{ID: "1", Service: "YouTube"})
+	suite.False(ok)
+}
+
+func TestHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(HistoryTestSuite))
+}
@@ -0,0 +1,96 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/hold.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/layeh/gumble/gumbleffmpeg"
+	"github.com/spf13/viper"
+)
+
+var (
+	// ErrAlreadyHeld is returned when the music is put on hold while it
+	// already is.
+	ErrAlreadyHeld = errors.New("The music is already on hold")
+	// ErrNotHeld is returned when the music is taken off hold while it is
+	// not on hold.
+	ErrNotHeld = errors.New("The music is not on hold")
+)
+
+// Hold puts the music on hold while a meeting takes place in the channel. The
+// current track is paused where it is and no new track is started until the
+// music is faded back in afterwards.
+type Hold struct {
+	active bool
+	since  time.Time
+	mutex  sync.Mutex
+}
+
+// NewHold returns a Hold that is not active.
+func NewHold() *Hold {
+	return &Hold{}
+}
+
+// Start puts the music on hold, pausing the current track if one is playing.
+// Returns ErrAlreadyHeld if the music is already on hold.
+func (h *Hold) Start() error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.active {
+		return ErrAlreadyHeld
+	}
+	h.active = true
+	h.since = time.Now()
+
+	if stream := DJ.AudioStream; stream != nil && stream.State() == gumbleffmpeg.StatePlaying {
+		DJ.Queue.PauseCurrent()
+	}
+	return nil
+}
+
+// End takes the music off hold and returns how long it was held. The paused
+// track is resumed, or the next track started, and faded in from silence to
+// the current volume over commands.unhold.fade seconds. Returns ErrNotHeld if
+// the music is not on hold.
+func (h *Hold) End() (time.Duration, error) {
+	h.mutex.Lock()
+	if !h.active {
+		h.mutex.Unlock()
+		return 0, ErrNotHeld
+	}
+	h.active = false
+	held := time.Since(h.since)
+	h.mutex.Unlock()
+
+	volume := DJ.Volume
+	DJ.Volume = 0
+	if stream := DJ.AudioStream; stream != nil && stream.State() == gumbleffmpeg.StatePaused {
+		stream.Volume = 0
+		DJ.Queue.ResumeCurrent()
+	} else if queue, ok := DJ.Queue.(*Queue); ok {
+		// Tracks added during the hold have not been started yet.
+		queue.startIfNeeded()
+	}
+
+	if DJ.AudioStream == nil {
+		DJ.Volume = volume
+		return held, nil
+	}
+	go fadeVolume(volume, time.Duration(viper.GetInt("commands.unhold.fade"))*time.Second)
+	return held, nil
+}
+
+// Active returns true if the music is on hold.
+func (h *Hold) Active() bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return h.active
+}
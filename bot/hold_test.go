@@ -0,0 +1,61 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/hold_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type HoldTestSuite struct {
+	suite.Suite
+}
+
+func (suite *HoldTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+	DJ.Volume = 0.3
+	viper.Set("commands.unhold.fade", 0)
+}
+
+func (suite *HoldTestSuite) TestStartTwice() {
+	suite.Nil(DJ.Hold.Start())
+	suite.Equal(ErrAlreadyHeld, DJ.Hold.Start())
+	suite.True(DJ.Hold.Active())
+}
+
+func (suite *HoldTestSuite) TestEndWithoutHold() {
+	_, err := DJ.Hold.End()
+
+	suite.Equal(ErrNotHeld, err)
+}
+
+func (suite *HoldTestSuite) TestNoTrackStartsWhileHeld() {
+	DJ.Hold.Start()
+
+	// Starting the track would fail, as it cannot be downloaded.
+	suite.Nil(DJ.Queue.AppendTrack(&Track{ID: "first"}))
+	suite.Nil(DJ.AudioStream, "No track should be started while the music is on hold.")
+	suite.Equal(1, DJ.Queue.Length())
+}
+
+func (suite *HoldTestSuite) TestEndRestoresVolume() {
+	DJ.Hold.Start()
+	DJ.Volume = 0.1
+
+	_, err := DJ.Hold.End()
+
+	suite.Nil(err)
+	suite.False(DJ.Hold.Active())
+	suite.Equal(float32(0.1), DJ.Volume, "A volume set during the hold should be kept.")
+}
+
+func TestHoldTestSuite(t *testing.T) {
+	suite.Run(t, new(HoldTestSuite))
+}
@@ -0,0 +1,129 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/import.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// ImportSettings reads a configuration file exported from another MumbleDJ
+// instance, either from a local path or an http(s) URL, and applies the
+// settings found under the sections listed in import.sections. Settings such
+// as connection details and API keys are never imported unless explicitly
+// listed. The imported keys are returned in alphabetical order.
+//
+// If the imported settings would result in duplicate command aliases, nothing
+// is applied and an error is returned.
+func ImportSettings(source string) ([]string, error) {
+	data, err := readImportSource(source)
+	if err != nil {
+		return nil, err
+	}
+
+	imported := viper.New()
+	imported.SetConfigType("yaml")
+	if err := imported.ReadConfig(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("The imported file is not a valid configuration file: %s", err.Error())
+	}
+
+	settings := make(map[string]interface{})
+	flattenSettings("", imported.AllSettings(), settings)
+	keys := make([]string, 0)
+	for key := range settings {
+		if isImportableKey(key) {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("The imported file contains no importable settings")
+	}
+	sort.Strings(keys)
+
+	previous := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		previous[key] = viper.Get(key)
+		viper.Set(key, settings[key])
+	}
+	if err := CheckForDuplicateAliases(); err != nil {
+		for key, value := range previous {
+			viper.Set(key, value)
+		}
+		return nil, err
+	}
+
+	if viper.GetBool("import.save_to_config") && viper.ConfigFileUsed() != "" {
+		if err := WriteConfigFile(viper.ConfigFileUsed(), "This configuration file was updated by the import command.", nil); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"file_path": viper.ConfigFileUsed(),
+				"error":     err.Error(),
+			}).Warnln("Imported settings could not be saved to the configuration file.")
+		}
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"source":       source,
+		"num_settings": len(keys),
+	}).Infoln("Imported settings.")
+	return keys, nil
+}
+
+// readImportSource returns the contents of the file or URL at `source`.
+func readImportSource(source string) ([]byte, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return ioutil.ReadFile(os.ExpandEnv(source))
+	}
+
+	response, err := http.Get(source)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("The import URL returned status %s", response.Status)
+	}
+	return ioutil.ReadAll(response.Body)
+}
+
+// flattenSettings converts nested maps read from a configuration file into
+// dot-separated keys, the reverse of nestSettings.
+func flattenSettings(prefix string, value interface{}, settings map[string]interface{}) {
+	switch nested := value.(type) {
+	case map[string]interface{}:
+		for key, child := range nested {
+			flattenSettings(prefix+strings.ToLower(key)+".", child, settings)
+		}
+	case map[interface{}]interface{}:
+		for key, child := range nested {
+			flattenSettings(prefix+strings.ToLower(fmt.Sprint(key))+".", child, settings)
+		}
+	default:
+		settings[strings.TrimSuffix(prefix, ".")] = value
+	}
+}
+
+// isImportableKey returns true if `key` falls under one of the sections listed
+// in import.sections.
+func isImportableKey(key string) bool {
+	for _, section := range viper.GetStringSlice("import.sections") {
+		section = strings.ToLower(section)
+		if key == section || strings.HasPrefix(key, section+".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/import_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type ImportTestSuite struct {
+	suite.Suite
+	Dir string
+}
+
+func (suite *ImportTestSuite) SetupTest() {
+	SetDefaultConfig()
+	suite.Dir, _ = ioutil.TempDir("", "mumbledj-import")
+}
+
+func (suite *ImportTestSuite) TearDownTest() {
+	os.RemoveAll(suite.Dir)
+	viper.Set("queue.max_track_duration", 0)
+	viper.Set("api_keys.youtube", "")
+	viper.Set("commands.skip.aliases", []string{"skip", "s"})
+}
+
+func (suite *ImportTestSuite) writeFile(contents string) string {
+	path := filepath.Join(suite.Dir, "config.yaml")
+	ioutil.WriteFile(path, []byte(contents), 0644)
+	return path
+}
+
+func (suite *ImportTestSuite) TestImportOnlyImportsListedSections() {
+	path := suite.writeFile("queue:\n    max_track_duration: 600\napi_keys:\n    youtube: \"secret\"\n")
+
+	keys, err := ImportSettings(path)
+
+	suite.Nil(err)
+	suite.Equal([]string{"queue.max_track_duration"}, keys)
+	suite.Equal(600, viper.GetInt("queue.max_track_duration"))
+	suite.Equal("", viper.GetString("api_keys.youtube"), "API keys should not be imported.")
+}
+
+func (suite *ImportTestSuite) TestImportRejectsDuplicateAliases() {
+	path := suite.writeFile("commands:\n    skip:\n        aliases:\n            - \"add\"\n")
+
+	_, err := ImportSettings(path)
+
+	suite.NotNil(err)
+	suite.Equal([]string{"skip", "s"}, viper.GetStringSlice("commands.skip.aliases"), "The previous aliases should be restored.")
+}
+
+func (suite *ImportTestSuite) TestImportWithNothingToImport() {
+	path := suite.writeFile("connection:\n    address: \"example.com\"\n")
+
+	_, err := ImportSettings(path)
+
+	suite.NotNil(err)
+}
+
+func (suite *ImportTestSuite) TestImportMissingFile() {
+	_, err := ImportSettings(filepath.Join(suite.Dir, "missing.yaml"))
+
+	suite.NotNil(err)
+}
+
+func TestImportTestSuite(t *testing.T) {
+	suite.Run(t, new(ImportTestSuite))
+}
@@ -0,0 +1,79 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/intros.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumbleffmpeg"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// IntroStream synthesizes a short shout-out to the submitter of `t`, radio DJ
+// style, and returns a stream that plays it before the track. ok is false
+// unless the submitter has opted in and a speech synthesizer is configured in
+// intros.command. The returned function removes the synthesized file once the
+// stream has finished.
+func (dj *MumbleDJ) IntroStream(t interfaces.Track) (stream *gumbleffmpeg.Stream, cleanup func(), ok bool) {
+	command := viper.GetString("intros.command")
+	if command == "" || !dj.Intros.Enabled(t.GetSubmitter()) {
+		return nil, nil, false
+	}
+
+	dir, err := ioutil.TempDir("", "mumbledj-intro")
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Warnln("Could not create a directory for the intro.")
+		return nil, nil, false
+	}
+	cleanup = func() { os.RemoveAll(dir) }
+
+	file := filepath.Join(dir, "intro.wav")
+	text := fmt.Sprintf(viper.GetString("intros.text"), t.GetSubmitter())
+	args := introCommandArgs(command, file, text)
+
+	var output bytes.Buffer
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if err := cmd.Run(); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"command": strings.Join(args, " "),
+			"output":  output.String(),
+			"error":   err.Error(),
+		}).Warnln("Could not synthesize the intro.")
+		cleanup()
+		return nil, nil, false
+	}
+
+	stream = gumbleffmpeg.New(dj.Client, gumbleffmpeg.SourceFile(file))
+	stream.Volume = dj.Volume
+	stream.Command = playerCommand()
+	return stream, cleanup, true
+}
+
+// introCommandArgs splits the configured synthesizer command into arguments
+// and substitutes the output file and the text to speak. The text is always
+// passed as a single argument.
+func introCommandArgs(command, file, text string) []string {
+	args := strings.Fields(command)
+	for i, arg := range args {
+		arg = strings.Replace(arg, "{file}", file, -1)
+		args[i] = strings.Replace(arg, "{text}", text, -1)
+	}
+	return args
+}
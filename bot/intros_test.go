@@ -0,0 +1,34 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/intros_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type IntrosTestSuite struct {
+	suite.Suite
+}
+
+func (suite *IntrosTestSuite) TestIntroCommandArgs() {
+	args := introCommandArgs("espeak -w {file} {text}", "/tmp/intro.wav", "This one goes out from test!")
+
+	suite.Equal([]string{"espeak", "-w", "/tmp/intro.wav", "This one goes out from test!"}, args)
+}
+
+func (suite *IntrosTestSuite) TestIntroCommandArgsWithinArgument() {
+	args := introCommandArgs("say --file-format=WAVE --output-file={file} {text}", "/tmp/intro.wav", "Hi")
+
+	suite.Equal([]string{"say", "--file-format=WAVE", "--output-file=/tmp/intro.wav", "Hi"}, args)
+}
+
+func TestIntrosTestSuite(t *testing.T) {
+	suite.Run(t, new(IntrosTestSuite))
+}
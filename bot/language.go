@@ -0,0 +1,155 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/language.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// Languages keeps track of the language each user has chosen for private
+// replies, along with the translations loaded from the language directory.
+// Each translation is a YAML file named after its language code (such as
+// de.yaml) that overrides message strings from the configuration file.
+type Languages struct {
+	preferences map[string]string
+	catalogs    map[string]*viper.Viper
+	mutex       sync.RWMutex
+}
+
+// NewLanguages returns a Languages with no preferences set.
+func NewLanguages() *Languages {
+	return &Languages{
+		preferences: make(map[string]string),
+		catalogs:    make(map[string]*viper.Viper),
+	}
+}
+
+// Available returns the language codes of the translations in the language
+// directory, sorted alphabetically.
+func (l *Languages) Available() []string {
+	files, _ := filepath.Glob(filepath.Join(os.ExpandEnv(viper.GetString("language.directory")), "*.yaml"))
+	codes := make([]string, 0, len(files))
+	for _, file := range files {
+		codes = append(codes, strings.TrimSuffix(filepath.Base(file), ".yaml"))
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Set sets the language of the user with the given name. An empty code resets
+// the user to the server's language.
+func (l *Languages) Set(name, code string) error {
+	if code != "" {
+		if _, err := l.catalog(code); err != nil {
+			return err
+		}
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if code == "" {
+		delete(l.preferences, name)
+	} else {
+		l.preferences[name] = code
+	}
+	return nil
+}
+
+// Get returns the language code chosen by the user with the given name, or an
+// empty string if the user has not chosen one.
+func (l *Languages) Get(name string) string {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return l.preferences[name]
+}
+
+// Preferences returns a copy of the language chosen by each user.
+func (l *Languages) Preferences() map[string]string {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	preferences := make(map[string]string, len(l.preferences))
+	for name, code := range l.preferences {
+		preferences[name] = code
+	}
+	return preferences
+}
+
+// Lookup returns the translation of the message with the given key in the
+// given language. The second return value is false if no translation exists.
+func (l *Languages) Lookup(code, key string) (string, bool) {
+	if code == "" {
+		return "", false
+	}
+	catalog, err := l.catalog(code)
+	if err != nil || !catalog.IsSet(key) {
+		return "", false
+	}
+	return catalog.GetString(key), true
+}
+
+// catalog returns the translation for the given language code, loading it from
+// the language directory the first time it is requested.
+func (l *Languages) catalog(code string) (*viper.Viper, error) {
+	l.mutex.RLock()
+	catalog, ok := l.catalogs[code]
+	l.mutex.RUnlock()
+	if ok {
+		return catalog, nil
+	}
+
+	if strings.ContainsAny(code, `/\.`) {
+		return nil, fmt.Errorf("%s is not a valid language code", code)
+	}
+	filePath := filepath.Join(os.ExpandEnv(viper.GetString("language.directory")), code+".yaml")
+	catalog = viper.New()
+	catalog.SetConfigFile(filePath)
+	if err := catalog.ReadInConfig(); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"file_path": filePath,
+			"error":     err.Error(),
+		}).Warnln("Could not load language file.")
+		return nil, fmt.Errorf("The language %s is not available", code)
+	}
+
+	l.mutex.Lock()
+	l.catalogs[code] = catalog
+	l.mutex.Unlock()
+	return catalog, nil
+}
+
+// Localize returns the message with the given configuration key in the language
+// chosen by the user. The message from the configuration file is returned if
+// the user has not chosen a language or no translation exists.
+func (dj *MumbleDJ) Localize(user *gumble.User, key string) string {
+	if user == nil {
+		return viper.GetString(key)
+	}
+	return dj.LocalizeFor(user.Name, key)
+}
+
+// LocalizeFor is like Localize but takes the name of the user.
+func (dj *MumbleDJ) LocalizeFor(name, key string) string {
+	if code := dj.Languages.Get(name); code != "" {
+		if message, ok := dj.Languages.Lookup(code, key); ok {
+			return message
+		}
+	}
+	return viper.GetString(key)
+}
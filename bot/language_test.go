@@ -0,0 +1,79 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/language_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type LanguageTestSuite struct {
+	suite.Suite
+	Directory string
+}
+
+func (suite *LanguageTestSuite) SetupSuite() {
+	DJ = NewMumbleDJ()
+}
+
+func (suite *LanguageTestSuite) SetupTest() {
+	DJ.Languages = NewLanguages()
+	suite.Directory, _ = ioutil.TempDir("", "mumbledj")
+	viper.Set("language.directory", suite.Directory)
+	viper.Set("commands.common_messages.no_tracks_error", "There are no tracks in the queue.")
+
+	translation := "commands:\n    common_messages:\n        no_tracks_error: \"Die Warteschlange ist leer.\"\n"
+	ioutil.WriteFile(filepath.Join(suite.Directory, "de.yaml"), []byte(translation), 0644)
+}
+
+func (suite *LanguageTestSuite) TearDownTest() {
+	os.RemoveAll(suite.Directory)
+}
+
+func (suite *LanguageTestSuite) TestAvailable() {
+	suite.Equal([]string{"de"}, DJ.Languages.Available())
+}
+
+func (suite *LanguageTestSuite) TestLocalizeWithoutPreference() {
+	user := &gumble.User{Name: "test"}
+
+	suite.Equal("There are no tracks in the queue.", DJ.Localize(user, "commands.common_messages.no_tracks_error"))
+}
+
+func (suite *LanguageTestSuite) TestLocalizeWithPreference() {
+	user := &gumble.User{Name: "test"}
+	err := DJ.Languages.Set("test", "de")
+
+	suite.Nil(err, "No error should be returned.")
+	suite.Equal("Die Warteschlange ist leer.", DJ.Localize(user, "commands.common_messages.no_tracks_error"))
+	suite.Equal("There are no tracks in the queue.", DJ.Localize(nil, "commands.common_messages.no_tracks_error"),
+		"Messages without a user should use the server's language.")
+}
+
+func (suite *LanguageTestSuite) TestLocalizeFallsBackForMissingTranslation() {
+	viper.Set("commands.add.messages.no_url_error", "A URL must be supplied with the add command.")
+	DJ.Languages.Set("test", "de")
+
+	suite.Equal("A URL must be supplied with the add command.", DJ.LocalizeFor("test", "commands.add.messages.no_url_error"))
+}
+
+func (suite *LanguageTestSuite) TestSetUnavailableLanguage() {
+	suite.NotNil(DJ.Languages.Set("test", "fr"), "An error should be returned for a missing translation.")
+	suite.NotNil(DJ.Languages.Set("test", "../de"), "An error should be returned for an invalid language code.")
+	suite.Equal("", DJ.Languages.Get("test"))
+}
+
+func TestLanguageTestSuite(t *testing.T) {
+	suite.Run(t, new(LanguageTestSuite))
+}
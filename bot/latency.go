@@ -0,0 +1,56 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/latency.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// LatencyProfile tunes how much audio is buffered between the source of a
+// track and the listeners. Less buffering makes playback respond sooner, for
+// example when the music is paused to talk over it, but copes worse with slow
+// networks and streams.
+type LatencyProfile struct {
+	// AudioInterval is the amount of audio sent to the server in each packet.
+	AudioInterval time.Duration
+	// InputArgs are passed to the player command before the input of live
+	// streams and of the monitor output.
+	InputArgs []string
+	// BufferSize is the download buffer of youtube-dl while it relays live
+	// streams. youtube-dl picks it if empty.
+	BufferSize string
+}
+
+// latencyProfiles are the profiles that output.latency may be set to.
+var latencyProfiles = map[string]LatencyProfile{
+	"low": {
+		AudioInterval: 10 * time.Millisecond,
+		InputArgs:     []string{"-fflags", "nobuffer", "-probesize", "32768", "-analyzeduration", "0"},
+		BufferSize:    "16K",
+	},
+	"normal": {
+		AudioInterval: gumble.AudioDefaultInterval,
+	},
+	"high": {
+		AudioInterval: 40 * time.Millisecond,
+		InputArgs:     []string{"-probesize", "10000000", "-analyzeduration", "10000000"},
+		BufferSize:    "64K",
+	},
+}
+
+// CurrentLatencyProfile returns the profile set in output.latency, or the
+// normal profile if it is not valid.
+func CurrentLatencyProfile() LatencyProfile {
+	if profile, ok := latencyProfiles[viper.GetString("output.latency")]; ok {
+		return profile
+	}
+	return latencyProfiles["normal"]
+}
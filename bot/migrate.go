@@ -0,0 +1,239 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/migrate.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Sirupsen/logrus"
+)
+
+// LegacyConfigPath is the location of the gcfg configuration file used by
+// MumbleDJ v2.
+var LegacyConfigPath = "$HOME/.mumbledj/config/mumbledj.gcfg"
+
+// legacyConfigKeys maps settings found in legacy gcfg configuration files to
+// their equivalents in the current configuration format. Legacy keys are
+// stored as lowercase "section.name" pairs.
+var legacyConfigKeys = map[string]string{
+	"general.commandprefix":          "commands.prefix",
+	"general.skipratio":              "queue.track_skip_ratio",
+	"general.playlistskipratio":      "queue.playlist_skip_ratio",
+	"general.defaultcomment":         "defaults.comment",
+	"general.defaultmaxsongduration": "queue.max_track_duration",
+	"general.maxsongperplaylist":     "queue.max_tracks_per_playlist",
+	"general.automaticshuffleon":     "queue.automatic_shuffle_on",
+	"general.announcenewtrack":       "queue.announce_new_tracks",
+	"general.playercommand":          "defaults.player_command",
+	"connection.retryenabled":        "connection.retry_enabled",
+	"connection.retryattempts":       "connection.retry_attempts",
+	"connection.retryinterval":       "connection.retry_interval",
+	"cache.enabled":                  "cache.enabled",
+	"cache.maximumsize":              "cache.maximum_size",
+	"cache.expiretime":               "cache.expire_time",
+	"volume.defaultvolume":           "volume.default",
+	"volume.lowestvolume":            "volume.lowest",
+	"volume.highestvolume":           "volume.highest",
+	"permissions.adminsenabled":      "admins.enabled",
+	"permissions.admins":             "admins.names",
+	"aliases.addsongalias":           "commands.add.aliases",
+	"aliases.addnextsongalias":       "commands.addnext.aliases",
+	"aliases.skipsongalias":          "commands.skip.aliases",
+	"aliases.skipplaylistalias":      "commands.skipplaylist.aliases",
+	"aliases.adminskipalias":         "commands.forceskip.aliases",
+	"aliases.adminskipplaylistalias": "commands.forceskipplaylist.aliases",
+	"aliases.helpalias":              "commands.help.aliases",
+	"aliases.volumealias":            "commands.volume.aliases",
+	"aliases.movealias":              "commands.move.aliases",
+	"aliases.reloadalias":            "commands.reload.aliases",
+	"aliases.resetalias":             "commands.reset.aliases",
+	"aliases.numsongsalias":          "commands.numtracks.aliases",
+	"aliases.nextsongalias":          "commands.nexttrack.aliases",
+	"aliases.currentsongalias":       "commands.currenttrack.aliases",
+	"aliases.setcommentalias":        "commands.setcomment.aliases",
+	"aliases.numcachedalias":         "commands.numcached.aliases",
+	"aliases.cachesizealias":         "commands.cachesize.aliases",
+	"aliases.killalias":              "commands.kill.aliases",
+	"aliases.shufflealias":           "commands.shuffle.aliases",
+	"aliases.shuffleonalias":         "commands.toggleshuffle.aliases",
+	"aliases.listsongsalias":         "commands.listtracks.aliases",
+	"aliases.versionalias":           "commands.version.aliases",
+	"permissions.adminadd":           "commands.add.is_admin",
+	"permissions.adminaddnext":       "commands.addnext.is_admin",
+	"permissions.adminskip":          "commands.forceskip.is_admin",
+	"permissions.adminhelp":          "commands.help.is_admin",
+	"permissions.adminvolume":        "commands.volume.is_admin",
+	"permissions.adminmove":          "commands.move.is_admin",
+	"permissions.adminreload":        "commands.reload.is_admin",
+	"permissions.adminreset":         "commands.reset.is_admin",
+	"permissions.adminnumsongs":      "commands.numtracks.is_admin",
+	"permissions.adminnextsong":      "commands.nexttrack.is_admin",
+	"permissions.admincurrentsong":   "commands.currenttrack.is_admin",
+	"permissions.adminsetcomment":    "commands.setcomment.is_admin",
+	"permissions.adminnumcached":     "commands.numcached.is_admin",
+	"permissions.admincachesize":     "commands.cachesize.is_admin",
+	"permissions.adminkill":          "commands.kill.is_admin",
+	"permissions.adminshuffle":       "commands.shuffle.is_admin",
+	"permissions.adminshuffletoggle": "commands.toggleshuffle.is_admin",
+	"permissions.adminlistsongs":     "commands.listtracks.is_admin",
+	"permissions.adminversion":       "commands.version.is_admin",
+}
+
+var (
+	legacySectionRegex = regexp.MustCompile(`^\[\s*([\w-]+)\s*(?:"[^"]*")?\s*\]$`)
+	legacyValueRegex   = regexp.MustCompile(`^([\w-]+)\s*=\s*(.*)$`)
+)
+
+// MigrateConfig checks whether the configuration file located at `path` is a
+// legacy gcfg configuration file. If `path` does not exist, the legacy v2
+// configuration location is checked instead. If a legacy file is found, its
+// settings are converted into the current format and written to `path`. When
+// the file at `path` is migrated in place, the original is kept as a backup
+// with a ".bak" extension. Returns true if a migration took place.
+func MigrateConfig(path string) (bool, error) {
+	sourcePath := path
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		sourcePath = os.ExpandEnv(LegacyConfigPath)
+		if data, err = ioutil.ReadFile(sourcePath); os.IsNotExist(err) {
+			return false, nil
+		}
+	}
+	if err != nil {
+		return false, err
+	}
+	if !isLegacyConfig(data) {
+		return false, nil
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"file_path": sourcePath,
+	}).Warnln("A legacy configuration file was found. Migrating it to the current format...")
+
+	legacyValues, err := parseLegacyConfig(data)
+	if err != nil {
+		return false, err
+	}
+
+	settings := make(map[string]interface{})
+	for legacyKey, values := range legacyValues {
+		key, ok := legacyConfigKeys[legacyKey]
+		if !ok {
+			logrus.WithFields(logrus.Fields{
+				"setting": legacyKey,
+			}).Warnln("A legacy setting has no equivalent in the current configuration format and will be dropped.")
+			continue
+		}
+		settings[key] = convertLegacyValue(key, values)
+	}
+
+	if sourcePath == path {
+		backupPath := path + ".bak"
+		if err := ioutil.WriteFile(backupPath, data, 0644); err != nil {
+			return false, err
+		}
+		logrus.WithFields(logrus.Fields{
+			"file_path": backupPath,
+		}).Infoln("A backup of the legacy configuration file has been written.")
+	}
+
+	header := fmt.Sprintf("This configuration file was migrated automatically from %s.", sourcePath)
+	if err := WriteConfigFile(path, header, settings); err != nil {
+		return false, err
+	}
+	logrus.WithFields(logrus.Fields{
+		"file_path": path,
+	}).Infoln("The configuration file has been migrated.")
+	return true, nil
+}
+
+// isLegacyConfig returns true if the provided configuration data appears to be
+// in the gcfg format, which consists of bracketed section headers followed by
+// "name = value" pairs.
+func isLegacyConfig(data []byte) bool {
+	hasSection, hasValue := false, false
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if legacySectionRegex.MatchString(line) {
+			hasSection = true
+		} else if hasSection && legacyValueRegex.MatchString(line) {
+			hasValue = true
+		}
+	}
+	return hasSection && hasValue
+}
+
+// parseLegacyConfig parses gcfg configuration data into a map of lowercase
+// "section.name" keys. Names may appear more than once within a section, so
+// every value is kept.
+func parseLegacyConfig(data []byte) (map[string][]string, error) {
+	values := make(map[string][]string)
+	section := ""
+	lineNumber := 0
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if match := legacySectionRegex.FindStringSubmatch(line); match != nil {
+			section = strings.ToLower(match[1])
+			continue
+		}
+		match := legacyValueRegex.FindStringSubmatch(line)
+		if match == nil || section == "" {
+			return nil, fmt.Errorf("Invalid line in legacy configuration file at line %d", lineNumber)
+		}
+		value := strings.TrimSpace(match[2])
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		key := section + "." + strings.ToLower(match[1])
+		values[key] = append(values[key], value)
+	}
+
+	return values, scanner.Err()
+}
+
+// convertLegacyValue converts the raw string values of a legacy setting to the
+// type expected by the current configuration key.
+func convertLegacyValue(key string, values []string) interface{} {
+	if strings.HasSuffix(key, "aliases") || key == "admins.names" {
+		var list []string
+		for _, value := range values {
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					list = append(list, item)
+				}
+			}
+		}
+		return list
+	}
+
+	value := values[len(values)-1]
+	if b, err := strconv.ParseBool(value); err == nil {
+		return b
+	}
+	if i, err := strconv.Atoi(value); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f
+	}
+	return value
+}
@@ -0,0 +1,105 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/migrate_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+const legacyConfig = `# MumbleDJ v2 configuration
+[General]
+CommandPrefix = "#"
+SkipRatio = 0.75
+AutomaticShuffleOn = true
+
+[Aliases]
+AddSongAlias = "add,a"
+
+[Permissions]
+AdminsEnabled = true
+Admins = "Matt"
+Admins = "SuperUser"
+`
+
+type MigrateTestSuite struct {
+	suite.Suite
+	Directory string
+}
+
+func (suite *MigrateTestSuite) SetupTest() {
+	viper.Reset()
+	SetDefaultConfig()
+	suite.Directory, _ = ioutil.TempDir("", "mumbledj")
+}
+
+func (suite *MigrateTestSuite) TearDownTest() {
+	os.RemoveAll(suite.Directory)
+	viper.Reset()
+	SetDefaultConfig()
+}
+
+func (suite *MigrateTestSuite) TestIsLegacyConfig() {
+	suite.True(isLegacyConfig([]byte(legacyConfig)), "A gcfg file should be detected as a legacy config.")
+	suite.False(isLegacyConfig([]byte("commands:\n    prefix: \"!\"\n")), "A YAML file should not be detected as a legacy config.")
+}
+
+func (suite *MigrateTestSuite) TestParseLegacyConfig() {
+	values, err := parseLegacyConfig([]byte(legacyConfig))
+
+	suite.Nil(err, "No error should be returned for a valid legacy config.")
+	suite.Equal([]string{"#"}, values["general.commandprefix"])
+	suite.Equal([]string{"Matt", "SuperUser"}, values["permissions.admins"])
+}
+
+func (suite *MigrateTestSuite) TestParseLegacyConfigWithInvalidLine() {
+	_, err := parseLegacyConfig([]byte("[General]\nthis is not valid\n"))
+
+	suite.NotNil(err, "An error should be returned for an invalid legacy config.")
+}
+
+func (suite *MigrateTestSuite) TestMigrateConfigWhenNotLegacy() {
+	path := filepath.Join(suite.Directory, "config.yaml")
+	ioutil.WriteFile(path, []byte("commands:\n    prefix: \"!\"\n"), 0644)
+
+	migrated, err := MigrateConfig(path)
+
+	suite.False(migrated, "A current config file should not be migrated.")
+	suite.Nil(err, "No error should be returned.")
+}
+
+func (suite *MigrateTestSuite) TestMigrateConfigWhenLegacy() {
+	path := filepath.Join(suite.Directory, "config.yaml")
+	ioutil.WriteFile(path, []byte(legacyConfig), 0644)
+
+	migrated, err := MigrateConfig(path)
+
+	suite.True(migrated, "The legacy config file should be migrated.")
+	suite.Nil(err, "No error should be returned.")
+
+	backup, _ := ioutil.ReadFile(path + ".bak")
+	suite.Equal(legacyConfig, string(backup), "A backup of the legacy config file should be written.")
+
+	viper.SetConfigFile(path)
+	suite.Nil(viper.ReadInConfig(), "The migrated config file should be valid.")
+	suite.Equal("#", viper.GetString("commands.prefix"))
+	suite.Equal(0.75, viper.GetFloat64("queue.track_skip_ratio"))
+	suite.True(viper.GetBool("queue.automatic_shuffle_on"))
+	suite.Equal([]string{"add", "a"}, viper.GetStringSlice("commands.add.aliases"))
+	suite.Equal([]string{"Matt", "SuperUser"}, viper.GetStringSlice("admins.names"))
+	suite.Equal(0.2, viper.GetFloat64("volume.default"), "Settings missing from the legacy config should use defaults.")
+}
+
+func TestMigrateTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrateTestSuite))
+}
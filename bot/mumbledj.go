@@ -11,12 +11,14 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"html"
 	"io/ioutil"
 	"net"
 	"os"
 	"os/exec"
 	"strings"
-	"time"
+	"sync/atomic"
+	"unicode"
 
 	"github.com/Sirupsen/logrus"
 	"github.com/layeh/gumble/gumble"
@@ -26,21 +28,61 @@ import (
 	"github.com/spf13/viper"
 )
 
+// ErrUnsupportedURL is returned when a URL does not match any service.
+var ErrUnsupportedURL = errors.New("The provided URL does not match an enabled service")
+
 // MumbleDJ is a struct that keeps track of all aspects of the bot's state.
 type MumbleDJ struct {
 	AvailableServices []interfaces.Service
+	DisabledServices  map[interfaces.Service]error
 	Client            *gumble.Client
 	GumbleConfig      *gumble.Config
 	TLSConfig         *tls.Config
 	AudioStream       *gumbleffmpeg.Stream
+	Output            interfaces.AudioOutput
+	Monitor           *MonitorOutput
+	Radio             *Radio
+	Quota             *Quota
+	Guests            *Guests
+	Party             *Party
+	Hold              *Hold
+	Reconnect         *Reconnect
+	Failover          *Failover
+	Departures        *Departures
+	Bans              *Bans
+	EmptyQueue        *EmptyQueue
 	Queue             interfaces.Queue
+	Queues            *Queues
 	Cache             *Cache
 	Skips             interfaces.SkipTracker
+	ShuffleVotes      *ShuffleVotes
 	Commands          []interfaces.Command
 	Version           string
+	Commit            string
+	Updates           *Updates
 	Volume            float32
 	YouTubeDL         *YouTubeDL
+	Watchdog          *Watchdog
+	AutoStop          *AutoStop
+	VolumeSchedule    *VolumeSchedule
+	Draft             *Draft
+	Languages         *Languages
+	Notifications     *UserToggle
+	PrivateAnnounce   *UserToggle
+	Intros            *UserToggle
+	ShuffleAdds       *UserToggle
+	Continuations     *Continuations
+	Breaks            *Breaks
+	VoteWindow        *VoteWindow
+	Thumbnails        *Thumbnails
+	SponsorBlock      *SponsorBlock
+	Premieres         *Premieres
+	Accounts          *Accounts
+	Failures          *Failures
+	History           *History
+	Notifiers         map[string]interfaces.Notifier
 	KeepAlive         chan bool
+	shuttingDown      int32
 }
 
 // DJ is a struct that keeps track of all aspects of MumbleDJ's environment.
@@ -52,12 +94,47 @@ func NewMumbleDJ() *MumbleDJ {
 
 	return &MumbleDJ{
 		AvailableServices: make([]interfaces.Service, 0),
+		DisabledServices:  make(map[interfaces.Service]error),
 		TLSConfig:         new(tls.Config),
+		Output:            new(MumbleOutput),
+		Monitor:           NewMonitorOutput(),
+		Radio:             NewRadio(),
+		Quota:             NewQuota(),
+		Guests:            NewGuests(),
+		Party:             NewParty(),
+		Hold:              NewHold(),
+		Reconnect:         NewReconnect(),
+		Failover:          NewFailover(),
+		Departures:        NewDepartures(),
+		Bans:              NewBans(),
+		EmptyQueue:        NewEmptyQueue(),
+		Updates:           NewUpdates(),
 		Queue:             NewQueue(),
+		Queues:            NewQueues(),
 		Cache:             NewCache(),
 		Skips:             NewSkipTracker(),
+		ShuffleVotes:      NewShuffleVotes(),
 		Commands:          make([]interfaces.Command, 0),
 		YouTubeDL:         new(YouTubeDL),
+		Watchdog:          NewWatchdog(),
+		AutoStop:          NewAutoStop(),
+		VolumeSchedule:    NewVolumeSchedule(),
+		Draft:             NewDraft(),
+		Languages:         NewLanguages(),
+		Notifications:     NewUserToggle("queue.notify_submitters"),
+		PrivateAnnounce:   NewUserToggle("queue.announce_privately"),
+		Intros:            NewUserToggle("intros.default"),
+		ShuffleAdds:       NewUserToggle("queue.shuffle_added_playlists"),
+		Continuations:     NewContinuations(),
+		Breaks:            NewBreaks(),
+		VoteWindow:        NewVoteWindow(),
+		Thumbnails:        NewThumbnails(),
+		SponsorBlock:      NewSponsorBlock(),
+		Premieres:         NewPremieres(),
+		Accounts:          NewAccounts(),
+		Failures:          NewFailures(),
+		History:           NewHistory(),
+		Notifiers:         NewNotifiers(),
 		KeepAlive:         make(chan bool),
 	}
 }
@@ -66,6 +143,8 @@ func NewMumbleDJ() *MumbleDJ {
 // The configuration is loaded and the audio stream is initialized.
 func (dj *MumbleDJ) OnConnect(e *gumble.ConnectEvent) {
 	dj.AudioStream = nil
+	// Voice targets do not survive a reconnection.
+	dj.Party.End()
 	logrus.WithFields(logrus.Fields{
 		"volume": fmt.Sprintf("%.2f", viper.GetFloat64("volume.default")),
 	}).Infoln("Setting default volume...")
@@ -78,54 +157,117 @@ func (dj *MumbleDJ) OnConnect(e *gumble.ConnectEvent) {
 	} else {
 		logrus.Infoln("Caching disabled.")
 	}
+
+	if viper.GetInt("queue.watchdog_timeout") > 0 {
+		dj.Watchdog.Start()
+	}
+
+	if _, scheduled := dj.AutoStop.Next(); !scheduled {
+		if err := dj.AutoStop.ScheduleFromConfig(); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"time":  viper.GetString("autostop.time"),
+				"error": err.Error(),
+			}).Warnln("An invalid stop time is configured. Playback will not be stopped automatically.")
+		}
+	}
+
+	if err := dj.VolumeSchedule.LoadFromConfig(); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Warnln("An invalid volume schedule is configured. Some scheduled volumes will not be applied.")
+	}
+	dj.VolumeSchedule.ApplyLatest()
+
+	if err := dj.History.LoadNotes(); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"file_path": viper.GetString("notes.file"),
+			"error":     err.Error(),
+		}).Warnln("Could not load the notes attached to songs.")
+	}
+
+	if err := dj.Accounts.Load(); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"file_path": viper.GetString("accounts.file"),
+			"error":     err.Error(),
+		}).Warnln("Could not load the linked accounts.")
+	}
+
+	if viper.GetBool("bans.enabled") {
+		dj.Bans.Refresh()
+		go dj.Bans.RefreshPeriodically()
+	}
+
+	dj.EmptyQueue.LeaveIfIdle()
+	dj.Reconnect.Reconnected()
+	go dj.Updates.Check()
 }
 
 // OnDisconnect event. Terminates MumbleDJ process or retries connection if
 // automatic connection retries are enabled.
 func (dj *MumbleDJ) OnDisconnect(e *gumble.DisconnectEvent) {
-	dj.Queue.Reset()
+	// Leaving a fallback server to return to the primary server is not a lost
+	// connection.
+	if e.Type == gumble.DisconnectUser && dj.Failover.Switching() {
+		return
+	}
+	// The process exits on its own after a shutdown.
+	if e.Type == gumble.DisconnectUser && dj.ShuttingDown() {
+		return
+	}
 	if viper.GetBool("connection.retry_enabled") &&
 		(e.Type == gumble.DisconnectError || e.Type == gumble.DisconnectKicked) {
+		if viper.GetBool("connection.keep_queue") {
+			dj.Reconnect.Disconnected()
+		} else {
+			dj.Queue.Reset()
+		}
 		logrus.WithFields(logrus.Fields{
 			"interval_secs": fmt.Sprintf("%d", viper.GetInt("connection.retry_interval")),
 			"attempts":      fmt.Sprintf("%d", viper.GetInt("connection.retry_attempts")),
 		}).Warnln("Disconnected from server. Retrying connection...")
 
-		success := false
-		for retries := 0; retries < viper.GetInt("connection.retry_attempts"); retries++ {
-			logrus.Infoln("Retrying connection...")
-			if client, err := gumble.DialWithDialer(new(net.Dialer), viper.GetString("connection.address")+":"+viper.GetString("connection.port"), dj.GumbleConfig, dj.TLSConfig); err == nil {
-				dj.Client = client
-				logrus.Infoln("Successfully reconnected to the server!")
-				success = true
-				break
-			}
-			time.Sleep(time.Duration(viper.GetInt("connection.retry_interval")) * time.Second)
-		}
-		if !success {
-			dj.KeepAlive <- true
-			logrus.Fatalln("Could not reconnect to server. Exiting...")
-		}
+		dj.reconnectOrExit()
 	} else {
+		dj.Queue.Reset()
 		dj.KeepAlive <- true
 		logrus.Fatalln("Disconnected from server. No reconnect attempts will be made.")
 	}
 }
 
+// Shutdown disconnects from the server on purpose before the bot exits, so
+// that the disconnection is not taken for a lost connection.
+func (dj *MumbleDJ) Shutdown() error {
+	atomic.StoreInt32(&dj.shuttingDown, 1)
+	return dj.Client.Disconnect()
+}
+
+// ShuttingDown returns true if the bot is disconnecting to shut down.
+func (dj *MumbleDJ) ShuttingDown() bool {
+	return atomic.LoadInt32(&dj.shuttingDown) == 1
+}
+
+// reconnectOrExit reconnects to the server, moving on to the fallback servers
+// if needed, and terminates MumbleDJ if none can be reached.
+func (dj *MumbleDJ) reconnectOrExit() {
+	if err := dj.reconnect(); err != nil {
+		dj.KeepAlive <- true
+		logrus.Fatalln("Could not reconnect to server. Exiting...")
+	}
+}
+
 // OnTextMessage event. Checks for command prefix and passes it to the Commander
 // if it exists. Ignores the incoming message otherwise.
 func (dj *MumbleDJ) OnTextMessage(e *gumble.TextMessageEvent) {
 	plainMessage := gumbleutil.PlainText(&e.TextMessage)
 	if len(plainMessage) != 0 {
-		if plainMessage[0] == viper.GetString("commands.prefix")[0] &&
-			plainMessage != viper.GetString("commands.prefix") {
+		if command, ok := stripCommandPrefix(plainMessage); ok {
 			go func() {
-				message, isPrivateMessage, err := dj.FindAndExecuteCommand(e.Sender, plainMessage[1:])
+				message, isPrivateMessage, err := dj.FindAndExecuteCommand(e.Sender, command)
 				if err != nil {
-					logrus.WithFields(logrus.Fields{
-						"user":    e.Sender.Name,
-						"message": err.Error(),
-					}).Warnln("Sending an error message...")
+					fields := ErrorFields(err)
+					fields["user"] = e.Sender.Name
+					logrus.WithFields(fields).Warnln("Sending an error message...")
+					dj.Failures.Record(e.Sender.Name, command, err.Error())
 					dj.SendPrivateMessage(e.Sender, fmt.Sprintf("<b>Error:</b> %s", err.Error()))
 				} else {
 					if isPrivateMessage {
@@ -147,6 +289,25 @@ func (dj *MumbleDJ) OnTextMessage(e *gumble.TextMessageEvent) {
 	}
 }
 
+// stripCommandPrefix returns `message` without the prefix that marks it as a
+// command. Besides the first character of commands.prefix, any of
+// commands.alternate_prefixes such as "/dj " is accepted, ignoring case, so
+// that users coming from other bots can keep their habits. ok is false if the
+// message is not a command.
+func stripCommandPrefix(message string) (command string, ok bool) {
+	if prefix := viper.GetString("commands.prefix"); prefix != "" && message[0] == prefix[0] {
+		command = message[1:]
+	} else {
+		for _, alternate := range viper.GetStringSlice("commands.alternate_prefixes") {
+			if alternate != "" && len(message) >= len(alternate) && strings.EqualFold(message[:len(alternate)], alternate) {
+				command = strings.TrimLeft(message[len(alternate):], " ")
+				break
+			}
+		}
+	}
+	return command, command != ""
+}
+
 // OnUserChange event. Checks UserChange type and adjusts skip trackers to
 // reflect the current status of the users on the server.
 func (dj *MumbleDJ) OnUserChange(e *gumble.UserChangeEvent) {
@@ -157,6 +318,26 @@ func (dj *MumbleDJ) OnUserChange(e *gumble.UserChangeEvent) {
 		dj.Skips.RemoveTrackSkip(e.User)
 		dj.Skips.RemovePlaylistSkip(e.User)
 	}
+	if e.Type.Has(gumble.UserChangeBanned) {
+		dj.Bans.Refresh()
+	}
+	if dj.Client != nil && e.User == dj.Client.Self {
+		return
+	}
+	if e.Type.Has(gumble.UserChangeDisconnected) {
+		dj.Departures.Left(e.User.Name)
+	} else if e.Type.Has(gumble.UserChangeConnected) || e.Type.Has(gumble.UserChangeChannel) {
+		if isListening(e.User.Name) {
+			dj.Departures.Returned(e.User.Name)
+		} else if e.Type.Has(gumble.UserChangeChannel) {
+			dj.Departures.Left(e.User.Name)
+		}
+	}
+}
+
+// OnBanList event. Keeps the copy of the server's ban list up to date.
+func (dj *MumbleDJ) OnBanList(e *gumble.BanListEvent) {
+	dj.Bans.Update(e.BanList)
 }
 
 // SendPrivateMessage sends a private message to the specified user. This method
@@ -170,6 +351,12 @@ func (dj *MumbleDJ) SendPrivateMessage(user *gumble.User, message string) {
 	})
 }
 
+// SendPrivateMessageToName sends a private message to the user with the
+// provided name if they are present in the bot's channel.
+func (dj *MumbleDJ) SendPrivateMessageToName(name, message string) {
+	dj.SendPrivateMessage(&gumble.User{Name: name}, message)
+}
+
 // IsAdmin checks whether a particular Mumble user is a MumbleDJ admin.
 // Returns true if the user is an admin, and false otherwise.
 func (dj *MumbleDJ) IsAdmin(user *gumble.User) bool {
@@ -192,6 +379,23 @@ func (dj *MumbleDJ) Connect() error {
 	dj.GumbleConfig.Username = viper.GetString("connection.username")
 	dj.GumbleConfig.Password = viper.GetString("connection.password")
 	dj.GumbleConfig.Tokens = strings.Split(viper.GetString("connection.access_tokens"), ",")
+	dj.GumbleConfig.AudioInterval = CurrentLatencyProfile().AudioInterval
+
+	// The development server takes the place of the configured servers.
+	if viper.GetBool("dev.local_server") {
+		server, err := StartDevServer(os.Stdin, os.Stdout)
+		if err != nil {
+			return err
+		}
+		address, port, _ := net.SplitHostPort(server.Address())
+		viper.Set("connection.address", address)
+		viper.Set("connection.port", port)
+		viper.Set("connection.fallback_servers", []string{})
+		viper.Set("connection.insecure", true)
+		logrus.WithFields(logrus.Fields{
+			"user": DevUsername,
+		}).Warnln("Connecting to the development server. Type messages to send them as the user...")
+	}
 
 	// Initialize key pair if needed.
 	if viper.GetBool("connection.insecure") {
@@ -249,20 +453,34 @@ func (dj *MumbleDJ) Connect() error {
 		Disconnect:  dj.OnDisconnect,
 		TextMessage: dj.OnTextMessage,
 		UserChange:  dj.OnUserChange,
+		BanList:     dj.OnBanList,
 	})
 	dj.GumbleConfig.Attach(gumbleutil.AutoBitrate)
 
 	var connErr error
 
-	logrus.WithFields(logrus.Fields{
-		"address": viper.GetString("connection.address"),
-		"port":    viper.GetString("connection.port"),
-	}).Infoln("Attempting connection to server...")
-	if dj.Client, connErr = gumble.DialWithDialer(new(net.Dialer), viper.GetString("connection.address")+":"+viper.GetString("connection.port"), dj.GumbleConfig, dj.TLSConfig); connErr != nil {
-		return connErr
+	// If the primary server cannot be reached, the fallback servers are tried
+	// in turn.
+	servers := len(dj.Failover.Servers())
+	for tried := 0; tried < servers; tried++ {
+		server := dj.Failover.Current()
+		logrus.WithFields(logrus.Fields{
+			"server": server,
+		}).Infoln("Attempting connection to server...")
+		if dj.Client, connErr = dj.dial(server); connErr == nil {
+			dj.Failover.Connected()
+			return nil
+		}
+		if servers > 1 {
+			logrus.WithFields(logrus.Fields{
+				"server": server,
+				"error":  connErr.Error(),
+			}).Warnln("Could not connect to the server. Trying the next server...")
+			dj.Failover.Next()
+		}
 	}
 
-	return nil
+	return connErr
 }
 
 // FindAndExecuteCommand attempts to find a reference to a command in an
@@ -278,22 +496,127 @@ func (dj *MumbleDJ) FindAndExecuteCommand(user *gumble.User, message string) (st
 
 // GetService loops through the available services and determines if a URL
 // matches a particular service. If a match is found, the service object is
-// returned.
+// returned. If the URL belongs to a service that was disabled during startup,
+// the returned error explains why the service is unavailable.
 func (dj *MumbleDJ) GetService(url string) (interfaces.Service, error) {
 	for _, service := range dj.AvailableServices {
 		if service.CheckURL(url) {
 			return service, nil
 		}
 	}
-	return nil, errors.New("The provided URL does not match an enabled service")
+	for service, reason := range dj.DisabledServices {
+		if service.CheckURL(url) {
+			return nil, fmt.Errorf("The %s service is disabled: %s", service.GetReadableName(), reason.Error())
+		}
+	}
+	return nil, ErrUnsupportedURL
+}
+
+// ProbeService returns the enabled service that recognizes `url` by connecting
+// to it on behalf of `user`, for links that no service recognizes by their
+// form. ok is false if no service does.
+func (dj *MumbleDJ) ProbeService(url string, user *gumble.User) (interfaces.Service, bool) {
+	for _, service := range dj.AvailableServices {
+		if probingService, ok := service.(interfaces.ProbingService); ok && probingService.ProbeURL(url, user) {
+			return service, true
+		}
+	}
+	return nil, false
+}
+
+// ServiceNames returns the readable names of the enabled services, in the
+// order in which URLs are matched against them.
+func (dj *MumbleDJ) ServiceNames() []string {
+	var names []string
+	for _, service := range dj.AvailableServices {
+		names = append(names, service.GetReadableName())
+	}
+	return names
+}
+
+// GetSearchService returns the enabled service with the given readable name if
+// it supports searching, and otherwise the first enabled service that does.
+func (dj *MumbleDJ) GetSearchService(preferred string) (interfaces.SearchService, error) {
+	var fallback interfaces.SearchService
+	for _, service := range dj.AvailableServices {
+		if searchService, ok := service.(interfaces.SearchService); ok {
+			if service.GetReadableName() == preferred {
+				return searchService, nil
+			}
+			if fallback == nil {
+				fallback = searchService
+			}
+		}
+	}
+	if fallback == nil {
+		return nil, errors.New("No enabled service supports searching")
+	}
+	return fallback, nil
+}
+
+// GetChannelService returns the enabled service that can list the uploads of
+// the channel at `url`.
+func (dj *MumbleDJ) GetChannelService(url string) (interfaces.ChannelService, error) {
+	for _, service := range dj.AvailableServices {
+		if channelService, ok := service.(interfaces.ChannelService); ok && channelService.CheckChannelURL(url) {
+			return channelService, nil
+		}
+	}
+	return nil, errors.New("The provided URL is not a channel of an enabled service")
+}
+
+// GetLikedService returns the enabled service that can list the tracks users
+// have liked on their linked accounts.
+func (dj *MumbleDJ) GetLikedService() (interfaces.LikedService, error) {
+	for _, service := range dj.AvailableServices {
+		if likedService, ok := service.(interfaces.LikedService); ok {
+			return likedService, nil
+		}
+	}
+	return nil, errors.New("No enabled service can list liked tracks")
+}
+
+// GetSearch parses a search such as "subsonic:search terms", which names an
+// enabled service that supports searching followed by the search terms. The
+// service and the search terms are returned, and ok is false if `arg` is not a
+// search.
+func (dj *MumbleDJ) GetSearch(arg string) (service interfaces.SearchService, query string, ok bool) {
+	i := strings.Index(arg, ":")
+	if i <= 0 || strings.HasPrefix(arg[i:], "://") {
+		return nil, "", false
+	}
+	name := strings.ToLower(arg[:i])
+	for _, s := range dj.AvailableServices {
+		if searchService, isSearch := s.(interfaces.SearchService); isSearch && strings.ToLower(s.GetReadableName()) == name {
+			return searchService, strings.TrimSpace(arg[i+1:]), true
+		}
+	}
+	return nil, "", false
+}
+
+// IsPlainSearch returns true if `arg` should be searched for with the service
+// set in commands.add.search_service, because commands.add.search_plain_text
+// is enabled and it is neither a link, a search such as "youtube:terms", nor
+// anything else that a service or the cache recognizes.
+func (dj *MumbleDJ) IsPlainSearch(arg string) bool {
+	if !viper.GetBool("commands.add.search_plain_text") || strings.Contains(arg, "://") {
+		return false
+	}
+	if _, _, ok := dj.GetSearch(arg); ok {
+		return false
+	}
+	if _, ok := dj.Cache.FindTrack(arg); ok {
+		return false
+	}
+	_, err := dj.GetService(arg)
+	return err != nil
 }
 
 func (dj *MumbleDJ) findCommand(message string) (interfaces.Command, error) {
-	var possibleCommand string
-	if strings.Contains(message, " ") {
-		possibleCommand = strings.ToLower(message[:strings.Index(message, " ")])
-	} else {
-		possibleCommand = strings.ToLower(message)
+	// Arguments may also start on the next line, e.g. a URL on each line.
+	possibleCommand := strings.ToLower(message)
+	if i := strings.IndexFunc(message, unicode.IsSpace); i != -1 {
+		possibleCommand = strings.ToLower(message[:i])
 	}
 	for _, command := range dj.Commands {
 		for _, alias := range command.Aliases() {
@@ -306,6 +629,10 @@ func (dj *MumbleDJ) findCommand(message string) (interfaces.Command, error) {
 }
 
 func (dj *MumbleDJ) executeCommand(user *gumble.User, message string, command interfaces.Command) (string, bool, error) {
+	if dj.Bans.Blocks(user) && !dj.IsAdmin(user) {
+		return "", true, errors.New("You cannot use the bot while you are banned or muted on the server")
+	}
+
 	canExecute := false
 	if viper.GetBool("admins.enabled") && command.IsAdminCommand() {
 		canExecute = dj.IsAdmin(user)
@@ -313,8 +640,36 @@ func (dj *MumbleDJ) executeCommand(user *gumble.User, message string, command in
 		canExecute = true
 	}
 
-	if canExecute {
-		return command.Execute(user, strings.Split(message, " ")[1:]...)
+	if !canExecute {
+		return "", true, errors.New("You do not have permission to execute this command")
+	}
+	if tier, ok := dj.CanUse(user, command.Aliases()); !ok {
+		return "", true, fmt.Errorf(dj.Localize(user, "tiers.messages.command_denied_error"), tier)
+	}
+
+	// Commands that declare their arguments are only executed with arguments
+	// that match, and are told how they are used otherwise.
+	if signatureCommand, ok := command.(interfaces.SignatureCommand); ok {
+		args := SplitArguments(message)
+		parsed, err := ParseArguments(user, signatureCommand.Signature(), args[1:])
+		if err != nil {
+			usage := Usage(strings.ToLower(args[0]), signatureCommand.Signature())
+			return "", true, fmt.Errorf("%s<br>"+dj.Localize(user, "commands.common_messages.usage"), err.Error(), html.EscapeString(usage))
+		}
+		return signatureCommand.ExecuteArguments(user, parsed)
 	}
-	return "", true, errors.New("You do not have permission to execute this command")
+	return command.Execute(user, strings.Split(message, " ")[1:]...)
+}
+
+// CheckQueuePermission returns an error if `user` may not use the add command,
+// for commands that add tracks to the queue on its behalf. Admins and tiers
+// that bar users from adding tracks bar them from these commands as well.
+func (dj *MumbleDJ) CheckQueuePermission(user *gumble.User) error {
+	if viper.GetBool("admins.enabled") && viper.GetBool("commands.add.is_admin") && !dj.IsAdmin(user) {
+		return errors.New("You do not have permission to execute this command")
+	}
+	if tier, ok := dj.CanUse(user, viper.GetStringSlice("commands.add.aliases")); !ok {
+		return fmt.Errorf(dj.Localize(user, "tiers.messages.command_denied_error"), tier)
+	}
+	return nil
 }
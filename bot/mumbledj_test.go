@@ -0,0 +1,140 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/mumbledj_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type CommandPrefixTestSuite struct {
+	suite.Suite
+}
+
+func (suite *CommandPrefixTestSuite) SetupTest() {
+	viper.Set("commands.prefix", "!")
+	viper.Set("commands.alternate_prefixes", []string{"/dj "})
+}
+
+func (suite *CommandPrefixTestSuite) TestStripsPrefix() {
+	command, ok := stripCommandPrefix("!add https://example.com")
+
+	suite.True(ok)
+	suite.Equal("add https://example.com", command)
+}
+
+func (suite *CommandPrefixTestSuite) TestStripsAlternatePrefix() {
+	command, ok := stripCommandPrefix("/DJ  skip")
+
+	suite.True(ok)
+	suite.Equal("skip", command)
+}
+
+func (suite *CommandPrefixTestSuite) TestIgnoresOtherMessages() {
+	for _, message := range []string{"hello", "!", "/dj ", "/djskip", "/me dances"} {
+		_, ok := stripCommandPrefix(message)
+		suite.False(ok, message)
+	}
+}
+
+func (suite *CommandPrefixTestSuite) TestAlternatePrefixesCanBeDisabled() {
+	viper.Set("commands.alternate_prefixes", []string{})
+
+	_, ok := stripCommandPrefix("/dj skip")
+
+	suite.False(ok)
+}
+
+func TestCommandPrefixTestSuite(t *testing.T) {
+	suite.Run(t, new(CommandPrefixTestSuite))
+}
+
+type PlainSearchTestSuite struct {
+	suite.Suite
+}
+
+func (suite *PlainSearchTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+	viper.Set("commands.add.search_plain_text", true)
+}
+
+func (suite *PlainSearchTestSuite) TestPlainTextIsSearched() {
+	suite.True(DJ.IsPlainSearch("never gonna give you up"))
+}
+
+func (suite *PlainSearchTestSuite) TestLinksAreNotSearched() {
+	suite.False(DJ.IsPlainSearch("https://example.com/song.mp3"))
+}
+
+func (suite *PlainSearchTestSuite) TestPlainSearchCanBeDisabled() {
+	viper.Set("commands.add.search_plain_text", false)
+
+	suite.False(DJ.IsPlainSearch("never gonna give you up"))
+}
+
+func TestPlainSearchTestSuite(t *testing.T) {
+	suite.Run(t, new(PlainSearchTestSuite))
+}
+
+type ServiceRoutingTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ServiceRoutingTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+	DJ.AvailableServices = []interfaces.Service{formatService{name: "First"}, formatService{name: "Second"}}
+}
+
+func (suite *ServiceRoutingTestSuite) TestUnsupportedURL() {
+	service, err := DJ.GetService("https://example.com/song")
+
+	suite.Nil(service)
+	suite.Equal(ErrUnsupportedURL, err)
+}
+
+func (suite *ServiceRoutingTestSuite) TestServiceNames() {
+	suite.Equal([]string{"First", "Second"}, DJ.ServiceNames())
+}
+
+func TestServiceRoutingTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceRoutingTestSuite))
+}
+
+type DisconnectTestSuite struct {
+	suite.Suite
+}
+
+func (suite *DisconnectTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+}
+
+func (suite *DisconnectTestSuite) TestShutdownIsNotALostConnection() {
+	DJ.shuttingDown = 1
+	returned := make(chan bool)
+	go func() {
+		DJ.OnDisconnect(&gumble.DisconnectEvent{Type: gumble.DisconnectUser})
+		returned <- true
+	}()
+
+	select {
+	case <-returned:
+	case <-DJ.KeepAlive:
+		suite.Fail("The shutdown was taken for a lost connection")
+	case <-time.After(time.Second):
+		suite.Fail("OnDisconnect did not return")
+	}
+}
+
+func TestDisconnectTestSuite(t *testing.T) {
+	suite.Run(t, new(DisconnectTestSuite))
+}
@@ -0,0 +1,189 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/notifier.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumble"
+	"github.com/layeh/gumble/gumbleutil"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// webhookClient is used to deliver notifications to webhooks.
+var webhookClient = &http.Client{Timeout: 10 * time.Second}
+
+// remoteNotifier is implemented by notifiers that send notifications over the
+// network. Their notifications are delivered in the background, in order, so
+// that a slow or unreachable service does not hold up playback.
+type remoteNotifier interface {
+	interfaces.Notifier
+	remote()
+}
+
+// delivery is a notification waiting to be sent by a remote notifier.
+type delivery struct {
+	name     string
+	notifier interfaces.Notifier
+	event    string
+	user     string
+	message  string
+}
+
+// deliveries holds the notifications waiting to be sent by remote notifiers.
+// Notifications are dropped while it is full.
+var (
+	deliveries    = make(chan delivery, 100)
+	startDelivery sync.Once
+)
+
+// NewNotifiers returns the built-in notifiers keyed by the names used for them
+// in notifications.events.
+func NewNotifiers() map[string]interfaces.Notifier {
+	return map[string]interfaces.Notifier{
+		"channel": new(ChannelNotifier),
+		"private": new(PrivateNotifier),
+		"webhook": new(WebhookNotifier),
+		"discord": new(DiscordNotifier),
+	}
+}
+
+// Notify sends `message` about `event` to each notifier listed for the event in
+// notifications.events. `user` is the name of the user the event concerns, if
+// any.
+func (dj *MumbleDJ) Notify(event, user, message string) {
+	for _, name := range viper.GetStringSlice("notifications.events." + event) {
+		notifier, ok := dj.Notifiers[name]
+		if !ok {
+			logrus.WithFields(logrus.Fields{
+				"event":    event,
+				"notifier": name,
+			}).Warnln("Ignoring unknown notifier.")
+			continue
+		}
+		if _, ok := notifier.(remoteNotifier); ok {
+			startDelivery.Do(func() {
+				go deliverRemote()
+			})
+			select {
+			case deliveries <- delivery{name, notifier, event, user, message}:
+			default:
+				logrus.WithFields(logrus.Fields{
+					"event":    event,
+					"notifier": name,
+				}).Warnln("Dropping a notification, too many are waiting to be sent.")
+			}
+			continue
+		}
+		sendNotification(name, notifier, event, user, message)
+	}
+}
+
+// deliverRemote sends the notifications waiting in deliveries one at a time.
+func deliverRemote() {
+	for d := range deliveries {
+		sendNotification(d.name, d.notifier, d.event, d.user, d.message)
+	}
+}
+
+// sendNotification sends `message` with `notifier` and logs a failure.
+func sendNotification(name string, notifier interfaces.Notifier, event, user, message string) {
+	if err := notifier.Notify(event, user, message); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"event":    event,
+			"notifier": name,
+			"error":    err.Error(),
+		}).Warnln("A notification could not be sent.")
+	}
+}
+
+// ChannelNotifier sends notifications to the Mumble channel the bot is in.
+type ChannelNotifier struct{}
+
+// Notify sends the message to the bot's channel.
+func (n *ChannelNotifier) Notify(event, user, message string) error {
+	if DJ.Client == nil {
+		return errors.New("The bot is not connected")
+	}
+	DJ.Client.Do(func() {
+		DJ.Client.Self.Channel.Send(message, false)
+	})
+	return nil
+}
+
+// PrivateNotifier sends notifications privately to the user they concern.
+type PrivateNotifier struct{}
+
+// Notify sends the message to `user` if they are in the bot's channel.
+// Notifications that concern nobody in particular are dropped.
+func (n *PrivateNotifier) Notify(event, user, message string) error {
+	if user == "" {
+		return nil
+	}
+	if DJ.Client == nil {
+		return errors.New("The bot is not connected")
+	}
+	DJ.SendPrivateMessageToName(user, message)
+	return nil
+}
+
+// WebhookNotifier posts notifications as JSON to notifications.webhook_url,
+// for example to feed a website or another chat bridge.
+type WebhookNotifier struct{}
+
+// Notify posts the event, user and message to the webhook.
+func (n *WebhookNotifier) Notify(event, user, message string) error {
+	return postJSON(viper.GetString("notifications.webhook_url"), map[string]string{
+		"event":   event,
+		"user":    user,
+		"message": message,
+	})
+}
+
+func (n *WebhookNotifier) remote() {}
+
+// DiscordNotifier posts notifications to the Discord webhook in
+// notifications.discord_webhook_url as plain text.
+type DiscordNotifier struct{}
+
+// Notify posts the message, without HTML formatting, to the Discord webhook.
+func (n *DiscordNotifier) Notify(event, user, message string) error {
+	return postJSON(viper.GetString("notifications.discord_webhook_url"), map[string]string{
+		"content": gumbleutil.PlainText(&gumble.TextMessage{Message: message}),
+	})
+}
+
+func (n *DiscordNotifier) remote() {}
+
+// postJSON posts `body` encoded as JSON to `url`.
+func postJSON(url string, body interface{}) error {
+	if url == "" {
+		return errors.New("No webhook URL has been configured")
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	response, err := webhookClient.Post(url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("The webhook returned status %s", response.Status)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/notifier_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type recordingNotifier struct {
+	messages []string
+}
+
+func (n *recordingNotifier) Notify(event, user, message string) error {
+	n.messages = append(n.messages, event+"|"+user+"|"+message)
+	return nil
+}
+
+type NotifierTestSuite struct {
+	suite.Suite
+	Recorder *recordingNotifier
+}
+
+func (suite *NotifierTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+	suite.Recorder = new(recordingNotifier)
+	DJ.Notifiers["recorder"] = suite.Recorder
+}
+
+func (suite *NotifierTestSuite) TearDownTest() {
+	viper.Set("notifications.events.track_started", []string{"channel"})
+	viper.Set("notifications.webhook_url", "")
+	viper.Set("notifications.discord_webhook_url", "")
+}
+
+func (suite *NotifierTestSuite) TestNotifyUsesConfiguredNotifiers() {
+	viper.Set("notifications.events.track_started", []string{"recorder", "unknown"})
+
+	DJ.Notify("track_started", "test", "message")
+
+	suite.Equal([]string{"track_started|test|message"}, suite.Recorder.messages)
+}
+
+func (suite *NotifierTestSuite) TestNotifyWithNoNotifiers() {
+	viper.Set("notifications.events.track_started", []string{})
+
+	DJ.Notify("track_started", "test", "message")
+
+	suite.Empty(suite.Recorder.messages)
+}
+
+func (suite *NotifierTestSuite) TestNotifyDoesNotWaitForWebhooks() {
+	received := make(chan bool)
+	release := make(chan bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- true
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+	viper.Set("notifications.webhook_url", server.URL)
+	viper.Set("notifications.events.track_started", []string{"webhook", "recorder"})
+
+	DJ.Notify("track_started", "test", "message")
+
+	suite.Equal([]string{"track_started|test|message"}, suite.Recorder.messages)
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		suite.Fail("The webhook was not notified")
+	}
+}
+
+func (suite *NotifierTestSuite) TestWebhookNotifier() {
+	var received map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+	}))
+	defer server.Close()
+	viper.Set("notifications.webhook_url", server.URL)
+
+	err := new(WebhookNotifier).Notify("track_started", "test", "<b>message</b>")
+
+	suite.Nil(err)
+	suite.Equal(map[string]string{"event": "track_started", "user": "test", "message": "<b>message</b>"}, received)
+}
+
+func (suite *NotifierTestSuite) TestDiscordNotifierSendsPlainText() {
+	var received map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	viper.Set("notifications.discord_webhook_url", server.URL)
+
+	err := new(DiscordNotifier).Notify("track_started", "test", "Now playing: <i>Title</i>")
+
+	suite.Nil(err)
+	suite.Equal("Now playing: Title", received["content"])
+}
+
+func (suite *NotifierTestSuite) TestWebhookNotifierWithoutURL() {
+	suite.NotNil(new(WebhookNotifier).Notify("track_started", "test", "message"))
+}
+
+func (suite *NotifierTestSuite) TestWebhookNotifierWithErrorStatus() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	viper.Set("notifications.webhook_url", server.URL)
+
+	suite.NotNil(new(WebhookNotifier).Notify("track_started", "test", "message"))
+}
+
+func TestNotifierTestSuite(t *testing.T) {
+	suite.Run(t, new(NotifierTestSuite))
+}
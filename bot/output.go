@@ -0,0 +1,263 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/output.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumbleffmpeg"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// MonitorOff is the monitor output setting that disables the monitor.
+const MonitorOff = "off"
+
+// monitorFormats maps the monitor output settings to the output formats of
+// the player command that play audio on a local sound device.
+var monitorFormats = map[string]string{
+	"pulse": "pulse",
+	"alsa":  "alsa",
+}
+
+// MumbleOutput plays audio in the Mumble channel the bot is in through
+// gumbleffmpeg. The stream it creates is kept in DJ.AudioStream.
+type MumbleOutput struct{}
+
+// Play creates a new audio stream for `track` and begins playing it.
+func (o *MumbleOutput) Play(track interfaces.Track, offset time.Duration) error {
+	var source gumbleffmpeg.Source
+	if track.IsLive() {
+		source = DJ.YouTubeDL.LiveSource(track)
+	} else {
+		source = gumbleffmpeg.SourceFile(trackPath(track))
+	}
+	DJ.AudioStream = gumbleffmpeg.New(DJ.Client, source)
+	DJ.AudioStream.Offset = offset
+	DJ.AudioStream.Volume = DJ.Volume
+	DJ.AudioStream.Command = playerCommand()
+	return DJ.AudioStream.Play()
+}
+
+// Pause pauses the current audio stream if it exists and is not already paused.
+func (o *MumbleOutput) Pause() error {
+	if DJ.AudioStream == nil {
+		return errors.New("There is no track to pause")
+	}
+	if DJ.AudioStream.State() == gumbleffmpeg.StatePaused {
+		return errors.New("The track is already paused")
+	}
+	DJ.AudioStream.Pause()
+	return nil
+}
+
+// Resume resumes playback of the current audio stream if it exists and is paused.
+func (o *MumbleOutput) Resume() error {
+	if DJ.AudioStream == nil {
+		return errors.New("There is no track to resume")
+	}
+	if DJ.AudioStream.State() == gumbleffmpeg.StatePlaying {
+		return errors.New("The track is already playing")
+	}
+	DJ.AudioStream.Play()
+	return nil
+}
+
+// Stop stops the playback of the current audio stream if it exists.
+func (o *MumbleOutput) Stop() error {
+	if DJ.AudioStream == nil {
+		return errors.New("The audio stream is nil")
+	}
+	DJ.AudioStream.Stop()
+	return nil
+}
+
+// MonitorOutput plays the same audio as the Mumble output on a local ALSA or
+// PulseAudio device, so operators can hear what the bot is sending without
+// joining the server. The device can be changed while a track is playing.
+type MonitorOutput struct {
+	device string
+	cmd    *exec.Cmd
+	track  interfaces.Track
+	offset time.Duration
+	mutex  sync.Mutex
+}
+
+// NewMonitorOutput returns a monitor output that uses the device set in
+// output.monitor until another one is chosen with SetDevice.
+func NewMonitorOutput() *MonitorOutput {
+	return &MonitorOutput{}
+}
+
+// Device returns the name of the device the monitor plays on, or MonitorOff.
+func (m *MonitorOutput) Device() string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.currentDevice()
+}
+
+// SetDevice switches the monitor to `device`, which is "pulse", "alsa" or
+// MonitorOff. If a track is playing, the monitor picks it up on the new device
+// where the Mumble output currently is.
+func (m *MonitorOutput) SetDevice(device string) error {
+	if _, ok := monitorFormats[device]; !ok && device != MonitorOff {
+		return fmt.Errorf("Unknown monitor device \"%s\"", device)
+	}
+
+	m.mutex.Lock()
+	m.stop()
+	m.device = device
+	track, offset := m.track, m.offset
+	m.mutex.Unlock()
+
+	stream := DJ.AudioStream
+	if track == nil || stream == nil || stream.State() == gumbleffmpeg.StateStopped {
+		return nil
+	}
+	if err := m.Play(track, offset+stream.Elapsed()); err != nil {
+		return err
+	}
+	if stream.State() == gumbleffmpeg.StatePaused {
+		return m.Pause()
+	}
+	return nil
+}
+
+// Play begins playing `track` on the monitor device, starting `offset` into
+// it. Nothing is played while the monitor is off.
+func (m *MonitorOutput) Play(track interfaces.Track, offset time.Duration) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.stop()
+	m.track = track
+	m.offset = offset
+	format, ok := monitorFormats[m.currentDevice()]
+	if !ok {
+		return nil
+	}
+
+	input := trackPath(track)
+	if track.IsLive() {
+		input = "-"
+	}
+	args := []string{"-loglevel", "error"}
+	if offset > 0 && !track.IsLive() {
+		args = append(args, "-ss", strconv.FormatFloat(offset.Seconds(), 'f', -1, 64))
+	}
+	args = append(args, CurrentLatencyProfile().InputArgs...)
+	args = append(args, "-i", input, "-af", fmt.Sprintf("volume=%f", DJ.Volume), "-f", format, viper.GetString("output.monitor_device"))
+	cmd := exec.Command(playerCommand(), args...)
+
+	var source *exec.Cmd
+	if track.IsLive() {
+		sourceName, sourceArgs := liveCommand(track)
+		source = exec.Command(sourceName, sourceArgs...)
+		pipe, err := source.StdoutPipe()
+		if err != nil {
+			return err
+		}
+		cmd.Stdin = pipe
+		if err := source.Start(); err != nil {
+			return err
+		}
+	}
+	if err := cmd.Start(); err != nil {
+		if source != nil {
+			source.Process.Kill()
+			source.Wait()
+		}
+		return err
+	}
+	m.cmd = cmd
+
+	go func() {
+		cmd.Wait()
+		if source != nil {
+			source.Process.Kill()
+			source.Wait()
+		}
+	}()
+	return nil
+}
+
+// Pause suspends the monitor player.
+func (m *MonitorOutput) Pause() error {
+	return m.signal(syscall.SIGSTOP)
+}
+
+// Resume continues the suspended monitor player.
+func (m *MonitorOutput) Resume() error {
+	return m.signal(syscall.SIGCONT)
+}
+
+// Stop stops the monitor player if it is running.
+func (m *MonitorOutput) Stop() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.stop()
+	m.track = nil
+	return nil
+}
+
+// Refresh restarts the monitor player where the Mumble output currently is,
+// for example to pick up a new volume.
+func (m *MonitorOutput) Refresh() error {
+	return m.SetDevice(m.Device())
+}
+
+// signal sends `sig` to the monitor player if it is running.
+func (m *MonitorOutput) signal(sig syscall.Signal) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.cmd == nil || m.cmd.Process == nil {
+		return nil
+	}
+	return m.cmd.Process.Signal(sig)
+}
+
+// stop kills the monitor player. The mutex must be held by the caller.
+func (m *MonitorOutput) stop() {
+	if m.cmd == nil || m.cmd.Process == nil {
+		return
+	}
+	if err := m.cmd.Process.Kill(); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Debugln("Could not stop the monitor player.")
+	}
+	m.cmd = nil
+}
+
+// currentDevice returns the device chosen with SetDevice, or the one set in
+// output.monitor if none has been chosen. The mutex must be held by the caller.
+func (m *MonitorOutput) currentDevice() string {
+	device := m.device
+	if device == "" {
+		device = viper.GetString("output.monitor")
+	}
+	if _, ok := monitorFormats[device]; !ok {
+		return MonitorOff
+	}
+	return device
+}
+
+// playerCommand returns the command used to decode audio.
+func playerCommand() string {
+	if viper.GetString("defaults.player_command") == "avconv" {
+		return "avconv"
+	}
+	return "ffmpeg"
+}
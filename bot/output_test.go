@@ -0,0 +1,58 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/output_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type OutputTestSuite struct {
+	suite.Suite
+}
+
+func (suite *OutputTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+	viper.Set("output.monitor", "off")
+}
+
+func (suite *OutputTestSuite) TestMonitorDeviceDefaultsToConfig() {
+	suite.Equal(MonitorOff, DJ.Monitor.Device())
+
+	viper.Set("output.monitor", "pulse")
+	suite.Equal("pulse", DJ.Monitor.Device())
+
+	viper.Set("output.monitor", "speakers")
+	suite.Equal(MonitorOff, DJ.Monitor.Device())
+}
+
+func (suite *OutputTestSuite) TestSetDevice() {
+	suite.Nil(DJ.Monitor.SetDevice("alsa"))
+	suite.Equal("alsa", DJ.Monitor.Device())
+
+	suite.NotNil(DJ.Monitor.SetDevice("speakers"))
+	suite.Equal("alsa", DJ.Monitor.Device())
+}
+
+func (suite *OutputTestSuite) TestMonitorPlaysNothingWhenOff() {
+	suite.Nil(DJ.Monitor.Play(Track{Filename: "test.track"}, 0))
+	suite.Nil(DJ.Monitor.cmd)
+	suite.Nil(DJ.Monitor.Stop())
+}
+
+func (suite *OutputTestSuite) TestMumbleOutputWithoutStream() {
+	suite.NotNil(DJ.Output.Pause())
+	suite.NotNil(DJ.Output.Resume())
+	suite.NotNil(DJ.Output.Stop())
+}
+
+func TestOutputTestSuite(t *testing.T) {
+	suite.Run(t, new(OutputTestSuite))
+}
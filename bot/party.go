@@ -0,0 +1,126 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/party.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/layeh/gumble/gumble"
+)
+
+// partyVoiceTargetID is the voice target the bot transmits to during a
+// listening party. Mumble allows targets 1 through 30.
+const partyVoiceTargetID = 1
+
+var (
+	// ErrNoPartyChannels is returned when none of the channels given for a
+	// listening party can be transmitted to.
+	ErrNoPartyChannels = errors.New("None of the given channels exist or can be transmitted to")
+	// ErrNoParty is returned when a listening party is ended while none is
+	// running.
+	ErrNoParty = errors.New("There is no listening party to end")
+)
+
+// Party links the bot's transmissions to several channels at once, so that
+// users in all of them hear the same audio at the same time. The bot keeps
+// transmitting to the channel it was in when the party started.
+type Party struct {
+	channels []string
+	mutex    sync.Mutex
+}
+
+// NewParty returns a Party that is not running.
+func NewParty() *Party {
+	return &Party{}
+}
+
+// Start begins a listening party in the channels named `names`, in addition to
+// the bot's own channel. Names may be paths from the root channel separated by
+// "/". Channels that do not exist, or that the bot is known not to have the
+// whisper permission in, are skipped and returned; the party starts as long as
+// at least one channel remains. Starting a party while one is running replaces
+// it.
+func (p *Party) Start(names []string) (linked, skipped []string, err error) {
+	self := DJ.Client.Self
+	target := &gumble.VoiceTarget{ID: partyVoiceTargetID}
+	target.AddChannel(self.Channel, false, false, "")
+
+	for _, name := range names {
+		channel := findChannel(DJ.Client.Channels, name)
+		if channel == nil || channel == self.Channel {
+			if channel == nil {
+				skipped = append(skipped, name)
+			}
+			continue
+		}
+		if permission := channel.Permission(); permission == nil {
+			// The server sends the permissions of a channel only on request.
+			// Until they arrive, the channel is assumed to be fine; if it is
+			// not, the server simply does not relay audio to it.
+			channel.RequestPermission()
+		} else if *permission&gumble.PermissionWhisper == 0 {
+			skipped = append(skipped, channel.Name)
+			continue
+		}
+		target.AddChannel(channel, false, false, "")
+		linked = append(linked, channel.Name)
+	}
+	if len(linked) == 0 {
+		return nil, skipped, ErrNoPartyChannels
+	}
+
+	DJ.Client.Send(target)
+	DJ.Client.VoiceTarget = target
+
+	p.mutex.Lock()
+	p.channels = linked
+	p.mutex.Unlock()
+	return linked, skipped, nil
+}
+
+// End reverts the bot to transmitting to its own channel only. Returns
+// ErrNoParty if no listening party is running.
+func (p *Party) End() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.channels == nil {
+		return ErrNoParty
+	}
+	p.channels = nil
+	if DJ.Client != nil {
+		DJ.Client.VoiceTarget = nil
+	}
+	return nil
+}
+
+// Channels returns the names of the channels linked by the running listening
+// party, or nil if none is running.
+func (p *Party) Channels() []string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.channels
+}
+
+// findChannel returns the channel named `name` in `channels`, or nil if there
+// is none. A name containing "/" is looked up as a path from the root channel;
+// otherwise the oldest channel with a matching name, ignoring case, is
+// returned.
+func findChannel(channels gumble.Channels, name string) *gumble.Channel {
+	if strings.Contains(name, "/") {
+		return channels.Find(strings.Split(strings.Trim(name, "/"), "/")...)
+	}
+	var found *gumble.Channel
+	for _, channel := range channels {
+		if strings.EqualFold(channel.Name, name) && (found == nil || channel.ID < found.ID) {
+			found = channel
+		}
+	}
+	return found
+}
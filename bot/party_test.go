@@ -0,0 +1,55 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/party_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/stretchr/testify/suite"
+)
+
+type PartyTestSuite struct {
+	suite.Suite
+	Channels gumble.Channels
+}
+
+func (suite *PartyTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+
+	root := &gumble.Channel{ID: 0, Name: "Root", Children: gumble.Channels{}}
+	lounge := &gumble.Channel{ID: 1, Name: "Lounge", Parent: root, Children: gumble.Channels{}}
+	games := &gumble.Channel{ID: 2, Name: "Games", Parent: root, Children: gumble.Channels{}}
+	gamesLounge := &gumble.Channel{ID: 3, Name: "Lounge", Parent: games, Children: gumble.Channels{}}
+	root.Children[1] = lounge
+	root.Children[2] = games
+	games.Children[3] = gamesLounge
+
+	suite.Channels = gumble.Channels{0: root, 1: lounge, 2: games, 3: gamesLounge}
+}
+
+func (suite *PartyTestSuite) TestFindChannelByName() {
+	suite.Equal(uint32(2), findChannel(suite.Channels, "games").ID)
+	suite.Equal(uint32(1), findChannel(suite.Channels, "Lounge").ID, "The oldest matching channel should be returned.")
+	suite.Nil(findChannel(suite.Channels, "Music"))
+}
+
+func (suite *PartyTestSuite) TestFindChannelByPath() {
+	suite.Equal(uint32(3), findChannel(suite.Channels, "Games/Lounge").ID)
+	suite.Equal(uint32(3), findChannel(suite.Channels, "/Games/Lounge/").ID)
+	suite.Nil(findChannel(suite.Channels, "Lounge/Games"))
+}
+
+func (suite *PartyTestSuite) TestEndWithoutParty() {
+	suite.Equal(ErrNoParty, DJ.Party.End())
+	suite.Nil(DJ.Party.Channels())
+}
+
+func TestPartyTestSuite(t *testing.T) {
+	suite.Run(t, new(PartyTestSuite))
+}
@@ -0,0 +1,98 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/position.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumbleffmpeg"
+	"github.com/spf13/viper"
+)
+
+// PlaybackPosition describes how far playback of the current track has
+// progressed. Times are given in milliseconds so that overlays can render
+// smooth progress bars.
+type PlaybackPosition struct {
+	Playing  bool   `json:"playing"`
+	Paused   bool   `json:"paused"`
+	Live     bool   `json:"live"`
+	TrackID  string `json:"track_id,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Position int64  `json:"position_ms"`
+	Duration int64  `json:"duration_ms"`
+	// Timestamp is when the position was taken, in milliseconds since the Unix
+	// epoch, so clients can extrapolate between requests.
+	Timestamp int64 `json:"timestamp_ms"`
+}
+
+// Position returns the playback position of the current track. The position
+// includes the offset the track was started from.
+func (dj *MumbleDJ) Position() PlaybackPosition {
+	position := PlaybackPosition{
+		Timestamp: time.Now().UnixNano() / int64(time.Millisecond),
+	}
+	stream := dj.AudioStream
+	track, err := dj.Queue.CurrentTrack()
+	if stream == nil || err != nil {
+		return position
+	}
+
+	state := stream.State()
+	position.Playing = state == gumbleffmpeg.StatePlaying
+	position.Paused = state == gumbleffmpeg.StatePaused
+	position.Live = track.IsLive()
+	position.TrackID = track.GetID()
+	position.Title = track.GetTitle()
+	position.Position = int64((track.GetPlaybackOffset() + stream.Elapsed()) / time.Millisecond)
+	// Chapters of a longer recording are timed from the start of the chapter.
+	if end := playbackEnd(track); end > 0 {
+		position.Position -= int64((end - track.GetDuration()) / time.Millisecond)
+	}
+	position.Duration = int64(track.GetDuration() / time.Millisecond)
+	return position
+}
+
+// ServeAPI serves the HTTP API on api.address until it fails. Nothing is
+// served if no address is configured.
+//
+// GET /position returns the PlaybackPosition of the current track as JSON.
+func (dj *MumbleDJ) ServeAPI() {
+	address := viper.GetString("api.address")
+	if address == "" {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/position", dj.handlePosition)
+	logrus.WithFields(logrus.Fields{
+		"address": address,
+	}).Infoln("Serving the HTTP API.")
+	if err := http.ListenAndServe(address, mux); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"address": address,
+			"error":   err.Error(),
+		}).Warnln("The HTTP API could not be served.")
+	}
+}
+
+// handlePosition writes the current playback position as JSON.
+func (dj *MumbleDJ) handlePosition(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	// Overlays are usually served from a different origin, such as a local
+	// file loaded by streaming software.
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	json.NewEncoder(w).Encode(dj.Position())
+}
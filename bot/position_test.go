@@ -0,0 +1,64 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/position_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/layeh/gumble/gumbleffmpeg"
+	"github.com/stretchr/testify/suite"
+)
+
+type PositionTestSuite struct {
+	suite.Suite
+}
+
+func (suite *PositionTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+}
+
+func (suite *PositionTestSuite) TestPositionWhenNothingIsPlaying() {
+	position := DJ.Position()
+
+	suite.False(position.Playing)
+	suite.Equal("", position.TrackID)
+	suite.NotZero(position.Timestamp)
+}
+
+func (suite *PositionTestSuite) TestPositionIncludesOffset() {
+	DJ.AudioStream = new(gumbleffmpeg.Stream)
+	DJ.Queue.AppendTrack(Track{ID: "test", Duration: 3 * time.Minute, PlaybackOffset: 1500 * time.Millisecond})
+
+	position := DJ.Position()
+
+	suite.Equal("test", position.TrackID)
+	suite.Equal(int64(1500), position.Position)
+	suite.Equal(int64(180000), position.Duration)
+}
+
+func (suite *PositionTestSuite) TestHandlePosition() {
+	recorder := httptest.NewRecorder()
+
+	DJ.handlePosition(recorder, httptest.NewRequest("GET", "/position", nil))
+
+	var position PlaybackPosition
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Nil(json.Unmarshal(recorder.Body.Bytes(), &position))
+
+	recorder = httptest.NewRecorder()
+	DJ.handlePosition(recorder, httptest.NewRequest("POST", "/position", nil))
+	suite.Equal(http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestPositionTestSuite(t *testing.T) {
+	suite.Run(t, new(PositionTestSuite))
+}
@@ -0,0 +1,150 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/premieres.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+const (
+	// premiereRetryInterval is how often a premiere that is late to start
+	// is checked again.
+	premiereRetryInterval = time.Minute
+
+	// premiereGiveUp is how long after its scheduled start a premiere is
+	// waited for before giving up on it.
+	premiereGiveUp = time.Hour
+)
+
+var (
+	// ErrPremiereTooFar is returned when a premiere starts later than
+	// premieres.max_wait allows waiting for.
+	ErrPremiereTooFar = errors.New("The premiere starts too far in the future")
+
+	// ErrPremiereWatched is returned when a premiere is already waited for.
+	ErrPremiereWatched = errors.New("The premiere is already waited for")
+)
+
+// Premieres waits for live streams and premieres that were added before they
+// started, and queues each of them once it has started.
+type Premieres struct {
+	pending map[string]*time.Timer
+	mutex   sync.Mutex
+}
+
+// NewPremieres returns a Premieres that is not waiting for anything.
+func NewPremieres() *Premieres {
+	return &Premieres{
+		pending: make(map[string]*time.Timer),
+	}
+}
+
+// Watch queues `url`, which `submitter` added before it started, once it has
+// started. It is first checked at `startsAt`, or in a minute if the start is
+// unknown, and then every minute until an hour after it was due.
+func (p *Premieres) Watch(url string, submitter *gumble.User, startsAt time.Time) error {
+	if maxWait := time.Duration(viper.GetInt("premieres.max_wait")) * time.Minute; maxWait > 0 && time.Until(startsAt) > maxWait {
+		return ErrPremiereTooFar
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, ok := p.pending[url]; ok {
+		return ErrPremiereWatched
+	}
+	if startsAt.Before(time.Now()) {
+		startsAt = time.Now()
+	}
+	p.schedule(url, submitter, startsAt, startsAt.Add(premiereGiveUp))
+	return nil
+}
+
+// Pending returns the number of premieres that are waited for.
+func (p *Premieres) Pending() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return len(p.pending)
+}
+
+// schedule checks `url` at `at`, or in a minute if that has passed. The
+// caller must hold the mutex.
+func (p *Premieres) schedule(url string, submitter *gumble.User, at, deadline time.Time) {
+	wait := time.Until(at)
+	if wait < premiereRetryInterval {
+		wait = premiereRetryInterval
+	}
+	p.pending[url] = time.AfterFunc(wait, func() {
+		p.check(url, submitter, deadline)
+	})
+}
+
+// check queues `url` if it has started. Otherwise it is checked again later,
+// unless `deadline` has passed.
+func (p *Premieres) check(url string, submitter *gumble.User, deadline time.Time) {
+	service, err := DJ.GetService(url)
+	if err == nil {
+		tracks, lookupErr := service.GetTracks(url, submitter)
+		if lookupErr == nil {
+			p.forget(url)
+			for _, track := range tracks {
+				if err := DJ.Queue.AppendTrack(track); err != nil {
+					p.fail(submitter, track.GetTitle(), err)
+					return
+				}
+			}
+			for _, track := range tracks {
+				DJ.Notify("premiere_queued", submitter.Name,
+					fmt.Sprintf(viper.GetString("premieres.messages.premiere_queued"), track.GetTitle(), submitter.Name))
+			}
+			return
+		}
+		err = lookupErr
+	}
+
+	upcoming, ok := err.(*UpcomingError)
+	if ok && time.Now().Before(deadline) {
+		p.mutex.Lock()
+		if _, pending := p.pending[url]; pending {
+			p.schedule(url, submitter, time.Now(), deadline)
+		}
+		p.mutex.Unlock()
+		return
+	}
+	p.forget(url)
+	title := url
+	if ok {
+		title = upcoming.Title
+	}
+	p.fail(submitter, title, err)
+}
+
+// forget stops waiting for `url`.
+func (p *Premieres) forget(url string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if timer, ok := p.pending[url]; ok {
+		timer.Stop()
+		delete(p.pending, url)
+	}
+}
+
+// fail tells `submitter` that the premiere `title` could not be queued.
+func (p *Premieres) fail(submitter *gumble.User, title string, err error) {
+	fields := ErrorFields(err)
+	fields["title"] = title
+	logrus.WithFields(fields).Warnln("A premiere could not be queued.")
+	DJ.Notify("track_failed", submitter.Name,
+		fmt.Sprintf(DJ.LocalizeFor(submitter.Name, "premieres.messages.premiere_failed"), title, err.Error()))
+}
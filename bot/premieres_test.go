@@ -0,0 +1,110 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/premieres_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/layeh/gumble/gumbleffmpeg"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+// premiereService is a service whose tracks are upcoming until it is started.
+type premiereService struct {
+	formatService
+	started *bool
+}
+
+func (s premiereService) CheckURL(string) bool { return true }
+func (s premiereService) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	if !*s.started {
+		return nil, &UpcomingError{Service: "Premieres", TrackID: "premiere", Title: "Premiere"}
+	}
+	return []interfaces.Track{Track{ID: "premiere", Title: "Premiere", Submitter: submitter.Name}}, nil
+}
+
+type PremieresTestSuite struct {
+	suite.Suite
+	Started   bool
+	Submitter *gumble.User
+	Recorder  *recordingNotifier
+}
+
+func (suite *PremieresTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+	// Trick the queue into thinking audio is already playing.
+	DJ.AudioStream = new(gumbleffmpeg.Stream)
+	suite.Started = false
+	DJ.AvailableServices = []interfaces.Service{premiereService{formatService{name: "Premieres"}, &suite.Started}}
+	suite.Submitter = &gumble.User{Name: "test"}
+	suite.Recorder = new(recordingNotifier)
+	DJ.Notifiers["recorder"] = suite.Recorder
+	viper.Set("notifications.events.premiere_queued", []string{"recorder"})
+	viper.Set("notifications.events.track_failed", []string{"recorder"})
+	viper.Set("premieres.max_wait", 120)
+}
+
+func (suite *PremieresTestSuite) TearDownTest() {
+	viper.Set("notifications.events.premiere_queued", []string{"channel"})
+	viper.Set("notifications.events.track_failed", []string{"private"})
+}
+
+func (suite *PremieresTestSuite) TestWatch() {
+	err := DJ.Premieres.Watch("https://example.com/premiere", suite.Submitter, time.Now().Add(time.Hour))
+
+	suite.Nil(err)
+	suite.Equal(1, DJ.Premieres.Pending())
+	suite.Equal(ErrPremiereWatched, DJ.Premieres.Watch("https://example.com/premiere", suite.Submitter, time.Time{}))
+	DJ.Premieres.forget("https://example.com/premiere")
+}
+
+func (suite *PremieresTestSuite) TestWatchTooFar() {
+	err := DJ.Premieres.Watch("https://example.com/premiere", suite.Submitter, time.Now().Add(3*time.Hour))
+
+	suite.Equal(ErrPremiereTooFar, err)
+	suite.Zero(DJ.Premieres.Pending())
+}
+
+func (suite *PremieresTestSuite) TestCheckQueuesStartedPremiere() {
+	DJ.Premieres.Watch("https://example.com/premiere", suite.Submitter, time.Time{})
+	suite.Started = true
+
+	DJ.Premieres.check("https://example.com/premiere", suite.Submitter, time.Now().Add(time.Hour))
+
+	suite.Equal(1, DJ.Queue.Length())
+	suite.Zero(DJ.Premieres.Pending())
+	suite.Len(suite.Recorder.messages, 1)
+}
+
+func (suite *PremieresTestSuite) TestCheckWaitsForLatePremiere() {
+	DJ.Premieres.Watch("https://example.com/premiere", suite.Submitter, time.Time{})
+
+	DJ.Premieres.check("https://example.com/premiere", suite.Submitter, time.Now().Add(time.Hour))
+
+	suite.Zero(DJ.Queue.Length())
+	suite.Equal(1, DJ.Premieres.Pending(), "The premiere should be checked again later.")
+	DJ.Premieres.forget("https://example.com/premiere")
+}
+
+func (suite *PremieresTestSuite) TestCheckGivesUp() {
+	DJ.Premieres.Watch("https://example.com/premiere", suite.Submitter, time.Time{})
+
+	DJ.Premieres.check("https://example.com/premiere", suite.Submitter, time.Now().Add(-time.Minute))
+
+	suite.Zero(DJ.Premieres.Pending())
+	suite.Len(suite.Recorder.messages, 1)
+	suite.Contains(suite.Recorder.messages[0], "track_failed|test|")
+}
+
+func TestPremieresTestSuite(t *testing.T) {
+	suite.Run(t, new(PremieresTestSuite))
+}
@@ -10,22 +10,41 @@ package bot
 import (
 	"errors"
 	"fmt"
+	"html"
 	"math/rand"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/Sirupsen/logrus"
 	"github.com/layeh/gumble/gumbleffmpeg"
 	_ "github.com/layeh/gumble/opus"
 	"github.com/matthieugrieger/mumbledj/interfaces"
 	"github.com/spf13/viper"
 )
 
+// ErrQueueDurationLimit is returned when adding a track would push the total
+// duration of the queue past queue.max_queue_duration.
+var ErrQueueDurationLimit = errors.New("The queue has reached its maximum total duration")
+
+// endCheckInterval is how often a track that ends before its file does is
+// checked for having reached its end.
+const endCheckInterval = 250 * time.Millisecond
+
 // Queue holds the audio queue itself along with useful methods for
 // performing actions on the queue.
 type Queue struct {
-	Queue []interfaces.Track
-	mutex sync.RWMutex
+	Queue  []interfaces.Track
+	boosts map[interfaces.Track][]string
+	// seeking is the stream that was stopped to restart the current track
+	// elsewhere, which must not skip the track once it ends.
+	seeking *gumbleffmpeg.Stream
+	// intro is the stream of the intro played before the current track, and
+	// introStopped is set once it has been stopped, which skips the track.
+	intro        *gumbleffmpeg.Stream
+	introStopped bool
+	mutex        sync.RWMutex
 }
 
 func init() {
@@ -35,7 +54,8 @@ func init() {
 // NewQueue initializes a new queue and returns it.
 func NewQueue() *Queue {
 	return &Queue{
-		Queue: make([]interfaces.Track, 0),
+		Queue:  make([]interfaces.Track, 0),
+		boosts: make(map[interfaces.Track][]string),
 	}
 }
 
@@ -51,47 +71,103 @@ func (q *Queue) Length() int {
 func (q *Queue) Reset() {
 	q.mutex.Lock()
 	q.Queue = q.Queue[:0]
+	q.boosts = make(map[interfaces.Track][]string)
 	q.mutex.Unlock()
 }
 
 // AppendTrack adds a track to the back of the queue.
 func (q *Queue) AppendTrack(t interfaces.Track) error {
+	if err := q.appendTrack(t); err != nil {
+		return err
+	}
+	q.startIfNeeded()
+	return nil
+}
+
+// appendTrack adds a track to the back of the queue without starting it.
+func (q *Queue) appendTrack(t interfaces.Track) error {
 	q.mutex.Lock()
+	defer q.mutex.Unlock()
 	beforeLen := len(q.Queue)
 
-	// An error should never occur here since maxTrackDuration is restricted to
-	// ints. Any error in the configuration will be caught during yaml load.
-	maxTrackDuration, _ := time.ParseDuration(fmt.Sprintf("%ds",
-		viper.GetInt("queue.max_track_duration")))
+	if !q.fitsDurationLimit(t) {
+		return ErrQueueDurationLimit
+	}
 
-	if viper.GetInt("queue.max_track_duration") == 0 ||
-		t.GetDuration() <= maxTrackDuration {
+	if fitsTrackDurationLimit(t) {
 		q.Queue = append(q.Queue, t)
 	} else {
-		q.mutex.Unlock()
 		return errors.New("The track is too long to add to the queue")
 	}
 	if len(q.Queue) == beforeLen+1 {
-		q.mutex.Unlock()
-		q.playIfNeeded()
 		return nil
 	}
-	q.mutex.Unlock()
 	return errors.New("Could not add track to queue")
 }
 
+// announcedDuration returns the duration announced when `track` starts
+// playing. Tracks that start part of the way in, such as at the timestamp of a
+// YouTube link, are announced with the time that remains.
+func announcedDuration(track interfaces.Track) string {
+	if track.IsLive() {
+		return viper.GetString("queue.messages.live")
+	}
+	if end := playbackEnd(track); end > track.GetPlaybackOffset() {
+		return (end - track.GetPlaybackOffset()).String()
+	}
+	if offset := track.GetPlaybackOffset(); offset > 0 && offset < track.GetDuration() {
+		return (track.GetDuration() - offset).String()
+	}
+	return track.GetDuration().String()
+}
+
+// fitsTrackDurationLimit checks whether track `t` is no longer than the
+// maximum track duration. A service may be given its own maximum in
+// queue.max_track_duration_overrides, e.g. for services with long recordings.
+func fitsTrackDurationLimit(t interfaces.Track) bool {
+	maxDuration := viper.GetInt("queue.max_track_duration")
+	if key := "queue.max_track_duration_overrides." + strings.ToLower(t.GetService()); viper.IsSet(key) {
+		maxDuration = viper.GetInt(key)
+	}
+	return maxDuration == 0 || t.GetDuration() <= time.Duration(maxDuration)*time.Second
+}
+
+// DurationLimited returns true if the tracks of `service` are subject to a
+// maximum track or queue duration, which cannot be checked for tracks whose
+// duration is unknown.
+func DurationLimited(service string) bool {
+	maxDuration := viper.GetInt("queue.max_track_duration")
+	if key := "queue.max_track_duration_overrides." + strings.ToLower(service); viper.IsSet(key) {
+		maxDuration = viper.GetInt(key)
+	}
+	return maxDuration != 0 || viper.GetInt("queue.max_queue_duration") != 0
+}
+
+// fitsDurationLimit checks whether track `t` can be added without the total
+// duration of the queue exceeding queue.max_queue_duration. The caller must
+// hold the queue mutex.
+func (q *Queue) fitsDurationLimit(t interfaces.Track) bool {
+	if viper.GetInt("queue.max_queue_duration") == 0 {
+		return true
+	}
+	total := t.GetDuration()
+	for _, track := range q.Queue {
+		total += track.GetDuration()
+	}
+	return total <= time.Duration(viper.GetInt("queue.max_queue_duration"))*time.Second
+}
+
 // InsertTrack inserts track `t` at position `i` in the queue.
 func (q *Queue) InsertTrack(i int, t interfaces.Track) error {
 	q.mutex.Lock()
 	beforeLen := len(q.Queue)
 
-	// An error should never occur here since maxTrackDuration is restricted to
-	// ints. Any error in the configuration will be caught during yaml load.
-	maxTrackDuration, _ := time.ParseDuration(fmt.Sprintf("%ds",
-		viper.GetInt("queue.max_track_duration")))
+	if !q.fitsDurationLimit(t) {
+		q.mutex.Unlock()
+		return ErrQueueDurationLimit
+	}
 
-	if viper.GetInt("queue.max_track_duration") == 0 ||
-		t.GetDuration() <= maxTrackDuration {
+	if fitsTrackDurationLimit(t) {
 		q.Queue = append(q.Queue, Track{})
 		copy(q.Queue[i+1:], q.Queue[i:])
 		q.Queue[i] = t
@@ -101,7 +177,7 @@ func (q *Queue) InsertTrack(i int, t interfaces.Track) error {
 	}
 	if len(q.Queue) == beforeLen+1 {
 		q.mutex.Unlock()
-		q.playIfNeeded()
+		q.startIfNeeded()
 		return nil
 	}
 	q.mutex.Unlock()
@@ -163,10 +239,21 @@ func (q *Queue) Traverse(visit func(i int, t interfaces.Track)) {
 
 // ShuffleTracks shuffles the queue using an inside-out algorithm.
 func (q *Queue) ShuffleTracks() {
+	q.shuffle(rand.Intn)
+}
+
+// ShuffleTracksWithSeed shuffles the queue like ShuffleTracks, but the new
+// order depends only on `seed`. Shuffling the same tracks with the same seed
+// always results in the same order.
+func (q *Queue) ShuffleTracksWithSeed(seed int64) {
+	q.shuffle(rand.New(rand.NewSource(seed)).Intn)
+}
+
+func (q *Queue) shuffle(intn func(int) int) {
 	q.mutex.Lock()
 	// Skip the first track, as it is likely playing.
 	for i := range q.Queue[1:] {
-		j := rand.Intn(i + 1)
+		j := intn(i + 1)
 		q.Queue[i+1], q.Queue[j+1] = q.Queue[j+1], q.Queue[i+1]
 	}
 	q.mutex.Unlock()
@@ -186,6 +273,47 @@ func (q *Queue) RandomNextTrack(queueWasEmpty bool) {
 	q.mutex.Unlock()
 }
 
+// Boost records a boost by `booster` for the track at index `i`, which must not
+// be the current track. If boost reordering is enabled, the track moves ahead of
+// tracks with fewer boosts, at most queue.boost_max_jump positions per boost and
+// never ahead of the next track. The new index of the track is returned.
+func (q *Queue) Boost(i int, booster string) (int, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if i < 1 || i >= len(q.Queue) {
+		return i, errors.New("Only queued tracks after the current track may be boosted")
+	}
+	track := q.Queue[i]
+	for _, name := range q.boosts[track] {
+		if name == booster {
+			return i, fmt.Errorf("%s has already boosted this track", booster)
+		}
+	}
+	q.boosts[track] = append(q.boosts[track], booster)
+
+	if !viper.GetBool("queue.boost_reorder") {
+		return i, nil
+	}
+	maxJump := viper.GetInt("queue.boost_max_jump")
+	numBoosts := len(q.boosts[track])
+	j := i
+	for j > 1 && (maxJump == 0 || i-j < maxJump) && len(q.boosts[q.Queue[j-1]]) < numBoosts {
+		j--
+	}
+	copy(q.Queue[j+1:i+1], q.Queue[j:i])
+	q.Queue[j] = track
+	return j, nil
+}
+
+// Boosts returns the number of boosts the track has received.
+func (q *Queue) Boosts(t interfaces.Track) int {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	return len(q.boosts[t])
+}
+
 // Skip performs the necessary actions that take place when a track is skipped
 // via a command.
 func (q *Queue) Skip() {
@@ -196,10 +324,17 @@ func (q *Queue) Skip() {
 
 	// Remove all track skips.
 	DJ.Skips.ResetTrackSkips()
+	DJ.ShuffleVotes.Reset()
 
 	q.mutex.Lock()
-	// If caching is disabled, delete the track from disk.
-	if len(q.Queue) != 0 && !viper.GetBool("cache.enabled") {
+	if len(q.Queue) == 0 {
+		q.mutex.Unlock()
+		return
+	}
+
+	// If caching is disabled, delete the track from disk unless another track,
+	// such as the next chapter of the same video, still needs it.
+	if !viper.GetBool("cache.enabled") && !q.sharesFile(0) {
 		DJ.YouTubeDL.Delete(q.Queue[0])
 	}
 
@@ -231,17 +366,21 @@ func (q *Queue) Skip() {
 	}
 
 	// Skip the track.
+	last := q.Queue[0]
+	delete(q.boosts, last)
 	length := len(q.Queue)
 	if length > 1 {
 		q.Queue = q.Queue[1:]
 	} else {
 		q.Queue = make([]interfaces.Track, 0)
+		DJ.Breaks.Reset()
 	}
 	q.mutex.Unlock()
 
-	if err := q.playIfNeeded(); err != nil {
-		q.Skip()
+	if length == 1 {
+		go DJ.EmptyQueue.Fill(last)
 	}
+	q.startIfNeeded()
 }
 
 // SkipPlaylist performs the necessary actions that take place when a playlist
@@ -266,89 +405,496 @@ func (q *Queue) SkipPlaylist() {
 	q.StopCurrent()
 }
 
-// PlayCurrent creates a new audio stream and begins playing the current track.
+// sharesFile returns true if another track in the queue is played from the
+// same file as the track at index `i`. The caller must hold the queue mutex.
+func (q *Queue) sharesFile(i int) bool {
+	for j, track := range q.Queue {
+		if j != i && track.GetService() == q.Queue[i].GetService() && track.GetFilename() == q.Queue[i].GetFilename() {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplaceUpcoming replaces the tracks after the current one with `tracks` and
+// returns the tracks it replaced. If the queue is empty, the first of
+// `tracks` becomes the current track.
+func (q *Queue) ReplaceUpcoming(tracks []interfaces.Track) []interfaces.Track {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if len(q.Queue) == 0 {
+		q.Queue = append([]interfaces.Track{}, tracks...)
+		return nil
+	}
+	upcoming := append([]interfaces.Track{}, q.Queue[1:]...)
+	q.Queue = append(q.Queue[:1], tracks...)
+	return upcoming
+}
+
+// TakeTrack removes the track at index `i` from the queue and returns it. Its
+// download, if any, is kept, as the track is meant to be queued elsewhere.
+// The current track cannot be taken.
+func (q *Queue) TakeTrack(i int) (interfaces.Track, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if i < 1 || i >= len(q.Queue) {
+		return nil, errors.New("There is no track after the current one at that position")
+	}
+	track := q.Queue[i]
+	q.Queue = append(q.Queue[:i], q.Queue[i+1:]...)
+	delete(q.boosts, track)
+	return track, nil
+}
+
+// MoveTrack moves the track at index `from` to index `to`, shifting the tracks
+// in between, and returns it. Neither may be the current track.
+func (q *Queue) MoveTrack(from, to int) (interfaces.Track, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if from < 1 || from >= len(q.Queue) || to < 1 || to >= len(q.Queue) {
+		return nil, ErrNoTrackAtPosition
+	}
+	track := q.Queue[from]
+	if from < to {
+		copy(q.Queue[from:to], q.Queue[from+1:to+1])
+	} else {
+		copy(q.Queue[to+1:from+1], q.Queue[to:from])
+	}
+	q.Queue[to] = track
+	return track, nil
+}
+
+// RemoveTrackAt removes the track at index `i` from the queue and returns it,
+// if `allowed` is nil or returns true for it. The track is checked while the
+// queue is locked, so that it cannot shift in between. Its download is deleted
+// unless another queued track still needs the file. The current track cannot
+// be removed.
+func (q *Queue) RemoveTrackAt(i int, allowed func(interfaces.Track) bool) (interfaces.Track, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if i < 1 || i >= len(q.Queue) {
+		return nil, ErrNoTrackAtPosition
+	}
+	track := q.Queue[i]
+	if allowed != nil && !allowed(track) {
+		return nil, ErrNotAllowedToRemove
+	}
+	if !q.sharesFile(i) {
+		// The track may have been downloaded ahead of time.
+		DJ.YouTubeDL.Delete(track)
+	}
+	q.Queue = append(q.Queue[:i], q.Queue[i+1:]...)
+	delete(q.boosts, track)
+	return track, nil
+}
+
+// RemoveTrack removes `track` from the queue unless it is the current track.
+// Returns false if it is not queued after the current track.
+func (q *Queue) RemoveTrack(track interfaces.Track) bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for i := 1; i < len(q.Queue); i++ {
+		if q.Queue[i] == track {
+			if !q.sharesFile(i) {
+				// The track may have been downloaded ahead of time.
+				DJ.YouTubeDL.Delete(track)
+			}
+			q.Queue = append(q.Queue[:i], q.Queue[i+1:]...)
+			delete(q.boosts, track)
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveSubmitter removes every queued track submitted by the user `name`,
+// matched case-insensitively, and returns how many were removed. The current
+// track is left in the queue; `current` reports whether it was submitted by
+// the user, in which case the caller decides whether to skip it.
+func (q *Queue) RemoveSubmitter(name string) (removed int, current bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if len(q.Queue) == 0 {
+		return 0, false
+	}
+	current = strings.EqualFold(q.Queue[0].GetSubmitter(), name)
+
+	// We must loop backwards to prevent missing any elements after deletion.
+	for i := len(q.Queue) - 1; i >= 1; i-- {
+		track := q.Queue[i]
+		if !strings.EqualFold(track.GetSubmitter(), name) {
+			continue
+		}
+		if !q.sharesFile(i) {
+			// The track may have been downloaded ahead of time.
+			DJ.YouTubeDL.Delete(track)
+		}
+		q.Queue = append(q.Queue[:i], q.Queue[i+1:]...)
+		delete(q.boosts, track)
+		removed++
+	}
+	return removed, current
+}
+
+// DemoteSubmitter moves every queued track submitted by the user `name`,
+// matched case-insensitively, to the back of the queue while keeping their
+// order. The current track is left in place. Returns how many tracks were
+// moved.
+func (q *Queue) DemoteSubmitter(name string) int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if len(q.Queue) == 0 {
+		return 0
+	}
+	kept := []interfaces.Track{q.Queue[0]}
+	var demoted []interfaces.Track
+	for _, track := range q.Queue[1:] {
+		if strings.EqualFold(track.GetSubmitter(), name) {
+			demoted = append(demoted, track)
+		} else {
+			kept = append(kept, track)
+		}
+	}
+	q.Queue = append(kept, demoted...)
+	return len(demoted)
+}
+
+// PlayCurrent begins playing the current track on the Mumble output and the
+// monitor output.
 func (q *Queue) PlayCurrent() error {
 	currentTrack := q.GetTrack(0)
-	filepath := os.ExpandEnv(viper.GetString("cache.directory") + "/" + currentTrack.GetFilename())
-	if _, err := os.Stat(filepath); os.IsNotExist(err) {
-		if err := DJ.YouTubeDL.Download(q.GetTrack(0)); err != nil {
+	if !currentTrack.IsLive() {
+		// Tracks are usually downloaded ahead of time, but the file may also
+		// have been evicted from the cache while the track was queued.
+		if err := ensureDownloaded(currentTrack); err != nil {
 			return err
 		}
-	}
-	source := gumbleffmpeg.SourceFile(filepath)
-	DJ.AudioStream = gumbleffmpeg.New(DJ.Client, source)
-	DJ.AudioStream.Offset = currentTrack.GetPlaybackOffset()
-	DJ.AudioStream.Volume = DJ.Volume
 
-	if viper.GetString("defaults.player_command") == "avconv" {
-		DJ.AudioStream.Command = "avconv"
+		// Tracks linked to directly are announced with the tags of their file.
+		if tagged, ok := applyTags(currentTrack); ok {
+			currentTrack = tagged
+			q.mutex.Lock()
+			q.Queue[0] = currentTrack
+			q.mutex.Unlock()
+		}
 	}
 
+	DJ.History.Record(currentTrack)
+	DJ.EmptyQueue.Remember(currentTrack)
+	DJ.EmptyQueue.Return()
+
 	if viper.GetBool("queue.announce_new_tracks") {
-		message :=
-			`<table>
+		duration := announcedDuration(currentTrack)
+		message := `<table>`
+		// Leave out the image entirely rather than showing a broken one.
+		if thumbnail := currentTrack.GetThumbnailURL(); thumbnail != "" {
+			message += fmt.Sprintf(`
 			 	<tr>
-					<td align="center"><img src="%s" width=150 /></td>
-				</tr>
+					<td align="center">%s</td>
+				</tr>`, DJ.Thumbnails.ImageTag(thumbnail))
+		}
+		message += fmt.Sprintf(`
 				<tr>
 					<td align="center"><b><a href="%s">%s</a> (%s)</b></td>
 				</tr>
 				<tr>
 					<td align="center">Added by %s</td>
 				</tr>
-			`
-		message = fmt.Sprintf(message, currentTrack.GetThumbnailURL(), currentTrack.GetURL(),
-			currentTrack.GetTitle(), currentTrack.GetDuration().String(), currentTrack.GetSubmitter())
+			`, currentTrack.GetURL(), currentTrack.GetTitle(), duration, currentTrack.GetSubmitter())
 		if currentTrack.GetPlaylist() != nil {
-			message = fmt.Sprintf(message+`<tr><td align="center">From playlist "%s"</td></tr>`, currentTrack.GetPlaylist().GetTitle())
+			message += fmt.Sprintf(`<tr><td align="center">From playlist "%s"</td></tr>`, currentTrack.GetPlaylist().GetTitle())
 		}
 		message += `</table>`
-		DJ.Client.Self.Channel.Send(message, false)
+		if submitter := currentTrack.GetSubmitter(); DJ.PrivateAnnounce.Enabled(submitter) {
+			DJ.SendPrivateMessageToName(submitter, message)
+			DJ.Notify("track_started", submitter, fmt.Sprintf(viper.GetString("queue.messages.now_playing_short"),
+				currentTrack.GetTitle(), duration, submitter))
+		} else {
+			DJ.Notify("track_started", submitter, message)
+		}
+	}
+
+	if note, ok := DJ.History.Note(currentTrack); ok {
+		DJ.Notify("track_note", currentTrack.GetSubmitter(),
+			fmt.Sprintf(viper.GetString("queue.messages.track_note"), html.EscapeString(note.Text), note.Author))
+	}
+
+	if submitter := currentTrack.GetSubmitter(); DJ.Notifications.Enabled(submitter) {
+		DJ.SendPrivateMessageToName(submitter,
+			fmt.Sprintf(DJ.LocalizeFor(submitter, "queue.messages.now_playing"), currentTrack.GetTitle()))
+	}
+
+	if intro, cleanup, ok := DJ.IntroStream(currentTrack); ok {
+		return q.startIntro(intro, cleanup, currentTrack)
+	}
+	return q.startStream(currentTrack)
+}
+
+// startIntro plays `intro` as the current stream, so that it can be paused,
+// stopped and skipped like a track, and starts `currentTrack` once it has
+// finished. Stopping the intro skips the track.
+func (q *Queue) startIntro(intro *gumbleffmpeg.Stream, cleanup func(), currentTrack interfaces.Track) error {
+	q.mutex.Lock()
+	q.intro = intro
+	q.introStopped = false
+	q.mutex.Unlock()
+
+	DJ.AudioStream = intro
+	if err := intro.Play(); err != nil {
+		cleanup()
+		logrus.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Warnln("Could not play the intro.")
+		q.mutex.Lock()
+		q.intro = nil
+		q.mutex.Unlock()
+		return q.startStream(currentTrack)
+	}
+
+	go func() {
+		intro.Wait()
+		cleanup()
+		q.mutex.Lock()
+		stopped := q.introStopped
+		if q.intro == intro {
+			q.intro = nil
+			q.introStopped = false
+		}
+		q.mutex.Unlock()
+
+		// The intro was replaced, such as by a reset, or the bot is
+		// reconnecting and plays the track again later.
+		if DJ.AudioStream != intro || DJ.Reconnect.Offline() {
+			return
+		}
+		if current, err := q.CurrentTrack(); err != nil || current != currentTrack {
+			return
+		}
+		if stopped {
+			q.Skip()
+		} else if err := q.startStream(currentTrack); err != nil {
+			q.skipFailed(err)
+		}
+	}()
+	return nil
+}
+
+// PlayingIntro returns true if the current stream is the intro of the current
+// track rather than the track itself.
+func (q *Queue) PlayingIntro() bool {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+	return q.intro != nil && q.intro == DJ.AudioStream
+}
+
+// Seek restarts the current track `offset` into it.
+func (q *Queue) Seek(offset time.Duration) error {
+	currentTrack, err := q.CurrentTrack()
+	if err != nil {
+		return err
+	}
+	if currentTrack.IsLive() {
+		return errors.New("Live tracks cannot be played from another position")
+	}
+	stream := DJ.AudioStream
+	if stream == nil {
+		return errors.New("The current track is not playing")
+	}
+
+	q.mutex.Lock()
+	q.seeking = stream
+	q.mutex.Unlock()
+	q.StopCurrent()
+	q.setCurrentOffset(offset)
+	return q.startStream(q.GetTrack(0))
+}
+
+// ensureDownloaded downloads `track` if its file is missing, which is also
+// the case if the file was evicted from the cache after it was downloaded.
+// Local files cannot be downloaded again, so an error is returned if one has
+// been removed.
+func ensureDownloaded(track interfaces.Track) error {
+	if track.IsLive() {
+		return nil
+	}
+	filepath := trackPath(track)
+	if _, err := os.Stat(filepath); !os.IsNotExist(err) {
+		return nil
+	}
+	if track.GetService() == LocalService {
+		return fmt.Errorf("The file %s no longer exists", filepath)
 	}
+	if DJ.YouTubeDL.Evicted(track) {
+		logrus.WithFields(logrus.Fields{
+			"title":    track.GetTitle(),
+			"filename": track.GetFilename(),
+		}).Warnln("The file of a queued track was removed from the cache. Downloading it again...")
+	}
+	return DJ.YouTubeDL.Download(track)
+}
 
-	DJ.AudioStream.Play()
+// startStream plays `currentTrack` from its playback offset on the Mumble
+// output and the monitor output, and moves on to the next track once it ends.
+func (q *Queue) startStream(currentTrack interfaces.Track) error {
+	// The file of a track that is played again from another position may have
+	// been evicted from the cache since it started.
+	if err := ensureDownloaded(currentTrack); err != nil {
+		return err
+	}
+	if err := DJ.Output.Play(currentTrack, currentTrack.GetPlaybackOffset()); err != nil {
+		return err
+	}
+	if err := DJ.Monitor.Play(currentTrack, currentTrack.GetPlaybackOffset()); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"device": DJ.Monitor.Device(),
+			"error":  err.Error(),
+		}).Warnln("Could not play the current track on the monitor output.")
+	}
+
+	DJ.Radio.Watch(currentTrack)
+
+	stream := DJ.AudioStream
+	if end := playbackEnd(currentTrack); end > currentTrack.GetPlaybackOffset() {
+		go q.stopAfter(stream, end-currentTrack.GetPlaybackOffset())
+	}
+	go q.skipSegments(stream, currentTrack)
 	go func() {
-		DJ.AudioStream.Wait()
+		stream.Wait()
+		DJ.Radio.Stop()
+		// The track was stopped by a disconnection and is played again later.
+		if DJ.Reconnect.Offline() {
+			return
+		}
+		// The track was restarted at another position.
+		q.mutex.Lock()
+		seeked := q.seeking == stream
+		if seeked {
+			q.seeking = nil
+		}
+		q.mutex.Unlock()
+		if seeked {
+			return
+		}
+		DJ.Breaks.TakeBreakIfDue(stream.Elapsed())
+		DJ.VoteWindow.Hold()
+		// The queue may have been reset or moved on while the window was open.
+		if current, err := q.CurrentTrack(); err != nil || current != currentTrack {
+			return
+		}
 		q.Skip()
 	}()
 
 	return nil
 }
 
+// stopAfter stops `stream` once it has played `length` of audio, for tracks
+// that end before their file does. Time spent paused does not count.
+func (q *Queue) stopAfter(stream *gumbleffmpeg.Stream, length time.Duration) {
+	for stream.State() != gumbleffmpeg.StateStopped {
+		if stream.Elapsed() >= length {
+			if DJ.AudioStream == stream {
+				q.StopCurrent()
+			} else {
+				stream.Stop()
+			}
+			return
+		}
+		time.Sleep(endCheckInterval)
+	}
+}
+
+// skipSegments skips the SponsorBlock segments of `track` while `stream` plays
+// it, by playing the track again from the end of each segment it reaches. A
+// segment that lasts until the end of the track ends it.
+func (q *Queue) skipSegments(stream *gumbleffmpeg.Stream, track interfaces.Track) {
+	segments := DJ.SponsorBlock.Segments(track)
+	if len(segments) == 0 {
+		return
+	}
+	end := playbackEnd(track)
+	if end == 0 {
+		end = track.GetDuration()
+	}
+	for stream.State() != gumbleffmpeg.StateStopped {
+		segment, ok := segmentAt(segments, track.GetPlaybackOffset()+stream.Elapsed())
+		if !ok {
+			time.Sleep(endCheckInterval)
+			continue
+		}
+		if DJ.AudioStream != stream {
+			return
+		}
+		logrus.WithFields(logrus.Fields{
+			"title":    track.GetTitle(),
+			"category": segment.Category,
+			"start":    segment.Start.String(),
+			"end":      segment.End.String(),
+		}).Infoln("Skipping a SponsorBlock segment...")
+		if end > 0 && segment.End >= end-minSegmentLength {
+			q.StopCurrent()
+		} else if err := q.Seek(segment.End); err != nil {
+			logrus.WithFields(ErrorFields(err)).Warnln("Could not skip a SponsorBlock segment.")
+		}
+		return
+	}
+}
+
 // PauseCurrent pauses the current audio stream if it exists and is not already paused.
 func (q *Queue) PauseCurrent() error {
-	if DJ.AudioStream == nil {
-		return errors.New("There is no track to pause")
+	if err := DJ.Output.Pause(); err != nil {
+		return err
 	}
-	if DJ.AudioStream.State() == gumbleffmpeg.StatePaused {
-		return errors.New("The track is already paused")
-	}
-	DJ.AudioStream.Pause()
-	return nil
+	return DJ.Monitor.Pause()
 }
 
 // ResumeCurrent resumes playback of the current audio stream if it exists and is paused.
 func (q *Queue) ResumeCurrent() error {
-	if DJ.AudioStream == nil {
-		return errors.New("There is no track to resume")
-	}
-	if DJ.AudioStream.State() == gumbleffmpeg.StatePlaying {
-		return errors.New("The track is already playing")
+	if err := DJ.Output.Resume(); err != nil {
+		return err
 	}
-	DJ.AudioStream.Play()
-	return nil
+	return DJ.Monitor.Resume()
 }
 
 // StopCurrent stops the playback of the current audio stream if it exists.
 func (q *Queue) StopCurrent() error {
-	if DJ.AudioStream == nil {
-		return errors.New("The audio stream is nil")
+	q.mutex.Lock()
+	if q.intro != nil && q.intro == DJ.AudioStream {
+		q.introStopped = true
+	}
+	q.mutex.Unlock()
+	DJ.Monitor.Stop()
+	return DJ.Output.Stop()
+}
+
+// setCurrentOffset sets the position that the current track starts from the
+// next time it is played.
+func (q *Queue) setCurrentOffset(offset time.Duration) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if len(q.Queue) == 0 {
+		return
+	}
+	switch track := q.Queue[0].(type) {
+	case Track:
+		track.PlaybackOffset = offset
+		q.Queue[0] = track
+	case *Track:
+		track.PlaybackOffset = offset
 	}
-	DJ.AudioStream.Stop()
-	return nil
 }
 
 func (q *Queue) playIfNeeded() error {
+	// Nothing is started while the music is on hold or the bot is reconnecting.
+	if DJ.Hold.Active() || DJ.Reconnect.Offline() {
+		return nil
+	}
 	if DJ.AudioStream == nil && q.Length() > 0 {
 		if err := DJ.YouTubeDL.Download(q.GetTrack(0)); err != nil {
 			return err
@@ -359,3 +905,22 @@ func (q *Queue) playIfNeeded() error {
 	}
 	return nil
 }
+
+// startIfNeeded begins playback of the current track if nothing is playing. If
+// the track cannot be played, its submitter is told why and the track is skipped.
+func (q *Queue) startIfNeeded() {
+	if err := q.playIfNeeded(); err != nil {
+		q.skipFailed(err)
+	}
+}
+
+// skipFailed tells the submitter of the current track that it could not be
+// played because of `err`, and skips it.
+func (q *Queue) skipFailed(err error) {
+	logrus.WithFields(ErrorFields(err)).Warnln("An error occurred while starting the next track. Skipping it...")
+	if track := q.GetTrack(0); track != nil {
+		DJ.Notify("track_failed", track.GetSubmitter(),
+			fmt.Sprintf(DJ.LocalizeFor(track.GetSubmitter(), "queue.messages.track_failed"), track.GetTitle(), err.Error()))
+	}
+	q.Skip()
+}
@@ -9,7 +9,9 @@ package bot
 
 import (
 	"fmt"
+	"io/ioutil"
 	"math/rand"
+	"os"
 	"testing"
 	"time"
 
@@ -43,6 +45,9 @@ func (suite *QueueTestSuite) SetupSuite() {
 func (suite *QueueTestSuite) SetupTest() {
 	DJ.Queue = NewQueue()
 	viper.Set("queue.max_track_duration", 0)
+	viper.Set("queue.max_queue_duration", 0)
+	viper.Set("queue.boost_reorder", false)
+	viper.Set("queue.boost_max_jump", 3)
 
 	// Override the initialized seed for consistent test results.
 	rand.Seed(1)
@@ -78,6 +83,85 @@ func (suite *QueueTestSuite) TestAppendTrackWhenTrackIsTooLong() {
 	suite.NotNil(err, "An error should be returned due to the track being too long.")
 }
 
+func (suite *QueueTestSuite) TestAppendTrackWithServiceDurationOverride() {
+	viper.Set("queue.max_track_duration", 5)
+	viper.Set("queue.max_track_duration_overrides.twitch", 0)
+
+	longTrack := &Track{Service: "Twitch", Duration: 6 * time.Second}
+
+	err := DJ.Queue.AppendTrack(longTrack)
+
+	suite.Nil(err, "The service's own maximum duration should be used instead.")
+	suite.Equal(1, DJ.Queue.Length())
+}
+
+func (suite *QueueTestSuite) TestAppendTrackWhenQueueDurationLimitIsReached() {
+	viper.Set("queue.max_queue_duration", 10)
+
+	DJ.Queue.AppendTrack(&Track{Duration: 6 * time.Second})
+	err := DJ.Queue.AppendTrack(&Track{Duration: 5 * time.Second})
+
+	suite.Equal(1, DJ.Queue.Length(), "The second track should not have been added.")
+	suite.Equal(ErrQueueDurationLimit, err, "The queue duration limit error should be returned.")
+
+	err = DJ.Queue.AppendTrack(&Track{Duration: 4 * time.Second})
+
+	suite.Equal(2, DJ.Queue.Length(), "A track that fits within the limit should be added.")
+	suite.Nil(err, "No error should be returned.")
+}
+
+func (suite *QueueTestSuite) TestBoostWithoutReorder() {
+	DJ.Queue.AppendTrack(suite.FirstTrack)
+	DJ.Queue.AppendTrack(suite.SecondTrack)
+	DJ.Queue.AppendTrack(suite.ThirdTrack)
+
+	index, err := DJ.Queue.Boost(2, "test")
+
+	suite.Nil(err, "No error should be returned.")
+	suite.Equal(2, index, "The track should not move when reordering is disabled.")
+	suite.Equal(1, DJ.Queue.Boosts(suite.ThirdTrack))
+}
+
+func (suite *QueueTestSuite) TestBoostTwiceBySameUser() {
+	DJ.Queue.AppendTrack(suite.FirstTrack)
+	DJ.Queue.AppendTrack(suite.SecondTrack)
+	DJ.Queue.Boost(1, "test")
+
+	_, err := DJ.Queue.Boost(1, "test")
+
+	suite.NotNil(err, "A user should not be able to boost the same track twice.")
+	suite.Equal(1, DJ.Queue.Boosts(suite.SecondTrack))
+}
+
+func (suite *QueueTestSuite) TestBoostCurrentTrack() {
+	DJ.Queue.AppendTrack(suite.FirstTrack)
+
+	_, err := DJ.Queue.Boost(0, "test")
+
+	suite.NotNil(err, "The current track should not be boostable.")
+}
+
+func (suite *QueueTestSuite) TestBoostWithReorderRespectsMaxJump() {
+	viper.Set("queue.boost_reorder", true)
+	viper.Set("queue.boost_max_jump", 2)
+	tracks := []*Track{{ID: "0"}, {ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}}
+	for _, track := range tracks {
+		DJ.Queue.AppendTrack(track)
+	}
+
+	index, err := DJ.Queue.Boost(4, "test")
+
+	suite.Nil(err, "No error should be returned.")
+	suite.Equal(2, index, "The track should move at most two positions.")
+	suite.Equal("4", DJ.Queue.GetTrack(2).GetID())
+	suite.Equal("2", DJ.Queue.GetTrack(3).GetID())
+
+	index, _ = DJ.Queue.Boost(2, "other")
+
+	suite.Equal(1, index, "The track should never move ahead of the next track.")
+	suite.Equal("0", DJ.Queue.GetTrack(0).GetID(), "The current track should not move.")
+}
+
 func (suite *QueueTestSuite) TestCurrentTrackWhenOneExists() {
 	DJ.Queue.AppendTrack(suite.FirstTrack)
 
@@ -168,6 +252,31 @@ func (suite *QueueTestSuite) TestShuffleTracks() {
 	suite.NotEqual(originalSecondTrack, DJ.Queue.GetTrack(1), "The shuffled queue should not be the same as the original queue.")
 }
 
+func (suite *QueueTestSuite) TestShuffleTracksWithSeedIsReproducible() {
+	for i := 0; i < 10; i++ {
+		DJ.Queue.AppendTrack(&Track{ID: fmt.Sprintf("%d", i+1)})
+	}
+	original := make([]interfaces.Track, 0)
+	DJ.Queue.Traverse(func(i int, t interfaces.Track) {
+		original = append(original, t)
+	})
+
+	DJ.Queue.ShuffleTracksWithSeed(1234)
+	shuffled := make([]interfaces.Track, 0)
+	DJ.Queue.Traverse(func(i int, t interfaces.Track) {
+		shuffled = append(shuffled, t)
+	})
+
+	DJ.Queue.(*Queue).Queue = append([]interfaces.Track{}, original...)
+	DJ.Queue.ShuffleTracksWithSeed(1234)
+
+	suite.Equal(original[0], DJ.Queue.GetTrack(0), "The current track should not be shuffled.")
+	for i, track := range shuffled {
+		suite.Equal(track, DJ.Queue.GetTrack(i), "Shuffling with the same seed should result in the same order.")
+	}
+	suite.NotEqual(original[1:], shuffled[1:], "The queue should have been shuffled.")
+}
+
 func (suite *QueueTestSuite) TestRandomNextTrackWhenQueueWasEmpty() {
 	DJ.Queue.AppendTrack(suite.FirstTrack)
 
@@ -206,6 +315,56 @@ func (suite *QueueTestSuite) TestRandomNextTrackWhenQueueWasNotEmpty() {
 	suite.NotEqual(suite.SecondTrack, DJ.Queue.GetTrack(1), "The next track should be randomized.")
 }
 
+func (suite *QueueTestSuite) TestRemoveSubmitter() {
+	DJ.Queue.AppendTrack(&Track{ID: "a", Submitter: "Alice"})
+	DJ.Queue.AppendTrack(&Track{ID: "b", Submitter: "Bob"})
+	DJ.Queue.AppendTrack(&Track{ID: "c", Submitter: "bob"})
+	DJ.Queue.AppendTrack(&Track{ID: "d", Submitter: "Alice"})
+	DJ.Queue.AppendTrack(&Track{ID: "e", Submitter: "Bob"})
+
+	removed, current := DJ.Queue.RemoveSubmitter("BOB")
+
+	suite.Equal(3, removed, "All of the user's queued tracks should be removed regardless of case.")
+	suite.False(current, "The current track was not added by the user.")
+	suite.Equal(2, DJ.Queue.Length())
+	suite.Equal("a", DJ.Queue.GetTrack(0).GetID())
+	suite.Equal("d", DJ.Queue.GetTrack(1).GetID())
+}
+
+func (suite *QueueTestSuite) TestRemoveSubmitterKeepsCurrentTrack() {
+	DJ.Queue.AppendTrack(&Track{ID: "a", Submitter: "Bob"})
+	DJ.Queue.AppendTrack(&Track{ID: "b", Submitter: "Bob"})
+
+	removed, current := DJ.Queue.RemoveSubmitter("Bob")
+
+	suite.Equal(1, removed)
+	suite.True(current, "The current track was added by the user.")
+	suite.Equal(1, DJ.Queue.Length(), "The current track should be left for the caller to skip.")
+}
+
+func (suite *QueueTestSuite) TestRemoveSubmitterKeepsSharedFile() {
+	path, restore := suite.downloadedFile("shared.track")
+	defer restore()
+	DJ.Queue.AppendTrack(suite.FirstTrack)
+	DJ.Queue.AppendTrack(&Track{ID: "shared", Service: "YouTube", Filename: "shared.track", Submitter: "Alice"})
+	DJ.Queue.AppendTrack(&Track{ID: "shared", Service: "YouTube", Filename: "shared.track", Submitter: "Bob"})
+
+	DJ.Queue.RemoveSubmitter("Bob")
+
+	_, err := os.Stat(path)
+	suite.Nil(err, "The file of a track that is still queued should be kept.")
+	DJ.Queue.RemoveSubmitter("Alice")
+	_, err = os.Stat(path)
+	suite.True(os.IsNotExist(err), "The file should be deleted with the last track using it.")
+}
+
+func (suite *QueueTestSuite) TestRemoveSubmitterWhenQueueIsEmpty() {
+	removed, current := DJ.Queue.RemoveSubmitter("Bob")
+
+	suite.Zero(removed)
+	suite.False(current)
+}
+
 // TODO: Fix these tests.
 /*func (suite *QueueTestSuite) TestSkipWhenQueueHasLessThanTwoTracks() {
 	DJ.Queue.AppendTrack(suite.FirstTrack)
@@ -271,6 +430,192 @@ func (suite *QueueTestSuite) TestSkipPlaylistWhenPlaylistIsShuffled() {
 	suite.Equal(2, DJ.Queue.Length(), "There should be two tracks remaining in the queue.")
 }*/
 
+func (suite *QueueTestSuite) TestRemoveTrack() {
+	DJ.Queue.AppendTrack(suite.FirstTrack)
+	DJ.Queue.AppendTrack(suite.SecondTrack)
+	DJ.Queue.AppendTrack(suite.ThirdTrack)
+
+	suite.False(DJ.Queue.(*Queue).RemoveTrack(suite.FirstTrack), "The current track should not be removed.")
+	suite.True(DJ.Queue.(*Queue).RemoveTrack(suite.SecondTrack))
+	suite.Equal(2, DJ.Queue.Length())
+	suite.Equal(suite.ThirdTrack, DJ.Queue.GetTrack(1))
+}
+
+func (suite *QueueTestSuite) TestRemoveTrackKeepsSharedFile() {
+	path, restore := suite.downloadedFile("shared.track")
+	defer restore()
+	duplicate := &Track{ID: "shared", Service: "YouTube", Filename: "shared.track"}
+	DJ.Queue.AppendTrack(suite.FirstTrack)
+	DJ.Queue.AppendTrack(&Track{ID: "shared", Service: "YouTube", Filename: "shared.track"})
+	DJ.Queue.AppendTrack(duplicate)
+
+	suite.True(DJ.Queue.(*Queue).RemoveTrack(duplicate))
+	_, err := os.Stat(path)
+	suite.Nil(err, "The file of a track that is still queued should be kept.")
+}
+
+func (suite *QueueTestSuite) TestMoveTrackForward() {
+	fourthTrack := &Track{ID: "fourth"}
+	DJ.Queue.AppendTrack(suite.FirstTrack)
+	DJ.Queue.AppendTrack(suite.SecondTrack)
+	DJ.Queue.AppendTrack(suite.ThirdTrack)
+	DJ.Queue.AppendTrack(fourthTrack)
+
+	moved, err := DJ.Queue.MoveTrack(3, 1)
+	suite.Nil(err)
+	suite.Equal(fourthTrack, moved)
+
+	suite.Equal(suite.FirstTrack, DJ.Queue.GetTrack(0))
+	suite.Equal(fourthTrack, DJ.Queue.GetTrack(1))
+	suite.Equal(suite.SecondTrack, DJ.Queue.GetTrack(2))
+	suite.Equal(suite.ThirdTrack, DJ.Queue.GetTrack(3))
+}
+
+func (suite *QueueTestSuite) TestMoveTrackBack() {
+	fourthTrack := &Track{ID: "fourth"}
+	DJ.Queue.AppendTrack(suite.FirstTrack)
+	DJ.Queue.AppendTrack(suite.SecondTrack)
+	DJ.Queue.AppendTrack(suite.ThirdTrack)
+	DJ.Queue.AppendTrack(fourthTrack)
+
+	moved, err := DJ.Queue.MoveTrack(1, 3)
+	suite.Nil(err)
+	suite.Equal(suite.SecondTrack, moved)
+
+	suite.Equal(suite.ThirdTrack, DJ.Queue.GetTrack(1))
+	suite.Equal(fourthTrack, DJ.Queue.GetTrack(2))
+	suite.Equal(suite.SecondTrack, DJ.Queue.GetTrack(3))
+}
+
+func (suite *QueueTestSuite) TestMoveTrackOutOfRange() {
+	DJ.Queue.AppendTrack(suite.FirstTrack)
+	DJ.Queue.AppendTrack(suite.SecondTrack)
+
+	_, err := DJ.Queue.MoveTrack(0, 1)
+	suite.Equal(ErrNoTrackAtPosition, err, "The current track should not be moved.")
+	_, err = DJ.Queue.MoveTrack(1, 0)
+	suite.Equal(ErrNoTrackAtPosition, err, "No track should be moved ahead of the current track.")
+	_, err = DJ.Queue.MoveTrack(1, 2)
+	suite.Equal(ErrNoTrackAtPosition, err)
+	suite.Equal(suite.SecondTrack, DJ.Queue.GetTrack(1))
+}
+
+func (suite *QueueTestSuite) TestRemoveTrackAt() {
+	DJ.Queue.AppendTrack(suite.FirstTrack)
+	DJ.Queue.AppendTrack(suite.SecondTrack)
+	DJ.Queue.AppendTrack(suite.ThirdTrack)
+
+	track, err := DJ.Queue.RemoveTrackAt(1, nil)
+
+	suite.Nil(err)
+	suite.Equal(suite.SecondTrack, track)
+	suite.Equal(2, DJ.Queue.Length())
+	suite.Equal(suite.ThirdTrack, DJ.Queue.GetTrack(1))
+}
+
+func (suite *QueueTestSuite) TestRemoveTrackAtWhenNotAllowed() {
+	DJ.Queue.AppendTrack(suite.FirstTrack)
+	DJ.Queue.AppendTrack(&Track{ID: "theirs", Submitter: "Alice"})
+
+	_, err := DJ.Queue.RemoveTrackAt(1, func(track interfaces.Track) bool {
+		return track.GetSubmitter() == "Bob"
+	})
+
+	suite.Equal(ErrNotAllowedToRemove, err)
+	suite.Equal(2, DJ.Queue.Length())
+}
+
+func (suite *QueueTestSuite) TestRemoveTrackAtOutOfRange() {
+	DJ.Queue.AppendTrack(suite.FirstTrack)
+	DJ.Queue.AppendTrack(suite.SecondTrack)
+
+	_, err := DJ.Queue.RemoveTrackAt(0, nil)
+	suite.Equal(ErrNoTrackAtPosition, err, "The current track should not be removed.")
+	_, err = DJ.Queue.RemoveTrackAt(2, nil)
+	suite.Equal(ErrNoTrackAtPosition, err)
+	suite.Equal(2, DJ.Queue.Length())
+}
+
+func (suite *QueueTestSuite) TestDurationLimited() {
+	suite.False(DurationLimited("Direct"))
+
+	viper.Set("queue.max_queue_duration", 3600)
+	suite.True(DurationLimited("Direct"))
+
+	viper.Set("queue.max_queue_duration", 0)
+	viper.Set("queue.max_track_duration", 600)
+	viper.Set("queue.max_track_duration_overrides.direct", 0)
+	defer viper.Set("queue.max_track_duration_overrides.direct", nil)
+	suite.False(DurationLimited("Direct"), "The override lifts the limit for the service.")
+	suite.True(DurationLimited("YouTube"))
+}
+
+func (suite *QueueTestSuite) TestSkipWhenQueueIsEmpty() {
+	suite.NotPanics(func() { DJ.Queue.Skip() }, "The queue may be reset while a vote window is open.")
+	suite.Zero(DJ.Queue.Length())
+
+	// Skip clears the audio stream, which the other tests rely on.
+	DJ.AudioStream = new(gumbleffmpeg.Stream)
+}
+
+func (suite *QueueTestSuite) TestAnnouncedDurationIsWhatRemains() {
+	track := Track{Duration: 3 * time.Minute, PlaybackOffset: 90 * time.Second}
+
+	suite.Equal("1m30s", announcedDuration(track))
+
+	track.PlaybackOffset = 5 * time.Minute
+	suite.Equal("3m0s", announcedDuration(track), "An offset past the end should be ignored.")
+}
+
+func (suite *QueueTestSuite) TestEnsureDownloadedSkipsLiveAndCachedTracks() {
+	suite.Nil(ensureDownloaded(&Track{ID: "live", Live: true}))
+
+	file, _ := ioutil.TempFile("", "mumbledj-track")
+	defer os.Remove(file.Name())
+	suite.Nil(ensureDownloaded(&Track{ID: "local", Service: LocalService, Filename: file.Name()}))
+}
+
+func (suite *QueueTestSuite) TestEnsureDownloadedWhenLocalFileIsMissing() {
+	track := &Track{ID: "local", Service: LocalService, Filename: "/nonexistent/track.mp3"}
+
+	suite.NotNil(ensureDownloaded(track), "A removed local file cannot be downloaded again.")
+}
+
+func (suite *QueueTestSuite) TestStopCurrentStopsIntro() {
+	playing := DJ.AudioStream
+	defer func() { DJ.AudioStream = playing }()
+	queue := DJ.Queue.(*Queue)
+	suite.False(queue.PlayingIntro())
+
+	intro := gumbleffmpeg.New(nil, gumbleffmpeg.SourceFile("intro.wav"))
+	queue.intro = intro
+	DJ.AudioStream = intro
+	suite.True(queue.PlayingIntro())
+
+	queue.StopCurrent()
+
+	suite.True(queue.introStopped, "Stopping the intro should skip the track it announces.")
+}
+
+// downloadedFile creates a downloaded file named `name` in a temporary cache
+// directory, with caching disabled so that the file is deleted along with the
+// tracks using it. It returns the path of the file and a function that
+// restores the cache settings.
+func (suite *QueueTestSuite) downloadedFile(name string) (string, func()) {
+	directory, err := ioutil.TempDir("", "mumbledj")
+	suite.Require().Nil(err)
+	cacheEnabled, cacheDirectory := viper.GetBool("cache.enabled"), viper.GetString("cache.directory")
+	viper.Set("cache.enabled", false)
+	viper.Set("cache.directory", directory)
+	path := directory + "/" + name
+	suite.Require().Nil(ioutil.WriteFile(path, []byte("audio"), 0644))
+	return path, func() {
+		viper.Set("cache.enabled", cacheEnabled)
+		viper.Set("cache.directory", cacheDirectory)
+		os.RemoveAll(directory)
+	}
+}
+
 func TestQueueTestSuite(t *testing.T) {
 	suite.Run(t, new(QueueTestSuite))
 }
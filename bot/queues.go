@@ -0,0 +1,215 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/queues.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+var (
+	// ErrQueueActive is returned when switching to the queue that is already
+	// playing.
+	ErrQueueActive = errors.New("The queue is already playing")
+
+	// ErrSameQueue is returned when a track is moved to the queue it is in.
+	ErrSameQueue = errors.New("The track is already in that queue")
+
+	// ErrUnknownQueue is returned when a queue that does not exist is named.
+	ErrUnknownQueue = errors.New("There is no queue with that name")
+
+	// ErrNoTrackAtPosition is returned when a queue has no track that can be
+	// moved or removed at the given position.
+	ErrNoTrackAtPosition = errors.New("There is no track that can be moved or removed at that position")
+
+	// ErrNotAllowedToRemove is returned when a user may not remove a track,
+	// such as one that somebody else submitted.
+	ErrNotAllowedToRemove = errors.New("The track cannot be removed by this user")
+)
+
+// Queues holds named queues, such as "main", "chill" and "requests", so that
+// hosts can curate several sets during one event. The active queue is the
+// one in DJ.Queue, which feeds playback; the upcoming tracks of the others are
+// set aside here until they are switched to.
+type Queues struct {
+	active  string
+	standby map[string][]interfaces.Track
+	mutex   sync.Mutex
+}
+
+// NewQueues returns a Queues in which only the default queue exists.
+func NewQueues() *Queues {
+	return &Queues{
+		standby: make(map[string][]interfaces.Track),
+	}
+}
+
+// Active returns the name of the queue that feeds playback.
+func (qs *Queues) Active() string {
+	qs.mutex.Lock()
+	defer qs.mutex.Unlock()
+	return qs.activeName()
+}
+
+// Names returns the names of the queues, with the active queue first and the
+// others in alphabetical order.
+func (qs *Queues) Names() []string {
+	qs.mutex.Lock()
+	defer qs.mutex.Unlock()
+
+	var names []string
+	for name := range qs.standby {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return append([]string{qs.activeName()}, names...)
+}
+
+// Length returns the number of tracks in the queue `name`. The current track
+// is not counted for the active queue.
+func (qs *Queues) Length(name string) int {
+	qs.mutex.Lock()
+	defer qs.mutex.Unlock()
+
+	if normalizeQueueName(name) == qs.activeName() {
+		if length := DJ.Queue.Length(); length > 1 {
+			return length - 1
+		}
+		return 0
+	}
+	return len(qs.standby[normalizeQueueName(name)])
+}
+
+// Use makes the queue `name` feed playback, creating it if it does not exist.
+// The current track keeps playing, and the tracks that were coming up are set
+// aside under the name of the previously active queue. Returns the number of
+// tracks now coming up.
+func (qs *Queues) Use(name string) (int, error) {
+	name = normalizeQueueName(name)
+	qs.mutex.Lock()
+	active := qs.activeName()
+	if name == active {
+		qs.mutex.Unlock()
+		return 0, ErrQueueActive
+	}
+	queue, ok := DJ.Queue.(*Queue)
+	if !ok {
+		qs.mutex.Unlock()
+		return 0, errors.New("The queue cannot be switched")
+	}
+
+	tracks := qs.standby[name]
+	delete(qs.standby, name)
+	if upcoming := queue.ReplaceUpcoming(tracks); len(upcoming) > 0 {
+		qs.standby[active] = upcoming
+	}
+	qs.active = name
+	qs.mutex.Unlock()
+
+	logrus.WithFields(logrus.Fields{
+		"queue":      name,
+		"num_tracks": len(tracks),
+	}).Infoln("Switched the active queue.")
+	// Nothing may have been playing if the previous queue had run out.
+	queue.startIfNeeded()
+	return len(tracks), nil
+}
+
+// Move moves the track at `position` in the queue `from` to the end of the
+// queue `to`, which is created if it does not exist, and returns the track.
+// Positions are numbered from 1 as listed by the listtracks command, so the
+// current track, at position 1 of the active queue, cannot be moved.
+func (qs *Queues) Move(from string, position int, to string) (interfaces.Track, error) {
+	from, to = normalizeQueueName(from), normalizeQueueName(to)
+	qs.mutex.Lock()
+	track, queue, err := qs.move(from, position, to)
+	qs.mutex.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	// The track may have been moved to an active queue that had run out.
+	if queue != nil {
+		queue.startIfNeeded()
+	}
+	return track, nil
+}
+
+// move moves a track like Move, and returns the active queue if the track was
+// added to it. The caller must hold the mutex.
+func (qs *Queues) move(from string, position int, to string) (interfaces.Track, *Queue, error) {
+	active := qs.activeName()
+	if from == "" {
+		from = active
+	}
+	if from == to {
+		return nil, nil, ErrSameQueue
+	}
+	queue, ok := DJ.Queue.(*Queue)
+	if !ok {
+		return nil, nil, errors.New("The queue cannot be changed")
+	}
+
+	var track interfaces.Track
+	if from == active {
+		taken, err := queue.TakeTrack(position - 1)
+		if err != nil {
+			return nil, nil, ErrNoTrackAtPosition
+		}
+		track = taken
+	} else {
+		tracks, ok := qs.standby[from]
+		if !ok {
+			return nil, nil, ErrUnknownQueue
+		}
+		if position < 1 || position > len(tracks) {
+			return nil, nil, ErrNoTrackAtPosition
+		}
+		track = tracks[position-1]
+		qs.standby[from] = append(tracks[:position-1:position-1], tracks[position:]...)
+		if len(qs.standby[from]) == 0 {
+			delete(qs.standby, from)
+		}
+	}
+
+	if to != active {
+		qs.standby[to] = append(qs.standby[to], track)
+		return track, nil, nil
+	}
+	// The track is started once the mutex has been released, as starting it
+	// may download it first.
+	if err := queue.appendTrack(track); err != nil {
+		// The track goes back where it came from.
+		qs.standby[from] = append(qs.standby[from], nil)
+		copy(qs.standby[from][position:], qs.standby[from][position-1:])
+		qs.standby[from][position-1] = track
+		return nil, nil, err
+	}
+	return track, queue, nil
+}
+
+// activeName returns the name of the active queue. The caller must hold the
+// mutex.
+func (qs *Queues) activeName() string {
+	if qs.active == "" {
+		return normalizeQueueName(viper.GetString("queue.default_queue_name"))
+	}
+	return qs.active
+}
+
+// normalizeQueueName returns `name` as it is stored, so that queue names are
+// matched regardless of case.
+func normalizeQueueName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
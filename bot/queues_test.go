@@ -0,0 +1,93 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/queues_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/layeh/gumble/gumbleffmpeg"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type QueuesTestSuite struct {
+	suite.Suite
+	Current, First, Second *Track
+}
+
+func (suite *QueuesTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+	// Trick the queue into thinking audio is already playing.
+	DJ.AudioStream = new(gumbleffmpeg.Stream)
+	viper.Set("queue.default_queue_name", "main")
+	viper.Set("queue.automatic_shuffle_on", false)
+	viper.Set("queue.max_track_duration", 0)
+	viper.Set("queue.max_queue_duration", 0)
+
+	suite.Current = &Track{ID: "current"}
+	suite.First = &Track{ID: "first"}
+	suite.Second = &Track{ID: "second"}
+	DJ.Queue.AppendTrack(suite.Current)
+	DJ.Queue.AppendTrack(suite.First)
+	DJ.Queue.AppendTrack(suite.Second)
+}
+
+func (suite *QueuesTestSuite) TestUseSetsUpcomingTracksAside() {
+	upcoming, err := DJ.Queues.Use("Chill")
+
+	suite.Nil(err)
+	suite.Zero(upcoming)
+	suite.Equal("chill", DJ.Queues.Active())
+	suite.Equal(1, DJ.Queue.Length(), "Only the current track should be left.")
+	suite.Equal([]string{"chill", "main"}, DJ.Queues.Names())
+	suite.Equal(2, DJ.Queues.Length("main"))
+
+	upcoming, err = DJ.Queues.Use("main")
+
+	suite.Nil(err)
+	suite.Equal(2, upcoming)
+	suite.Equal([]interfaces.Track{suite.Current, suite.First, suite.Second}, DJ.Queue.(*Queue).Queue)
+}
+
+func (suite *QueuesTestSuite) TestUseActiveQueue() {
+	_, err := DJ.Queues.Use("MAIN")
+
+	suite.Equal(ErrQueueActive, err)
+}
+
+func (suite *QueuesTestSuite) TestMoveBetweenQueues() {
+	track, err := DJ.Queues.Move("", 2, "chill")
+
+	suite.Nil(err)
+	suite.Equal(suite.First, track)
+	suite.Equal(1, DJ.Queues.Length("chill"))
+	suite.Equal(suite.Second, DJ.Queue.GetTrack(1))
+
+	track, err = DJ.Queues.Move("chill", 1, "main")
+
+	suite.Nil(err)
+	suite.Equal(suite.First, track)
+	suite.Equal(suite.First, DJ.Queue.GetTrack(2))
+	suite.Equal([]string{"main"}, DJ.Queues.Names(), "Empty queues should be dropped.")
+}
+
+func (suite *QueuesTestSuite) TestMoveErrors() {
+	_, err := DJ.Queues.Move("", 1, "chill")
+	suite.Equal(ErrNoTrackAtPosition, err, "The current track should not be moved.")
+
+	_, err = DJ.Queues.Move("", 2, "main")
+	suite.Equal(ErrSameQueue, err)
+
+	_, err = DJ.Queues.Move("party", 1, "main")
+	suite.Equal(ErrUnknownQueue, err)
+}
+
+func TestQueuesTestSuite(t *testing.T) {
+	suite.Run(t, new(QueuesTestSuite))
+}
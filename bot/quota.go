@@ -0,0 +1,110 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/quota.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Priorities of API calls made against the quota.
+const (
+	// QuotaHigh is the priority of calls that directly serve a user's request,
+	// such as looking up the video they added.
+	QuotaHigh = iota
+	// QuotaLow is the priority of calls that only enrich a request, such as
+	// looking up the details of every item of a long playlist. They are
+	// deferred once the budget runs low.
+	QuotaLow
+)
+
+var (
+	// ErrQuotaExhausted is returned when an API call would exceed the daily
+	// budget.
+	ErrQuotaExhausted = errors.New("The daily YouTube API budget has been used up. Please try again tomorrow")
+	// ErrQuotaDeferred is returned when a low-priority API call is deferred to
+	// save the remaining budget for users' requests.
+	ErrQuotaDeferred = errors.New("The YouTube API budget is running low, so this call has been deferred")
+)
+
+// quotaLocation is the time zone in which the YouTube API quota resets at
+// midnight.
+var quotaLocation = loadQuotaLocation()
+
+// Quota keeps an estimate of the YouTube API units used today so that the
+// daily budget is spent on the calls that matter most.
+type Quota struct {
+	used  int
+	calls int
+	day   string
+	mutex sync.Mutex
+}
+
+// NewQuota returns a Quota with no units used.
+func NewQuota() *Quota {
+	return &Quota{}
+}
+
+// Spend records an API call costing `units` with the given priority. An error
+// is returned instead, and nothing is recorded, if the call would exceed the
+// budget set in quota.youtube_daily_units, or if it is a low-priority call and
+// fewer than quota.low_priority_reserve units would remain afterwards. A
+// budget of 0 disables budgeting, though usage is still tracked.
+func (q *Quota) Spend(units, priority int) error {
+	return q.spend(units, priority, time.Now())
+}
+
+func (q *Quota) spend(units, priority int, now time.Time) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.resetIfNewDay(now)
+
+	if budget := viper.GetInt("quota.youtube_daily_units"); budget > 0 {
+		remaining := budget - q.used - units
+		if remaining < 0 {
+			return ErrQuotaExhausted
+		}
+		if priority == QuotaLow && remaining < viper.GetInt("quota.low_priority_reserve") {
+			return ErrQuotaDeferred
+		}
+	}
+	q.used += units
+	q.calls++
+	return nil
+}
+
+// Usage returns the units used and the number of calls made today.
+func (q *Quota) Usage() (units, calls int) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.resetIfNewDay(time.Now())
+	return q.used, q.calls
+}
+
+// resetIfNewDay clears the usage once the quota has reset. The mutex must be
+// held by the caller.
+func (q *Quota) resetIfNewDay(now time.Time) {
+	day := now.In(quotaLocation).Format("2006-01-02")
+	if day != q.day {
+		q.day = day
+		q.used = 0
+		q.calls = 0
+	}
+}
+
+// loadQuotaLocation returns the Pacific time zone, in which the YouTube API
+// quota resets. A fixed offset is used if no time zone database is available.
+func loadQuotaLocation() *time.Location {
+	if location, err := time.LoadLocation("America/Los_Angeles"); err == nil {
+		return location
+	}
+	return time.FixedZone("PST", -8*60*60)
+}
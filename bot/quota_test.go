@@ -0,0 +1,72 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/quota_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type QuotaTestSuite struct {
+	suite.Suite
+	Quota *Quota
+	Now   time.Time
+}
+
+func (suite *QuotaTestSuite) SetupTest() {
+	suite.Quota = NewQuota()
+	suite.Now = time.Date(2016, time.August, 1, 20, 0, 0, 0, time.UTC)
+	viper.Set("quota.youtube_daily_units", 200)
+	viper.Set("quota.low_priority_reserve", 50)
+}
+
+func (suite *QuotaTestSuite) TestSpend() {
+	suite.Nil(suite.Quota.spend(100, QuotaHigh, suite.Now))
+	suite.Nil(suite.Quota.spend(1, QuotaLow, suite.Now))
+
+	suite.Equal(101, suite.Quota.used)
+	suite.Equal(2, suite.Quota.calls)
+}
+
+func (suite *QuotaTestSuite) TestSpendDefersLowPriorityCalls() {
+	suite.Nil(suite.Quota.spend(100, QuotaHigh, suite.Now))
+	suite.Nil(suite.Quota.spend(51, QuotaHigh, suite.Now))
+
+	suite.Equal(ErrQuotaDeferred, suite.Quota.spend(1, QuotaLow, suite.Now))
+	suite.Nil(suite.Quota.spend(1, QuotaHigh, suite.Now))
+	suite.Equal(152, suite.Quota.used)
+}
+
+func (suite *QuotaTestSuite) TestSpendRefusesCallsOverBudget() {
+	suite.Nil(suite.Quota.spend(150, QuotaHigh, suite.Now))
+
+	suite.Equal(ErrQuotaExhausted, suite.Quota.spend(100, QuotaHigh, suite.Now))
+	suite.Equal(150, suite.Quota.used)
+}
+
+func (suite *QuotaTestSuite) TestSpendWithoutBudget() {
+	viper.Set("quota.youtube_daily_units", 0)
+
+	suite.Nil(suite.Quota.spend(1000, QuotaLow, suite.Now))
+	suite.Equal(1000, suite.Quota.used)
+}
+
+func (suite *QuotaTestSuite) TestUsageResetsEachDay() {
+	suite.Nil(suite.Quota.spend(150, QuotaHigh, suite.Now))
+
+	suite.Nil(suite.Quota.spend(1, QuotaHigh, suite.Now.Add(24*time.Hour)))
+	suite.Equal(1, suite.Quota.used)
+	suite.Equal(1, suite.Quota.calls)
+}
+
+func TestQuotaTestSuite(t *testing.T) {
+	suite.Run(t, new(QuotaTestSuite))
+}
@@ -0,0 +1,228 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/radio.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// streamTitleRegex matches the title of the song currently playing in an ICY
+// metadata block, e.g. "StreamTitle='Artist - Title';".
+var streamTitleRegex = regexp.MustCompile(`StreamTitle='(.*?)';`)
+
+// radioProbeClient is used to check whether a URL is an internet radio stream.
+// The timeout keeps unresponsive URLs from holding up the add command.
+var radioProbeClient = &http.Client{Timeout: 10 * time.Second}
+
+// RadioStation holds the information an Icecast or Shoutcast server sends
+// about a station in its ICY headers.
+type RadioStation struct {
+	Name        string
+	Description string
+	Homepage    string
+	// MetaInt is the number of audio bytes between metadata blocks, or 0 if
+	// the server does not send metadata.
+	MetaInt int
+}
+
+// ProbeRadio connects to `url` and returns the station information from the
+// ICY headers of the response. An error is returned if the URL is not an
+// internet radio stream.
+func ProbeRadio(url string) (RadioStation, error) {
+	response, err := getRadioStream(radioProbeClient, url)
+	if err != nil {
+		return RadioStation{}, err
+	}
+	response.Body.Close()
+	return parseRadioHeaders(response.Header)
+}
+
+// parseRadioHeaders returns the station information in the ICY headers
+// `header`. An error is returned if no ICY headers are present.
+func parseRadioHeaders(header http.Header) (RadioStation, error) {
+	isRadio := false
+	for name := range header {
+		if strings.HasPrefix(strings.ToLower(name), "icy-") {
+			isRadio = true
+		}
+	}
+	if !isRadio {
+		return RadioStation{}, errors.New("The URL is not an internet radio stream")
+	}
+	metaInt, _ := strconv.Atoi(header.Get("icy-metaint"))
+	return RadioStation{
+		Name:        header.Get("icy-name"),
+		Description: header.Get("icy-description"),
+		Homepage:    header.Get("icy-url"),
+		MetaInt:     metaInt,
+	}, nil
+}
+
+// Radio follows the metadata of the internet radio station that is playing and
+// announces each new song the station plays.
+type Radio struct {
+	title string
+	stop  chan struct{}
+	mutex sync.Mutex
+}
+
+// NewRadio returns an empty Radio.
+func NewRadio() *Radio {
+	return &Radio{}
+}
+
+// StreamTitle returns the title of the song the station that is playing
+// reported last, or an empty string if it has reported none.
+func (r *Radio) StreamTitle() string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.title
+}
+
+// Watch begins following the metadata of `t` if it is an internet radio
+// station. Whatever station was followed before is no longer followed.
+func (r *Radio) Watch(t interfaces.Track) {
+	r.Stop()
+	if t.GetService() != "Radio" {
+		return
+	}
+
+	stop := make(chan struct{})
+	r.mutex.Lock()
+	r.stop = stop
+	r.mutex.Unlock()
+
+	go func() {
+		if err := r.follow(t, stop); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"url":   t.GetURL(),
+				"error": err.Error(),
+			}).Warnln("Stopped following the metadata of an internet radio station.")
+		}
+	}()
+}
+
+// Stop stops following the metadata of the station that is playing.
+func (r *Radio) Stop() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.stop != nil {
+		close(r.stop)
+		r.stop = nil
+	}
+	r.title = ""
+}
+
+// follow reads the metadata blocks of the stream of `t` until `stop` is closed
+// and announces each new title.
+func (r *Radio) follow(t interfaces.Track, stop chan struct{}) error {
+	response, err := getRadioStream(http.DefaultClient, t.GetURL())
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-stop
+		response.Body.Close()
+	}()
+	defer response.Body.Close()
+
+	station, err := parseRadioHeaders(response.Header)
+	if err != nil {
+		return err
+	}
+	if station.MetaInt == 0 {
+		return errors.New("The station does not send metadata")
+	}
+
+	err = readStreamTitles(response.Body, station.MetaInt, func(title string) {
+		r.mutex.Lock()
+		if r.stop != stop || title == r.title {
+			r.mutex.Unlock()
+			return
+		}
+		r.title = title
+		r.mutex.Unlock()
+
+		DJ.Notify("radio_title_changed", "", fmt.Sprintf(viper.GetString("queue.messages.radio_now_playing"),
+			t.GetTitle(), title))
+	})
+	select {
+	case <-stop:
+		return nil
+	default:
+		return err
+	}
+}
+
+// readStreamTitles reads an ICY stream from `stream` with metadata blocks every
+// `metaInt` bytes of audio and calls `onTitle` with every non-empty stream
+// title found, until the stream ends.
+func readStreamTitles(stream io.Reader, metaInt int, onTitle func(string)) error {
+	reader := bufio.NewReader(stream)
+	for {
+		if _, err := reader.Discard(metaInt); err != nil {
+			return err
+		}
+		length, err := reader.ReadByte()
+		if err != nil {
+			return err
+		}
+		if length == 0 {
+			continue
+		}
+		block := make([]byte, int(length)*16)
+		if _, err := io.ReadFull(reader, block); err != nil {
+			return err
+		}
+		if title := parseStreamTitle(string(block)); title != "" {
+			onTitle(title)
+		}
+	}
+}
+
+// parseStreamTitle returns the stream title in the ICY metadata block `meta`,
+// or an empty string if it holds none.
+func parseStreamTitle(meta string) string {
+	match := streamTitleRegex.FindStringSubmatch(strings.TrimRight(meta, "\x00"))
+	if match == nil {
+		return ""
+	}
+	return strings.TrimSpace(match[1])
+}
+
+// getRadioStream requests the stream at `url`, asking the server to include
+// ICY metadata.
+func getRadioStream(client *http.Client, url string) (*http.Response, error) {
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Icy-MetaData", "1")
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		return nil, fmt.Errorf("The radio stream returned status %s", response.Status)
+	}
+	return response, nil
+}
@@ -0,0 +1,74 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/radio_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RadioTestSuite struct {
+	suite.Suite
+}
+
+func (suite *RadioTestSuite) TestParseRadioHeaders() {
+	header := http.Header{}
+	header.Set("icy-name", "Test FM")
+	header.Set("icy-metaint", "16000")
+
+	station, err := parseRadioHeaders(header)
+
+	suite.Nil(err)
+	suite.Equal("Test FM", station.Name)
+	suite.Equal(16000, station.MetaInt)
+
+	_, err = parseRadioHeaders(http.Header{"Content-Type": []string{"audio/mpeg"}})
+	suite.NotNil(err)
+}
+
+func (suite *RadioTestSuite) TestParseStreamTitle() {
+	suite.Equal("Artist - Title", parseStreamTitle("StreamTitle='Artist - Title';StreamUrl='';\x00\x00"))
+	suite.Equal("", parseStreamTitle("StreamUrl='';"))
+}
+
+func (suite *RadioTestSuite) TestReadStreamTitles() {
+	meta := "StreamTitle='First';"
+	meta += strings.Repeat("\x00", 32-len(meta))
+	var stream bytes.Buffer
+	stream.WriteString("abcd")
+	stream.WriteByte(2)
+	stream.WriteString(meta)
+	stream.WriteString("efgh")
+	stream.WriteByte(0)
+	stream.WriteString("ij")
+
+	var titles []string
+	err := readStreamTitles(&stream, 4, func(title string) {
+		titles = append(titles, title)
+	})
+
+	suite.NotNil(err)
+	suite.Equal([]string{"First"}, titles)
+}
+
+func (suite *RadioTestSuite) TestWatchIgnoresOtherServices() {
+	radio := NewRadio()
+
+	radio.Watch(Track{Service: "YouTube"})
+
+	suite.Nil(radio.stop)
+	suite.Equal("", radio.StreamTitle())
+}
+
+func TestRadioTestSuite(t *testing.T) {
+	suite.Run(t, new(RadioTestSuite))
+}
@@ -0,0 +1,86 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/reconnect.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"sync"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// Reconnect keeps the queue while the bot reconnects to the server, if
+// connection.keep_queue is enabled. Tracks that are added in the meantime are
+// queued as usual and the next tracks are downloaded ahead, but nothing is
+// played until the bot is back. Playback then resumes where it stopped.
+type Reconnect struct {
+	offline bool
+	mutex   sync.Mutex
+}
+
+// NewReconnect returns a Reconnect for a connected bot.
+func NewReconnect() *Reconnect {
+	return &Reconnect{}
+}
+
+// Offline returns true while the bot is reconnecting with its queue kept.
+func (r *Reconnect) Offline() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.offline
+}
+
+// Disconnected stops the current track, remembering how far it got, and
+// begins downloading the first connection.reconnect_prefetch tracks of the
+// queue.
+func (r *Reconnect) Disconnected() {
+	r.mutex.Lock()
+	r.offline = true
+	r.mutex.Unlock()
+
+	if stream := DJ.AudioStream; stream != nil {
+		// The track has not started yet while its intro is playing.
+		if track, err := DJ.Queue.CurrentTrack(); err == nil && !track.IsLive() && !DJ.Queue.(*Queue).PlayingIntro() {
+			DJ.Queue.(*Queue).setCurrentOffset(track.GetPlaybackOffset() + stream.Elapsed())
+		}
+		DJ.Queue.StopCurrent()
+		DJ.AudioStream = nil
+	}
+	go r.prefetch(viper.GetInt("connection.reconnect_prefetch"))
+}
+
+// Reconnected resumes playback once the bot is connected again. Nothing
+// happens if the bot was not reconnecting with its queue kept.
+func (r *Reconnect) Reconnected() {
+	r.mutex.Lock()
+	offline := r.offline
+	r.offline = false
+	r.mutex.Unlock()
+	if !offline {
+		return
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"num_tracks": DJ.Queue.Length(),
+	}).Infoln("Resuming the queue after reconnecting...")
+	go DJ.Queue.(*Queue).startIfNeeded()
+}
+
+// prefetch downloads the first `count` tracks of the queue while the bot is
+// offline. Tracks that fail to download are tried again when they are played.
+func (r *Reconnect) prefetch(count int) {
+	for i := 0; i < count && r.Offline(); i++ {
+		track := DJ.Queue.GetTrack(i)
+		if track == nil {
+			return
+		}
+		if err := DJ.YouTubeDL.Download(track); err != nil {
+			logrus.WithFields(ErrorFields(err)).Warnln("Could not download a queued track while reconnecting.")
+		}
+	}
+}
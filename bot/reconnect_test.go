@@ -0,0 +1,54 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/reconnect_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReconnectTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ReconnectTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+	viper.Set("connection.reconnect_prefetch", 0)
+}
+
+func (suite *ReconnectTestSuite) TestNoTrackStartsWhileOffline() {
+	DJ.Reconnect.Disconnected()
+
+	// Starting the track would fail, as it cannot be downloaded.
+	suite.Nil(DJ.Queue.AppendTrack(&Track{ID: "first"}))
+	suite.True(DJ.Reconnect.Offline())
+	suite.Nil(DJ.AudioStream, "No track should be started while the bot is offline.")
+	suite.Equal(1, DJ.Queue.Length(), "Tracks added while offline should be kept.")
+}
+
+func (suite *ReconnectTestSuite) TestReconnectedWithoutDisconnect() {
+	DJ.Reconnect.Reconnected()
+
+	suite.False(DJ.Reconnect.Offline())
+}
+
+func (suite *ReconnectTestSuite) TestSetCurrentOffset() {
+	DJ.Reconnect.Disconnected()
+	DJ.Queue.AppendTrack(Track{ID: "first"})
+
+	DJ.Queue.(*Queue).setCurrentOffset(90 * time.Second)
+
+	suite.Equal(90*time.Second, DJ.Queue.GetTrack(0).GetPlaybackOffset())
+}
+
+func TestReconnectTestSuite(t *testing.T) {
+	suite.Run(t, new(ReconnectTestSuite))
+}
@@ -0,0 +1,66 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/scrub.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+var (
+	scrubMutex    sync.Mutex
+	scrubPatterns []string
+	scrubRegexes  []*regexp.Regexp
+	spaceRegex    = regexp.MustCompile(`\s+`)
+)
+
+// ScrubTitle removes clutter such as "[Official Video]" or "(HD)" from a track
+// title using the patterns in queue.title_scrub_patterns. The original title
+// is returned if scrubbing would leave nothing behind.
+func ScrubTitle(title string) string {
+	scrubbed := title
+	for _, regex := range titleScrubRegexes() {
+		scrubbed = regex.ReplaceAllString(scrubbed, "")
+	}
+	scrubbed = strings.TrimSpace(spaceRegex.ReplaceAllString(scrubbed, " "))
+	if scrubbed == "" {
+		return title
+	}
+	return scrubbed
+}
+
+// titleScrubRegexes returns the compiled scrubbing patterns, compiling them
+// again if the configuration has changed since they were last used.
+func titleScrubRegexes() []*regexp.Regexp {
+	scrubMutex.Lock()
+	defer scrubMutex.Unlock()
+
+	patterns := viper.GetStringSlice("queue.title_scrub_patterns")
+	if strings.Join(patterns, "\n") == strings.Join(scrubPatterns, "\n") && scrubRegexes != nil {
+		return scrubRegexes
+	}
+
+	scrubPatterns = patterns
+	scrubRegexes = make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"pattern": pattern,
+				"error":   err.Error(),
+			}).Warnln("Ignoring invalid title scrub pattern.")
+			continue
+		}
+		scrubRegexes = append(scrubRegexes, regex)
+	}
+	return scrubRegexes
+}
@@ -0,0 +1,51 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/scrub_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type ScrubTestSuite struct {
+	suite.Suite
+	DefaultPatterns []string
+}
+
+func (suite *ScrubTestSuite) SetupSuite() {
+	SetDefaultConfig()
+	suite.DefaultPatterns = viper.GetStringSlice("queue.title_scrub_patterns")
+}
+
+func (suite *ScrubTestSuite) TearDownTest() {
+	viper.Set("queue.title_scrub_patterns", suite.DefaultPatterns)
+}
+
+func (suite *ScrubTestSuite) TestScrubTitleWithDefaultPatterns() {
+	suite.Equal("Artist - Song", ScrubTitle("Artist - Song (Official Music Video)"))
+	suite.Equal("Artist - Song", ScrubTitle("Artist - Song [HD]"))
+	suite.Equal("Artist - Song", ScrubTitle("Artist - Song - Lyrics"))
+	suite.Equal("Artist - Song", ScrubTitle("Artist - Song | Napalm Records"))
+	suite.Equal("Artist - Song (feat. Someone)", ScrubTitle("Artist - Song (feat. Someone) [Official Audio]"))
+}
+
+func (suite *ScrubTestSuite) TestScrubTitleKeepsTitleThatWouldBeEmpty() {
+	suite.Equal("(Official Video)", ScrubTitle("(Official Video)"))
+}
+
+func (suite *ScrubTestSuite) TestScrubTitleWithCustomPatterns() {
+	viper.Set("queue.title_scrub_patterns", []string{`^Nightcore - `, `(invalid`})
+
+	suite.Equal("Song [HD]", ScrubTitle("Nightcore - Song [HD]"), "Only the custom pattern should apply and invalid patterns should be ignored.")
+}
+
+func TestScrubTestSuite(t *testing.T) {
+	suite.Run(t, new(ScrubTestSuite))
+}
@@ -0,0 +1,151 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/seed.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// LocalService is the service name of tracks that are played from local audio
+// files rather than from the cache directory.
+const LocalService = "Local"
+
+// seedExtensions are the file extensions of audio files that are seeded from a
+// directory.
+var seedExtensions = map[string]bool{
+	".mp3":  true,
+	".ogg":  true,
+	".opus": true,
+	".m4a":  true,
+	".flac": true,
+	".wav":  true,
+}
+
+// SeedQueue adds the tracks found at seed.source to the queue, so that the bot
+// starts playing as soon as it connects. The source may be a URL supported by
+// one of the enabled services, such as a playlist, or a local directory of
+// audio files. Nothing is added if no source is configured or if the queue
+// already holds tracks, for example ones restored from the state file.
+//
+// If seed.autoplay is disabled, the music is put on hold until someone takes
+// it off hold.
+func (dj *MumbleDJ) SeedQueue() error {
+	source := viper.GetString("seed.source")
+	if source == "" || dj.Queue.Length() > 0 {
+		return nil
+	}
+
+	tracks, err := dj.seedTracks(source)
+	if err != nil {
+		return err
+	}
+	if len(tracks) == 0 {
+		return errors.New("The seed source contains no tracks")
+	}
+	if viper.GetBool("seed.shuffle") {
+		for i := range tracks {
+			j := rand.Intn(i + 1)
+			tracks[i], tracks[j] = tracks[j], tracks[i]
+		}
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"source":     source,
+		"num_tracks": len(tracks),
+	}).Infoln("Seeding the queue...")
+
+	// The music is put on hold before any track is added, so that none is
+	// started or announced.
+	if !viper.GetBool("seed.autoplay") {
+		dj.Hold.Start()
+	}
+	for _, track := range tracks {
+		if err := dj.Queue.AppendTrack(track); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"title": track.GetTitle(),
+				"error": err.Error(),
+			}).Infoln("Skipping a seeded track.")
+		}
+	}
+	return nil
+}
+
+// seedTracks returns the tracks found at `source`, which is either a local
+// directory or a URL.
+func (dj *MumbleDJ) seedTracks(source string) ([]interfaces.Track, error) {
+	submitter := viper.GetString("connection.username")
+	if info, err := os.Stat(os.ExpandEnv(source)); err == nil && info.IsDir() {
+		return localTracks(os.ExpandEnv(source), submitter)
+	}
+
+	service, err := dj.GetService(source)
+	if err != nil {
+		return nil, err
+	}
+	if dj.Client != nil && dj.Client.Self != nil {
+		return service.GetTracks(source, dj.Client.Self)
+	}
+	return nil, errors.New("The bot must be connected to seed the queue from a URL")
+}
+
+// localTracks returns a track for each audio file directly inside the
+// directory `dir`, in alphabetical order. Titles, artists and durations are
+// read from the tags of the files where possible.
+func localTracks(dir, submitter string) ([]interfaces.Track, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(files))
+	for _, file := range files {
+		if !file.IsDir() && seedExtensions[strings.ToLower(filepath.Ext(file.Name()))] {
+			names = append(names, file.Name())
+		}
+	}
+	sort.Strings(names)
+
+	tracks := make([]interfaces.Track, 0, len(names))
+	for _, name := range names {
+		path, _ := filepath.Abs(filepath.Join(dir, name))
+		hash := sha1.Sum([]byte(path))
+		track := Track{
+			ID:        hex.EncodeToString(hash[:])[:16],
+			URL:       "file://" + path,
+			Title:     strings.TrimSuffix(name, filepath.Ext(name)),
+			Submitter: submitter,
+			Service:   LocalService,
+			Filename:  path,
+		}
+		if tags, err := ReadTags(path); err == nil {
+			track = track.WithTags(tags)
+		}
+		tracks = append(tracks, track)
+	}
+	return tracks, nil
+}
+
+// trackPath returns the path of the audio file of `t`. Local tracks are played
+// where they are; all others are played from the cache directory.
+func trackPath(t interfaces.Track) string {
+	if t.GetService() == LocalService {
+		return t.GetFilename()
+	}
+	return cachePath(t.GetFilename())
+}
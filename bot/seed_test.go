@@ -0,0 +1,113 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/seed_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/layeh/gumble/gumbleffmpeg"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type SeedTestSuite struct {
+	suite.Suite
+	Directory string
+}
+
+func (suite *SeedTestSuite) SetupSuite() {
+	DJ = NewMumbleDJ()
+
+	// Trick the tests into thinking audio is already playing to avoid
+	// attempting to play tracks that don't exist.
+	DJ.AudioStream = new(gumbleffmpeg.Stream)
+
+	viper.Set("queue.automatic_shuffle_on", false)
+	viper.Set("queue.max_track_duration", 0)
+	viper.Set("seed.shuffle", false)
+}
+
+func (suite *SeedTestSuite) SetupTest() {
+	DJ.Queue = NewQueue()
+	suite.Directory, _ = ioutil.TempDir("", "mumbledj")
+	for _, name := range []string{"b.mp3", "a.flac", "notes.txt"} {
+		ioutil.WriteFile(filepath.Join(suite.Directory, name), []byte{}, 0644)
+	}
+	os.Mkdir(filepath.Join(suite.Directory, "c.mp3"), 0755)
+	viper.Set("seed.source", suite.Directory)
+}
+
+func (suite *SeedTestSuite) TearDownTest() {
+	viper.Set("seed.source", "")
+	os.RemoveAll(suite.Directory)
+}
+
+func (suite *SeedTestSuite) TestLocalTracks() {
+	tracks, err := localTracks(suite.Directory, "DJ")
+
+	suite.Nil(err)
+	suite.Len(tracks, 2, "Only audio files should be seeded.")
+	suite.Equal("a", tracks[0].GetTitle())
+	suite.Equal("b", tracks[1].GetTitle())
+	suite.Equal(LocalService, tracks[0].GetService())
+	suite.Equal(filepath.Join(suite.Directory, "a.flac"), trackPath(tracks[0]))
+}
+
+func (suite *SeedTestSuite) TestTrackPathOfCachedTrack() {
+	directory := viper.GetString("cache.directory")
+	defer viper.Set("cache.directory", directory)
+	viper.Set("cache.directory", suite.Directory)
+
+	suite.Equal(suite.Directory+"/id.track", trackPath(Track{Service: "YouTube", Filename: "id.track"}))
+}
+
+func (suite *SeedTestSuite) TestSeedQueue() {
+	suite.Nil(DJ.SeedQueue())
+
+	suite.Equal(2, DJ.Queue.Length())
+}
+
+func (suite *SeedTestSuite) TestSeedQueueWithoutAutoplay() {
+	viper.Set("seed.autoplay", false)
+	defer viper.Set("seed.autoplay", true)
+	stream := DJ.AudioStream
+	DJ.AudioStream = nil
+	defer func() {
+		DJ.AudioStream = stream
+		DJ.Hold = NewHold()
+	}()
+
+	suite.Nil(DJ.SeedQueue())
+
+	suite.Equal(2, DJ.Queue.Length())
+	suite.True(DJ.Hold.Active(), "The music should be on hold until someone takes it off hold.")
+	suite.Nil(DJ.AudioStream, "No seeded track should be started.")
+}
+
+func (suite *SeedTestSuite) TestSeedQueueSkipsNonEmptyQueue() {
+	DJ.Queue.AppendTrack(Track{ID: "restored"})
+
+	suite.Nil(DJ.SeedQueue())
+
+	suite.Equal(1, DJ.Queue.Length())
+}
+
+func (suite *SeedTestSuite) TestSeedQueueWithoutSource() {
+	viper.Set("seed.source", "")
+
+	suite.Nil(DJ.SeedQueue())
+
+	suite.Zero(DJ.Queue.Length())
+}
+
+func TestSeedTestSuite(t *testing.T) {
+	suite.Run(t, new(SeedTestSuite))
+}
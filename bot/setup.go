@@ -0,0 +1,177 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/setup.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// setupQuestion is a single prompt asked during interactive setup.
+type setupQuestion struct {
+	Key    string
+	Prompt string
+	IsList bool
+}
+
+var connectionQuestions = []setupQuestion{
+	{Key: "connection.address", Prompt: "Mumble server address"},
+	{Key: "connection.port", Prompt: "Mumble server port"},
+	{Key: "connection.username", Prompt: "Username for the bot"},
+	{Key: "connection.password", Prompt: "Mumble server password (leave empty if none)"},
+}
+
+var apiKeyQuestions = []setupQuestion{
+	{Key: "api_keys.youtube", Prompt: "YouTube API key"},
+	{Key: "api_keys.soundcloud", Prompt: "SoundCloud client ID"},
+	{Key: "api_keys.jamendo", Prompt: "Jamendo client ID"},
+}
+
+var adminQuestions = []setupQuestion{
+	{Key: "admins.names", Prompt: "Admin usernames, separated by commas", IsList: true},
+}
+
+// RunSetup interactively asks for the settings needed to get the bot running,
+// validates them where possible, and writes the resulting configuration file
+// to `path`. Questions are read from `in` and prompts are written to `out`.
+func RunSetup(in io.Reader, out io.Writer, path string) error {
+	reader := bufio.NewReader(in)
+	settings := make(map[string]interface{})
+
+	fmt.Fprintln(out, "Welcome to MumbleDJ! Press enter to accept the value shown in brackets.")
+
+	for {
+		if err := askQuestions(reader, out, connectionQuestions, settings); err != nil {
+			return err
+		}
+		fmt.Fprintln(out, "Testing connection to the server...")
+		err := checkConnection()
+		if err == nil {
+			fmt.Fprintln(out, "Successfully connected to the server.")
+			break
+		}
+		fmt.Fprintf(out, "Could not connect to the server: %s\n", err.Error())
+		if retry, err := askYesNo(reader, out, "Would you like to re-enter the connection details?"); err != nil {
+			return err
+		} else if !retry {
+			break
+		}
+	}
+
+	for {
+		if err := askQuestions(reader, out, apiKeyQuestions, settings); err != nil {
+			return err
+		}
+		fmt.Fprintln(out, "Testing API keys...")
+		allValid := true
+		for _, service := range DJ.AvailableServices {
+			if err := service.CheckAPIKey(); err != nil {
+				fmt.Fprintf(out, "%s: %s\n", service.GetReadableName(), err.Error())
+				allValid = false
+			} else {
+				fmt.Fprintf(out, "%s: OK\n", service.GetReadableName())
+			}
+		}
+		if allValid {
+			break
+		}
+		if retry, err := askYesNo(reader, out, "Would you like to re-enter the API keys?"); err != nil {
+			return err
+		} else if !retry {
+			break
+		}
+	}
+
+	if err := askQuestions(reader, out, adminQuestions, settings); err != nil {
+		return err
+	}
+
+	if err := WriteConfigFile(path, "This configuration file was written by the interactive setup.", settings); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "The configuration file has been written to %s.\n", path)
+	return nil
+}
+
+// askQuestions asks each of the provided questions and stores the answers in
+// both `settings` and the active configuration so they can be validated.
+func askQuestions(reader *bufio.Reader, out io.Writer, questions []setupQuestion, settings map[string]interface{}) error {
+	for _, question := range questions {
+		current := viper.GetString(question.Key)
+		if question.IsList {
+			current = strings.Join(viper.GetStringSlice(question.Key), ",")
+		}
+		answer, err := askQuestion(reader, out, question.Prompt, current)
+		if err != nil {
+			return err
+		}
+
+		if question.IsList {
+			var list []string
+			for _, item := range strings.Split(answer, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					list = append(list, item)
+				}
+			}
+			settings[question.Key] = list
+			viper.Set(question.Key, list)
+		} else {
+			settings[question.Key] = answer
+			viper.Set(question.Key, answer)
+		}
+	}
+	return nil
+}
+
+// askQuestion writes a prompt and reads a single line of input. If the line is
+// empty, `current` is returned instead.
+func askQuestion(reader *bufio.Reader, out io.Writer, prompt, current string) (string, error) {
+	fmt.Fprintf(out, "%s [%s]: ", prompt, current)
+	line, err := reader.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+	if line = strings.TrimSpace(line); line == "" {
+		return current, nil
+	}
+	return line, nil
+}
+
+// askYesNo asks a yes or no question. Yes is assumed if no answer is given.
+func askYesNo(reader *bufio.Reader, out io.Writer, prompt string) (bool, error) {
+	answer, err := askQuestion(reader, out, prompt+" (y/n)", "y")
+	if err != nil {
+		return false, err
+	}
+	return strings.HasPrefix(strings.ToLower(answer), "y"), nil
+}
+
+// checkConnection attempts to connect to the configured server with the
+// configured credentials and disconnects immediately afterwards. Certificates
+// are not verified here, as only reachability and credentials are tested.
+func checkConnection() error {
+	config := gumble.NewConfig()
+	config.Username = viper.GetString("connection.username")
+	config.Password = viper.GetString("connection.password")
+
+	address := net.JoinHostPort(viper.GetString("connection.address"), viper.GetString("connection.port"))
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	client, err := gumble.DialWithDialer(dialer, address, config, &tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		return err
+	}
+	return client.Disconnect()
+}
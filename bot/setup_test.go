@@ -0,0 +1,95 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/setup_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type SetupTestSuite struct {
+	suite.Suite
+	Directory string
+}
+
+func (suite *SetupTestSuite) SetupTest() {
+	viper.Reset()
+	SetDefaultConfig()
+	DJ = NewMumbleDJ()
+	suite.Directory, _ = ioutil.TempDir("", "mumbledj")
+}
+
+func (suite *SetupTestSuite) TearDownTest() {
+	os.RemoveAll(suite.Directory)
+	viper.Reset()
+	SetDefaultConfig()
+}
+
+func (suite *SetupTestSuite) TestAskQuestionWithAnswer() {
+	reader := bufio.NewReader(strings.NewReader("answer\n"))
+	var out bytes.Buffer
+
+	answer, err := askQuestion(reader, &out, "Question", "default")
+
+	suite.Nil(err, "No error should be returned.")
+	suite.Equal("answer", answer, "The provided answer should be returned.")
+	suite.Contains(out.String(), "[default]", "The prompt should display the current value.")
+}
+
+func (suite *SetupTestSuite) TestAskQuestionWithoutAnswer() {
+	reader := bufio.NewReader(strings.NewReader("\n"))
+	var out bytes.Buffer
+
+	answer, err := askQuestion(reader, &out, "Question", "default")
+
+	suite.Nil(err, "No error should be returned.")
+	suite.Equal("default", answer, "The current value should be returned when no answer is given.")
+}
+
+func (suite *SetupTestSuite) TestRunSetup() {
+	path := filepath.Join(suite.Directory, "config.yaml")
+	// Port 1 is used so that the connection test fails immediately.
+	input := strings.Join([]string{
+		"127.0.0.1", "1", "DJ", "", // Connection details.
+		"n",                  // Do not retry the connection.
+		"youtubekey", "", "", // API keys.
+		"Matt, SuperUser", // Admins.
+	}, "\n") + "\n"
+	var out bytes.Buffer
+
+	err := RunSetup(strings.NewReader(input), &out, path)
+
+	suite.Nil(err, "No error should be returned.")
+	suite.Contains(out.String(), "Could not connect", "The failed connection test should be reported.")
+
+	viper.Reset()
+	viper.SetConfigFile(path)
+	suite.Nil(viper.ReadInConfig(), "The written config file should be valid.")
+	suite.Equal("1", viper.GetString("connection.port"))
+	suite.Equal("DJ", viper.GetString("connection.username"))
+	suite.Equal("youtubekey", viper.GetString("api_keys.youtube"))
+	suite.Equal([]string{"Matt", "SuperUser"}, viper.GetStringSlice("admins.names"))
+}
+
+func (suite *SetupTestSuite) TestRunSetupWithNoInput() {
+	err := RunSetup(strings.NewReader(""), new(bytes.Buffer), filepath.Join(suite.Directory, "config.yaml"))
+
+	suite.NotNil(err, "An error should be returned when input ends unexpectedly.")
+}
+
+func TestSetupTestSuite(t *testing.T) {
+	suite.Run(t, new(SetupTestSuite))
+}
@@ -0,0 +1,72 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/shufflevotes.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"errors"
+	"math"
+	"sync"
+
+	"github.com/spf13/viper"
+)
+
+// ErrAlreadyVotedToShuffle is returned when a user votes to shuffle the queue
+// twice.
+var ErrAlreadyVotedToShuffle = errors.New("This user has already voted to shuffle the queue")
+
+// ShuffleVotes keeps track of the users who have voted to shuffle the queue
+// while the shuffle command is not admin-only. The votes are reset once the
+// queue is shuffled or the current track changes.
+type ShuffleVotes struct {
+	voters []string
+	mutex  sync.Mutex
+}
+
+// NewShuffleVotes returns a ShuffleVotes without any votes.
+func NewShuffleVotes() *ShuffleVotes {
+	return &ShuffleVotes{}
+}
+
+// Add records a vote to shuffle by the user `name` and returns the number of
+// votes and the number needed. The vote passes, resetting the votes, once the
+// share of the `listeners` in the channel who voted reaches
+// commands.shuffle.vote_ratio.
+func (v *ShuffleVotes) Add(name string, listeners int) (votes, needed int, passed bool, err error) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	for _, voter := range v.voters {
+		if voter == name {
+			return len(v.voters), neededVotes(listeners), false, ErrAlreadyVotedToShuffle
+		}
+	}
+	v.voters = append(v.voters, name)
+	votes, needed = len(v.voters), neededVotes(listeners)
+	if votes >= needed {
+		v.voters = nil
+		return votes, needed, true, nil
+	}
+	return votes, needed, false, nil
+}
+
+// Reset removes all votes.
+func (v *ShuffleVotes) Reset() {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	v.voters = nil
+}
+
+// neededVotes returns the number of votes needed for a shuffle when there are
+// `listeners` in the channel.
+func neededVotes(listeners int) int {
+	needed := int(math.Ceil(viper.GetFloat64("commands.shuffle.vote_ratio") * float64(listeners)))
+	if needed < 1 {
+		needed = 1
+	}
+	return needed
+}
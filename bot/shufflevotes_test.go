@@ -0,0 +1,70 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/shufflevotes_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type ShuffleVotesTestSuite struct {
+	suite.Suite
+	Votes *ShuffleVotes
+}
+
+func (suite *ShuffleVotesTestSuite) SetupTest() {
+	suite.Votes = NewShuffleVotes()
+	viper.Set("commands.shuffle.vote_ratio", 0.5)
+}
+
+func (suite *ShuffleVotesTestSuite) TestVotePassesAtRatio() {
+	votes, needed, passed, err := suite.Votes.Add("User1", 4)
+	suite.Nil(err)
+	suite.Equal(1, votes)
+	suite.Equal(2, needed)
+	suite.False(passed)
+
+	_, _, passed, _ = suite.Votes.Add("User2", 4)
+	suite.True(passed, "Half of the listeners voting should pass the vote.")
+
+	votes, _, passed, _ = suite.Votes.Add("User1", 4)
+	suite.Equal(1, votes, "The votes should be reset once the vote passes.")
+	suite.False(passed)
+}
+
+func (suite *ShuffleVotesTestSuite) TestDuplicateVote() {
+	suite.Votes.Add("User1", 4)
+
+	votes, _, passed, err := suite.Votes.Add("User1", 4)
+
+	suite.Equal(ErrAlreadyVotedToShuffle, err)
+	suite.Equal(1, votes)
+	suite.False(passed)
+}
+
+func (suite *ShuffleVotesTestSuite) TestReset() {
+	suite.Votes.Add("User1", 4)
+
+	suite.Votes.Reset()
+
+	_, _, _, err := suite.Votes.Add("User1", 4)
+	suite.Nil(err)
+}
+
+func (suite *ShuffleVotesTestSuite) TestSingleVoteWithoutListeners() {
+	_, needed, passed, _ := suite.Votes.Add("User1", 0)
+
+	suite.Equal(1, needed)
+	suite.True(passed)
+}
+
+func TestShuffleVotesTestSuite(t *testing.T) {
+	suite.Run(t, new(ShuffleVotesTestSuite))
+}
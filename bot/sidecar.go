@@ -0,0 +1,336 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/sidecar.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// sidecarExtension is appended to the filename of a cached audio file to form
+// the filename of its metadata sidecar.
+const sidecarExtension = ".json"
+
+// meanVolumeRegex matches the mean volume reported by the volumedetect filter.
+var meanVolumeRegex = regexp.MustCompile(`mean_volume: (-?[\d.]+) dB`)
+
+// CachedTrack is the metadata stored in a JSON sidecar next to each cached
+// audio file. It holds everything needed to queue and announce the track
+// again without contacting the service it came from.
+type CachedTrack struct {
+	ID           string        `json:"id"`
+	URL          string        `json:"url"`
+	Title        string        `json:"title"`
+	Author       string        `json:"author"`
+	AuthorURL    string        `json:"author_url"`
+	Service      string        `json:"service"`
+	Filename     string        `json:"filename"`
+	ThumbnailURL string        `json:"thumbnail_url"`
+	Duration     time.Duration `json:"duration"`
+	// Loudness is the mean volume of the audio in dB, if it was measured.
+	Loudness *float64  `json:"loudness,omitempty"`
+	CachedAt time.Time `json:"cached_at"`
+	// Offset is where playback begins, as given by the link the track was
+	// found with.
+	Offset time.Duration `json:"-"`
+}
+
+// sortCachedTracksByTitle sorts cached tracks alphabetically by title,
+// ignoring case.
+type sortCachedTracksByTitle []CachedTrack
+
+func (a sortCachedTracksByTitle) Len() int      { return len(a) }
+func (a sortCachedTracksByTitle) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a sortCachedTracksByTitle) Less(i, j int) bool {
+	return strings.ToLower(a[i].Title) < strings.ToLower(a[j].Title)
+}
+
+// Track returns a queueable track for the cached audio, submitted by
+// `submitter`.
+func (ct CachedTrack) Track(submitter string) Track {
+	return Track{
+		ID:             ct.ID,
+		URL:            ct.URL,
+		Title:          ct.Title,
+		Author:         ct.Author,
+		AuthorURL:      ct.AuthorURL,
+		Submitter:      submitter,
+		Service:        ct.Service,
+		Filename:       ct.Filename,
+		ThumbnailURL:   ct.ThumbnailURL,
+		Duration:       ct.Duration,
+		PlaybackOffset: ct.Offset,
+	}
+}
+
+// WriteSidecar saves the metadata of `t` next to its cached audio file. The
+// loudness of the audio is measured first if cache.measure_loudness is
+// enabled.
+func WriteSidecar(t interfaces.Track) error {
+	cached := CachedTrack{
+		ID:           t.GetID(),
+		URL:          t.GetURL(),
+		Title:        t.GetTitle(),
+		Author:       t.GetAuthor(),
+		AuthorURL:    t.GetAuthorURL(),
+		Service:      t.GetService(),
+		Filename:     t.GetFilename(),
+		ThumbnailURL: t.GetThumbnailURL(),
+		Duration:     t.GetDuration(),
+		CachedAt:     time.Now(),
+	}
+	if viper.GetBool("cache.measure_loudness") {
+		if loudness, err := measureLoudness(cachePath(t.GetFilename())); err == nil {
+			cached.Loudness = &loudness
+		} else {
+			logrus.WithFields(logrus.Fields{
+				"filename": t.GetFilename(),
+				"error":    err.Error(),
+			}).Warnln("Could not measure the loudness of a cached track.")
+		}
+	}
+
+	data, err := json.Marshal(cached)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(cachePath(t.GetFilename())+sidecarExtension, data, 0644); err != nil {
+		return err
+	}
+	if DJ != nil && DJ.Cache != nil {
+		DJ.Cache.index(cached)
+	}
+	return nil
+}
+
+// Tracks returns the metadata of every cached track whose audio file is still
+// present, sorted by title.
+func (c *Cache) Tracks() []CachedTrack {
+	tracks := make([]CachedTrack, 0)
+	files, _ := ioutil.ReadDir(os.ExpandEnv(viper.GetString("cache.directory")))
+	for _, file := range files {
+		if !isSidecar(file.Name()) {
+			continue
+		}
+		cached, err := readSidecar(file.Name())
+		if err != nil {
+			continue
+		}
+		if _, err := os.Stat(cachePath(cached.Filename)); err != nil {
+			continue
+		}
+		tracks = append(tracks, cached)
+	}
+	sort.Stable(sortCachedTracksByTitle(tracks))
+	return tracks
+}
+
+// FindTrack returns the cached track that `url` links to, if caching is
+// enabled and the track has been cached. Links that carry a starting point,
+// such as "t=90", begin playback there.
+func (c *Cache) FindTrack(url string) (CachedTrack, bool) {
+	if !viper.GetBool("cache.enabled") {
+		return CachedTrack{}, false
+	}
+
+	c.mutex.Lock()
+	indexed := c.tracks != nil
+	c.mutex.Unlock()
+	if !indexed {
+		c.indexAll()
+	}
+
+	key := cacheKey(url)
+	if key == "" {
+		return CachedTrack{}, false
+	}
+	c.mutex.Lock()
+	cached, ok := c.tracks[key]
+	c.mutex.Unlock()
+	if !ok {
+		return CachedTrack{}, false
+	}
+
+	// Audio files are deleted when they expire or the cache grows too large.
+	if _, err := os.Stat(cachePath(cached.Filename)); err != nil {
+		c.mutex.Lock()
+		delete(c.tracks, key)
+		c.mutex.Unlock()
+		return CachedTrack{}, false
+	}
+	if offset := LinkOffset(url); offset < cached.Duration {
+		cached.Offset = offset
+	}
+	return cached, true
+}
+
+// indexAll indexes the tracks in the cache directory by the keys of their
+// links, so that finding a track does not read every sidecar.
+func (c *Cache) indexAll() {
+	tracks := make(map[string]CachedTrack)
+	for _, cached := range c.Tracks() {
+		if key := cacheKey(cached.URL); key != "" {
+			tracks[key] = cached
+		}
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.tracks == nil {
+		c.tracks = tracks
+	}
+}
+
+// index adds a newly cached track to the index, if it has been built.
+func (c *Cache) index(cached CachedTrack) {
+	key := cacheKey(cached.URL)
+	if key == "" {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.tracks != nil {
+		c.tracks[key] = cached
+	}
+}
+
+// Search returns the cached tracks whose title or author contains every one of
+// `terms`, ignoring case. All cached tracks are returned if no terms are given.
+// The results are remembered for `user` so one can be picked by number with
+// SearchResult.
+func (c *Cache) Search(user string, terms ...string) []CachedTrack {
+	results := make([]CachedTrack, 0)
+	for _, cached := range c.Tracks() {
+		if cached.matchesTerms(terms) {
+			results = append(results, cached)
+		}
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.results[user] = results
+	return results
+}
+
+// SearchResult returns the result numbered `n`, starting from 1, of the last
+// search made by `user`.
+func (c *Cache) SearchResult(user string, n int) (CachedTrack, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	results, ok := c.results[user]
+	if !ok {
+		return CachedTrack{}, errors.New("No search of the cache has been made")
+	}
+	if n < 1 || n > len(results) {
+		return CachedTrack{}, errors.New("There is no search result with that number")
+	}
+	return results[n-1], nil
+}
+
+// matchesTerms returns true if the title or author of the cached track contains
+// every one of `terms`, ignoring case.
+func (ct CachedTrack) matchesTerms(terms []string) bool {
+	text := strings.ToLower(ct.Title + " " + ct.Author)
+	for _, term := range terms {
+		if !strings.Contains(text, strings.ToLower(term)) {
+			return false
+		}
+	}
+	return true
+}
+
+// readSidecar reads the sidecar with filename `name` from the cache directory.
+func readSidecar(name string) (CachedTrack, error) {
+	var cached CachedTrack
+	data, err := ioutil.ReadFile(cachePath(name))
+	if err != nil {
+		return cached, err
+	}
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return cached, err
+	}
+	if cached.Filename == "" {
+		return cached, errors.New("The sidecar does not name an audio file")
+	}
+	return cached, nil
+}
+
+// measureLoudness returns the mean volume of the audio file at `path` in dB,
+// as reported by the volumedetect filter of the configured player command.
+func measureLoudness(path string) (float64, error) {
+	command := "ffmpeg"
+	if viper.GetString("defaults.player_command") == "avconv" {
+		command = "avconv"
+	}
+	output, err := exec.Command(command, "-nostats", "-i", path, "-af", "volumedetect", "-f", "null", "-").CombinedOutput()
+	if err != nil {
+		return 0, err
+	}
+	return parseMeanVolume(string(output))
+}
+
+// parseMeanVolume extracts the mean volume from the output of the volumedetect
+// filter.
+func parseMeanVolume(output string) (float64, error) {
+	match := meanVolumeRegex.FindStringSubmatch(output)
+	if match == nil {
+		return 0, errors.New("No mean volume was reported")
+	}
+	return strconv.ParseFloat(match[1], 64)
+}
+
+// cacheKey returns the key under which the track that `url` links to is
+// cached: the service that recognizes the link and the ID it gives the track,
+// so that different links to the same track share a key. Links that no
+// service can identify are normalized instead.
+func cacheKey(url string) string {
+	if service, err := DJ.GetService(url); err == nil {
+		if identifying, ok := service.(interfaces.IdentifyingService); ok {
+			if id, ok := identifying.GetTrackID(url); ok {
+				return service.GetReadableName() + ":" + id
+			}
+			// Playlists are never cached as a whole.
+			return ""
+		}
+	}
+	return normalizeURL(url)
+}
+
+// normalizeURL strips the parts of `url` that do not change what it refers to,
+// such as the scheme and a trailing slash.
+func normalizeURL(url string) string {
+	url = strings.TrimSpace(url)
+	for _, prefix := range []string{"https://", "http://", "www.", "m."} {
+		url = strings.TrimPrefix(url, prefix)
+	}
+	return strings.TrimSuffix(url, "/")
+}
+
+// cachePath returns the path of the file with name `name` in the cache
+// directory.
+func cachePath(name string) string {
+	return os.ExpandEnv(viper.GetString("cache.directory") + "/" + name)
+}
+
+// isSidecar returns true if the file with name `name` is a metadata sidecar
+// rather than an audio file.
+func isSidecar(name string) bool {
+	return strings.HasSuffix(name, sidecarExtension)
+}
@@ -0,0 +1,224 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/sidecar_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type SidecarTestSuite struct {
+	suite.Suite
+	Cache     *Cache
+	Directory string
+	Track     Track
+}
+
+func (suite *SidecarTestSuite) SetupTest() {
+	suite.Directory, _ = ioutil.TempDir("", "mumbledj-cache")
+	suite.Cache = NewCache()
+	suite.Track = Track{
+		ID:           "KQY9zrjPBjo",
+		URL:          "https://youtube.com/watch?v=KQY9zrjPBjo",
+		Title:        "Test Track",
+		Author:       "Test Author",
+		Service:      "YouTube",
+		Filename:     "KQY9zrjPBjo.track",
+		ThumbnailURL: "https://i.ytimg.com/vi/KQY9zrjPBjo/hqdefault.jpg",
+		Duration:     3 * time.Minute,
+	}
+	DJ = NewMumbleDJ()
+	DJ.AvailableServices = []interfaces.Service{identifyingService{formatService{name: "YouTube"}}}
+	viper.Set("cache.directory", suite.Directory)
+	viper.Set("cache.enabled", true)
+	viper.Set("cache.measure_loudness", false)
+	ioutil.WriteFile(filepath.Join(suite.Directory, suite.Track.Filename), []byte("audio"), 0644)
+}
+
+func (suite *SidecarTestSuite) TearDownTest() {
+	os.RemoveAll(suite.Directory)
+	viper.Set("cache.directory", "$HOME/.cache/mumbledj")
+	viper.Set("cache.enabled", false)
+	viper.Set("cache.measure_loudness", true)
+}
+
+func (suite *SidecarTestSuite) TestWriteSidecarAndTracks() {
+	suite.Nil(WriteSidecar(suite.Track))
+
+	tracks := suite.Cache.Tracks()
+
+	suite.Len(tracks, 1)
+	suite.Equal("Test Track", tracks[0].Title)
+	suite.Equal(3*time.Minute, tracks[0].Duration)
+	suite.Equal(suite.Track.ThumbnailURL, tracks[0].ThumbnailURL)
+	suite.Nil(tracks[0].Loudness)
+}
+
+func (suite *SidecarTestSuite) TestTracksSkipsMissingAudio() {
+	suite.Nil(WriteSidecar(suite.Track))
+	os.Remove(filepath.Join(suite.Directory, suite.Track.Filename))
+
+	suite.Len(suite.Cache.Tracks(), 0)
+}
+
+func (suite *SidecarTestSuite) TestFindTrack() {
+	suite.Nil(WriteSidecar(suite.Track))
+
+	cached, ok := suite.Cache.FindTrack("https://youtu.be/KQY9zrjPBjo")
+	suite.True(ok)
+	suite.Equal("test", cached.Track("test").Submitter)
+	suite.Equal("KQY9zrjPBjo.track", cached.Track("test").Filename)
+	suite.Equal(time.Duration(0), cached.Track("test").PlaybackOffset)
+
+	_, ok = suite.Cache.FindTrack("https://youtube.com/watch?v=KQY9zrjPBjoX")
+	suite.False(ok)
+}
+
+func (suite *SidecarTestSuite) TestFindTrackIgnoresPlaylists() {
+	suite.Nil(WriteSidecar(suite.Track))
+
+	_, ok := suite.Cache.FindTrack("https://youtube.com/watch?v=KQY9zrjPBjo&list=PL123")
+
+	suite.False(ok, "A playlist that starts with a cached track should be retrieved as a whole.")
+}
+
+func (suite *SidecarTestSuite) TestFindTrackStartsAtOffset() {
+	suite.Nil(WriteSidecar(suite.Track))
+
+	cached, ok := suite.Cache.FindTrack("https://youtu.be/KQY9zrjPBjo?t=90")
+	suite.True(ok)
+	suite.Equal(90*time.Second, cached.Track("test").PlaybackOffset)
+
+	cached, _ = suite.Cache.FindTrack("https://youtu.be/KQY9zrjPBjo?t=1h")
+	suite.Equal(time.Duration(0), cached.Track("test").PlaybackOffset, "An offset past the end should be ignored.")
+}
+
+func (suite *SidecarTestSuite) TestFindTrackWhenAudioIsDeleted() {
+	suite.Nil(WriteSidecar(suite.Track))
+	_, ok := suite.Cache.FindTrack(suite.Track.URL)
+	suite.True(ok)
+
+	os.Remove(filepath.Join(suite.Directory, suite.Track.Filename))
+	_, ok = suite.Cache.FindTrack(suite.Track.URL)
+
+	suite.False(ok)
+}
+
+func (suite *SidecarTestSuite) TestFindTrackCachedAfterIndexing() {
+	_, ok := suite.Cache.FindTrack(suite.Track.URL)
+	suite.False(ok)
+
+	DJ.Cache = suite.Cache
+	suite.Nil(WriteSidecar(suite.Track))
+	_, ok = suite.Cache.FindTrack("https://youtu.be/KQY9zrjPBjo")
+
+	suite.True(ok, "Tracks cached after the index was built should be found.")
+}
+
+func (suite *SidecarTestSuite) TestFindTrackWhenCachingIsDisabled() {
+	suite.Nil(WriteSidecar(suite.Track))
+	viper.Set("cache.enabled", false)
+
+	_, ok := suite.Cache.FindTrack(suite.Track.URL)
+
+	suite.False(ok)
+}
+
+func (suite *SidecarTestSuite) TestSearch() {
+	suite.Nil(WriteSidecar(suite.Track))
+
+	suite.Len(suite.Cache.Search("test", "test", "AUTHOR"), 1)
+	suite.Len(suite.Cache.Search("test"), 1)
+	suite.Len(suite.Cache.Search("test", "missing"), 0)
+}
+
+func (suite *SidecarTestSuite) TestSearchResult() {
+	suite.Nil(WriteSidecar(suite.Track))
+
+	_, err := suite.Cache.SearchResult("test", 1)
+	suite.NotNil(err)
+
+	suite.Cache.Search("test", "track")
+	cached, err := suite.Cache.SearchResult("test", 1)
+	suite.Nil(err)
+	suite.Equal("KQY9zrjPBjo", cached.ID)
+
+	_, err = suite.Cache.SearchResult("test", 2)
+	suite.NotNil(err)
+	_, err = suite.Cache.SearchResult("other", 1)
+	suite.NotNil(err)
+}
+
+func (suite *SidecarTestSuite) TestFindTrackByLink() {
+	suite.Track.Service = "SoundCloud"
+	suite.Track.URL = "https://soundcloud.com/artist/track"
+	suite.Nil(WriteSidecar(suite.Track))
+
+	_, ok := suite.Cache.FindTrack("http://www.soundcloud.com/artist/track/")
+	suite.True(ok, "Links that no service identifies should be matched as a whole.")
+	_, ok = suite.Cache.FindTrack("https://soundcloud.com/artist/track-123")
+	suite.False(ok)
+}
+
+func (suite *SidecarTestSuite) TestDeleteOldestRemovesSidecar() {
+	suite.Nil(WriteSidecar(suite.Track))
+
+	suite.Nil(suite.Cache.DeleteOldest())
+
+	files, _ := ioutil.ReadDir(suite.Directory)
+	suite.Len(files, 0)
+	suite.NotNil(suite.Cache.DeleteOldest())
+}
+
+func (suite *SidecarTestSuite) TestStatisticsIgnoreSidecars() {
+	suite.Nil(WriteSidecar(suite.Track))
+
+	suite.Cache.UpdateStatistics()
+
+	suite.Equal(1, suite.Cache.NumAudioFiles)
+}
+
+func (suite *SidecarTestSuite) TestParseMeanVolume() {
+	loudness, err := parseMeanVolume("[Parsed_volumedetect_0 @ 0x0] mean_volume: -16.3 dB\n[Parsed_volumedetect_0 @ 0x0] max_volume: -0.5 dB")
+
+	suite.Nil(err)
+	suite.Equal(-16.3, loudness)
+
+	_, err = parseMeanVolume("no output")
+	suite.NotNil(err)
+}
+
+// identifyingService is a service that identifies the videos that YouTube
+// links point to.
+type identifyingService struct {
+	formatService
+}
+
+var identifyingRegex = regexp.MustCompile(`(youtu\.be/|v=)([\w-]{11})([^\w-]|$)`)
+
+func (s identifyingService) CheckURL(url string) bool { return strings.Contains(url, "youtu") }
+func (s identifyingService) GetTrackID(url string) (string, bool) {
+	match := identifyingRegex.FindStringSubmatch(url)
+	if match == nil || strings.Contains(url, "list=") {
+		return "", false
+	}
+	return match[2], true
+}
+
+func TestSidecarTestSuite(t *testing.T) {
+	suite.Run(t, new(SidecarTestSuite))
+}
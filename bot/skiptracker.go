@@ -124,7 +124,12 @@ func (s *SkipTracker) evaluateTrackSkips() {
 	s.trackMutex.RLock()
 	skipRatio := viper.GetFloat64("queue.track_skip_ratio")
 	DJ.Client.Do(func() {
-		if float64(len(s.TrackSkips))/float64(len(DJ.Client.Self.Channel.Users)) >= skipRatio {
+		// Users whose tier cannot vote are not counted.
+		voters := DJ.CountVoters(DJ.Client.Self.Channel.Users, "commands.skip.aliases")
+		if voters < 1 {
+			voters = 1
+		}
+		if float64(len(s.TrackSkips))/float64(voters) >= skipRatio {
 			// Stopping an audio stream triggers a skip.
 			DJ.Queue.StopCurrent()
 		}
@@ -136,7 +141,12 @@ func (s *SkipTracker) evaluatePlaylistSkips() {
 	s.playlistMutex.RLock()
 	skipRatio := viper.GetFloat64("queue.playlist_skip_ratio")
 	DJ.Client.Do(func() {
-		if float64(len(s.PlaylistSkips))/float64(len(DJ.Client.Self.Channel.Users)) >= skipRatio {
+		// Users whose tier cannot vote are not counted.
+		voters := DJ.CountVoters(DJ.Client.Self.Channel.Users, "commands.skipplaylist.aliases")
+		if voters < 1 {
+			voters = 1
+		}
+		if float64(len(s.PlaylistSkips))/float64(voters) >= skipRatio {
 			DJ.Queue.SkipPlaylist()
 		}
 	})
@@ -0,0 +1,77 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/songinfo.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/matthieugrieger/mumbledj/interfaces"
+)
+
+var (
+	// separatorRegex matches the dash that usually separates the artist from
+	// the title, e.g. "Artist - Title" or "Artist – Title".
+	separatorRegex = regexp.MustCompile(`\s+(?:-|–|—|--|~)\s+`)
+
+	// featuringRegex matches a featured artist credit, with or without
+	// surrounding brackets, e.g. "(feat. X)", "ft. X & Y" or "featuring X".
+	featuringRegex = regexp.MustCompile(`(?i)\s*[\(\[]?\s*\b(?:feat\.?|ft\.?|featuring)\s+([^\)\]]+?)\s*(?:[\)\]]|$)`)
+
+	// featuringSplitRegex splits a list of featured artists.
+	featuringSplitRegex = regexp.MustCompile(`\s*(?:,|&)\s*`)
+
+	// channelSuffixRegex matches suffixes that are commonly added to channel
+	// names but are not part of the artist's name.
+	channelSuffixRegex = regexp.MustCompile(`(?i)\s*(?:-\s*topic|vevo|official)$`)
+)
+
+// SongInfo holds the artist and song title parsed from a track title.
+type SongInfo struct {
+	Artist    string
+	Title     string
+	Featuring []string
+}
+
+// ParseSongInfo splits the title of a track into structured artist and title
+// fields. Titles in the common "Artist - Title (feat. X)" form are split on the
+// dash. Otherwise the whole title is used as the song title and the artist is
+// derived from the track's author (usually the channel name), if available.
+func ParseSongInfo(t interfaces.Track) SongInfo {
+	return parseSongInfo(t.GetTitle(), t.GetAuthor())
+}
+
+func parseSongInfo(title, author string) SongInfo {
+	var info SongInfo
+
+	if parts := separatorRegex.Split(title, 2); len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		info.Artist = parts[0]
+		info.Title = parts[1]
+	} else {
+		info.Artist = channelSuffixRegex.ReplaceAllString(strings.TrimSpace(author), "")
+		info.Title = title
+	}
+
+	info.Artist, info.Featuring = extractFeaturing(info.Artist, info.Featuring)
+	info.Title, info.Featuring = extractFeaturing(info.Title, info.Featuring)
+	info.Title = strings.Trim(info.Title, `"'“”`)
+	return info
+}
+
+// extractFeaturing removes featured artist credits from `s` and appends the
+// featured artists to `featuring`.
+func extractFeaturing(s string, featuring []string) (string, []string) {
+	for _, match := range featuringRegex.FindAllStringSubmatch(s, -1) {
+		for _, name := range featuringSplitRegex.Split(match[1], -1) {
+			if name = strings.TrimSpace(name); name != "" {
+				featuring = append(featuring, name)
+			}
+		}
+	}
+	return strings.TrimSpace(featuringRegex.ReplaceAllString(s, "")), featuring
+}
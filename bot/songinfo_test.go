@@ -0,0 +1,60 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/songinfo_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SongInfoTestSuite struct {
+	suite.Suite
+}
+
+func (suite *SongInfoTestSuite) TestParseArtistAndTitle() {
+	info := parseSongInfo("Daft Punk - Get Lucky", "DaftPunkVEVO")
+
+	suite.Equal("Daft Punk", info.Artist)
+	suite.Equal("Get Lucky", info.Title)
+	suite.Empty(info.Featuring)
+}
+
+func (suite *SongInfoTestSuite) TestParseFeaturing() {
+	info := parseSongInfo("Daft Punk - Get Lucky (feat. Pharrell Williams & Nile Rodgers)", "")
+
+	suite.Equal("Daft Punk", info.Artist)
+	suite.Equal("Get Lucky", info.Title)
+	suite.Equal([]string{"Pharrell Williams", "Nile Rodgers"}, info.Featuring)
+}
+
+func (suite *SongInfoTestSuite) TestParseFeaturingInArtist() {
+	info := parseSongInfo("Calvin Harris ft. Rihanna – \"This Is What You Came For\"", "")
+
+	suite.Equal("Calvin Harris", info.Artist)
+	suite.Equal("This Is What You Came For", info.Title)
+	suite.Equal([]string{"Rihanna"}, info.Featuring)
+}
+
+func (suite *SongInfoTestSuite) TestParseWithoutSeparatorUsesAuthor() {
+	info := parseSongInfo("Bohemian Rhapsody", "Queen - Topic")
+
+	suite.Equal("Queen", info.Artist)
+	suite.Equal("Bohemian Rhapsody", info.Title)
+}
+
+func (suite *SongInfoTestSuite) TestParseHyphenatedWordIsNotSeparator() {
+	info := parseSongInfo("Re-Education Through Labor", "")
+
+	suite.Equal("", info.Artist)
+	suite.Equal("Re-Education Through Labor", info.Title)
+}
+
+func TestSongInfoTestSuite(t *testing.T) {
+	suite.Run(t, new(SongInfoTestSuite))
+}
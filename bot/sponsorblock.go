@@ -0,0 +1,180 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/sponsorblock.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+const (
+	// maxSponsorBlockVideos is the number of videos whose segments are kept
+	// in memory.
+	maxSponsorBlockVideos = 100
+
+	// minSegmentLength is the shortest segment that is skipped. Skipping
+	// restarts the player, which is not worth it for a moment of audio.
+	minSegmentLength = time.Second
+)
+
+// sponsorBlockClient retrieves segments. Tracks start playing before their
+// segments are known, so a slow answer only delays the first skip.
+var sponsorBlockClient = &http.Client{Timeout: 5 * time.Second}
+
+// Segment is a part of a video that SponsorBlock users have marked as being
+// in a category, such as "sponsor" or "intro".
+type Segment struct {
+	Start    time.Duration
+	End      time.Duration
+	Category string
+}
+
+// SponsorBlock looks up the segments of YouTube videos in the SponsorBlock
+// database, so that sponsor messages, intros and other parts that are not
+// music can be skipped during playback. https://sponsor.ajay.app
+type SponsorBlock struct {
+	byVideo map[string][]Segment
+	mutex   sync.Mutex
+}
+
+// NewSponsorBlock returns a SponsorBlock that has not looked up any video yet.
+func NewSponsorBlock() *SponsorBlock {
+	return &SponsorBlock{
+		byVideo: make(map[string][]Segment),
+	}
+}
+
+// Segments returns the segments of `track` in the categories listed in
+// sponsorblock.categories, ordered by where they start. Nil is returned if
+// sponsorblock.enabled is off, the track is not a YouTube video or its
+// segments cannot be retrieved.
+func (s *SponsorBlock) Segments(track interfaces.Track) []Segment {
+	if !viper.GetBool("sponsorblock.enabled") || track.IsLive() {
+		return nil
+	}
+	id := youtubeVideoID(track.GetURL())
+	if id == "" {
+		return nil
+	}
+	s.mutex.Lock()
+	segments, ok := s.byVideo[id]
+	s.mutex.Unlock()
+	if ok {
+		return segments
+	}
+
+	segments, err := fetchSegments(id, viper.GetStringSlice("sponsorblock.categories"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"id":    id,
+			"error": err.Error(),
+		}).Warnln("Could not retrieve the SponsorBlock segments of a video.")
+		return nil
+	}
+
+	s.mutex.Lock()
+	if len(s.byVideo) >= maxSponsorBlockVideos {
+		s.byVideo = make(map[string][]Segment)
+	}
+	s.byVideo[id] = segments
+	s.mutex.Unlock()
+	return segments
+}
+
+// fetchSegments retrieves the segments of the YouTube video `id` in
+// `categories` from sponsorblock.api_url.
+func fetchSegments(id string, categories []string) ([]Segment, error) {
+	encoded, err := json.Marshal(categories)
+	if err != nil {
+		return nil, err
+	}
+	params := neturl.Values{}
+	params.Set("videoID", id)
+	params.Set("categories", string(encoded))
+	response, err := sponsorBlockClient.Get(strings.TrimSuffix(viper.GetString("sponsorblock.api_url"), "/") +
+		"/api/skipSegments?" + params.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	// Videos without segments are reported as not found.
+	if response.StatusCode == http.StatusNotFound {
+		return []Segment{}, nil
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("SponsorBlock returned status %s", response.Status)
+	}
+
+	var results []struct {
+		Segment  []float64 `json:"segment"`
+		Category string    `json:"category"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&results); err != nil {
+		return nil, err
+	}
+	segments := make([]Segment, 0, len(results))
+	for _, result := range results {
+		if len(result.Segment) != 2 {
+			continue
+		}
+		segment := Segment{
+			Start:    time.Duration(result.Segment[0] * float64(time.Second)),
+			End:      time.Duration(result.Segment[1] * float64(time.Second)),
+			Category: result.Category,
+		}
+		if segment.End-segment.Start >= minSegmentLength {
+			segments = append(segments, segment)
+		}
+	}
+	sort.Sort(sortSegments(segments))
+	return segments, nil
+}
+
+// segmentAt returns the segment that `position` lies in, if any.
+func segmentAt(segments []Segment, position time.Duration) (Segment, bool) {
+	for _, segment := range segments {
+		if position >= segment.Start && position < segment.End {
+			return segment, true
+		}
+	}
+	return Segment{}, false
+}
+
+// youtubeVideoID returns the ID of the YouTube video that `link` points to,
+// or an empty string if it is not a link to a YouTube video.
+func youtubeVideoID(link string) string {
+	parsed, err := neturl.Parse(link)
+	if err != nil {
+		return ""
+	}
+	host := strings.TrimPrefix(strings.TrimPrefix(parsed.Host, "www."), "music.")
+	switch host {
+	case "youtube.com":
+		return parsed.Query().Get("v")
+	case "youtu.be":
+		return strings.Trim(parsed.Path, "/")
+	}
+	return ""
+}
+
+// sortSegments sorts segments by where they start.
+type sortSegments []Segment
+
+func (a sortSegments) Len() int           { return len(a) }
+func (a sortSegments) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a sortSegments) Less(i, j int) bool { return a[i].Start < a[j].Start }
@@ -0,0 +1,104 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/sponsorblock_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type SponsorBlockTestSuite struct {
+	suite.Suite
+	SponsorBlock *SponsorBlock
+	Server       *httptest.Server
+	Requests     int
+}
+
+func (suite *SponsorBlockTestSuite) SetupTest() {
+	viper.Set("sponsorblock.enabled", true)
+	viper.Set("sponsorblock.categories", []string{"sponsor", "intro"})
+	suite.SponsorBlock = NewSponsorBlock()
+	suite.Requests = 0
+	suite.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Requests++
+		suite.Equal(`["sponsor","intro"]`, r.URL.Query().Get("categories"))
+		if r.URL.Query().Get("videoID") != "KQY9zrjPBjo" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`[
+			{"segment": [95.5, 120], "category": "sponsor", "UUID": "b"},
+			{"segment": [0, 12.25], "category": "intro", "UUID": "a"},
+			{"segment": [200, 200.5], "category": "sponsor", "UUID": "c"}
+		]`))
+	}))
+	viper.Set("sponsorblock.api_url", suite.Server.URL+"/")
+}
+
+func (suite *SponsorBlockTestSuite) TearDownTest() {
+	suite.Server.Close()
+}
+
+func (suite *SponsorBlockTestSuite) TestSegments() {
+	track := Track{URL: "https://youtube.com/watch?v=KQY9zrjPBjo"}
+
+	segments := suite.SponsorBlock.Segments(track)
+
+	suite.Equal([]Segment{
+		{Start: 0, End: 12250 * time.Millisecond, Category: "intro"},
+		{Start: 95500 * time.Millisecond, End: 120 * time.Second, Category: "sponsor"},
+	}, segments, "Segments should be ordered, and very short ones left out.")
+	suite.SponsorBlock.Segments(track)
+	suite.Equal(1, suite.Requests, "Segments should only be retrieved once.")
+}
+
+func (suite *SponsorBlockTestSuite) TestVideoWithoutSegments() {
+	suite.Empty(suite.SponsorBlock.Segments(Track{URL: "https://youtu.be/dQw4w9WgXcQ"}))
+	suite.Equal(1, suite.Requests)
+}
+
+func (suite *SponsorBlockTestSuite) TestOnlyYouTubeVideos() {
+	suite.Nil(suite.SponsorBlock.Segments(Track{URL: "https://soundcloud.com/artist/track"}))
+	suite.Nil(suite.SponsorBlock.Segments(Track{URL: "https://youtube.com/watch?v=KQY9zrjPBjo", Live: true}))
+	suite.Zero(suite.Requests)
+}
+
+func (suite *SponsorBlockTestSuite) TestDisabled() {
+	viper.Set("sponsorblock.enabled", false)
+
+	suite.Nil(suite.SponsorBlock.Segments(Track{URL: "https://youtube.com/watch?v=KQY9zrjPBjo"}))
+	suite.Zero(suite.Requests)
+}
+
+func (suite *SponsorBlockTestSuite) TestSegmentAt() {
+	segments := []Segment{{Start: 10 * time.Second, End: 20 * time.Second, Category: "sponsor"}}
+
+	segment, ok := segmentAt(segments, 15*time.Second)
+	suite.True(ok)
+	suite.Equal("sponsor", segment.Category)
+	_, ok = segmentAt(segments, 20*time.Second)
+	suite.False(ok, "Playback resumes at the end of the segment.")
+	_, ok = segmentAt(segments, 5*time.Second)
+	suite.False(ok)
+}
+
+func (suite *SponsorBlockTestSuite) TestYouTubeVideoID() {
+	suite.Equal("KQY9zrjPBjo", youtubeVideoID("https://www.youtube.com/watch?v=KQY9zrjPBjo&t=30s"))
+	suite.Equal("KQY9zrjPBjo", youtubeVideoID("https://music.youtube.com/watch?v=KQY9zrjPBjo"))
+	suite.Equal("KQY9zrjPBjo", youtubeVideoID("https://youtu.be/KQY9zrjPBjo"))
+	suite.Equal("", youtubeVideoID("https://vimeo.com/12345"))
+}
+
+func TestSponsorBlockTestSuite(t *testing.T) {
+	suite.Run(t, new(SponsorBlockTestSuite))
+}
@@ -23,16 +23,24 @@ func PerformStartupChecks() {
 		"num_services": fmt.Sprintf("%d", len(DJ.AvailableServices)),
 	}).Infoln("Checking for availability of services...")
 
-	for i := len(DJ.AvailableServices) - 1; i >= 0; i-- {
-		if err := DJ.AvailableServices[i].CheckAPIKey(); err != nil {
-			name := DJ.AvailableServices[i].GetReadableName()
-			logrus.WithFields(logrus.Fields{
-				"service": name,
-				"error":   err.Error(),
-			}).Warnln("A startup check discovered an issue. The service will be disabled.")
-
-			// Remove service from enabled services.
-			DJ.AvailableServices = append(DJ.AvailableServices[:i], DJ.AvailableServices[i+1:]...)
+	if IsReplayMode() {
+		// API keys are not needed to replay fixtures, so every service stays enabled.
+		logrus.WithFields(logrus.Fields{
+			"fixtures_directory": viper.GetString("dev.fixtures_directory"),
+		}).Warnln("Running in replay mode. API responses are served from recorded fixtures and downloads are replaced with dummy audio.")
+	} else {
+		for i := len(DJ.AvailableServices) - 1; i >= 0; i-- {
+			if err := DJ.AvailableServices[i].CheckAPIKey(); err != nil {
+				name := DJ.AvailableServices[i].GetReadableName()
+				logrus.WithFields(logrus.Fields{
+					"service": name,
+					"error":   err.Error(),
+				}).Warnln("A startup check discovered an issue. The service will be disabled.")
+
+				// Remove service from enabled services.
+				DJ.DisabledServices[DJ.AvailableServices[i]] = err
+				DJ.AvailableServices = append(DJ.AvailableServices[:i], DJ.AvailableServices[i+1:]...)
+			}
 		}
 	}
 
@@ -40,8 +48,8 @@ func PerformStartupChecks() {
 		logrus.Fatalln("The bot cannot continue as no services are enabled.")
 	}
 
-	if err := checkYouTubeDLInstallation(); err != nil {
-		logrus.Fatalln("youtube-dl is either not installed or is not discoverable in $PATH. youtube-dl is required to download audio.")
+	if err := checkYouTubeDLInstallation(); err != nil && !IsReplayMode() {
+		logrus.Fatalln(downloaderCommand() + " is either not installed or is not discoverable in $PATH. youtube-dl, or a compatible fork such as yt-dlp set in downloads.command, is required to download audio.")
 	}
 	if viper.GetString("defaults.player_command") == "ffmpeg" {
 		if err := checkFfmpegInstallation(); err != nil {
@@ -55,6 +63,10 @@ func PerformStartupChecks() {
 		logrus.Fatalln("The player command provided in the configuration file is invalid. Valid choices are: \"ffmpeg\", \"avconv\".")
 	}
 
+	if _, ok := latencyProfiles[viper.GetString("output.latency")]; !ok {
+		logrus.Fatalln("The latency profile provided in the configuration file is invalid. Valid choices are: \"low\", \"normal\", \"high\".")
+	}
+
 	if err := checkAria2Installation(); err != nil {
 		logrus.Warnln("aria2 is not installed or is not discoverable in $PATH. The bot will still partially work, but some services will not work properly.")
 	}
@@ -66,7 +78,7 @@ func PerformStartupChecks() {
 
 func checkYouTubeDLInstallation() error {
 	logrus.Infoln("Checking YouTubeDL installation...")
-	command := exec.Command("youtube-dl", "--version")
+	command := exec.Command(downloaderCommand(), "--version")
 	if err := command.Run(); err != nil {
 		return errors.New("youtube-dl is not properly installed")
 	}
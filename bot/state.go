@@ -0,0 +1,196 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/state.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// State holds the parts of the bot's state that are written to disk before
+// the bot shuts down or restarts.
+type State struct {
+	Queue           []SavedTrack      `json:"queue"`
+	Languages       map[string]string `json:"languages,omitempty"`
+	Notifications   map[string]bool   `json:"notifications,omitempty"`
+	PrivateAnnounce map[string]bool   `json:"private_announce,omitempty"`
+	Intros          map[string]bool   `json:"intros,omitempty"`
+	ShuffleAdds     map[string]bool   `json:"shuffle_adds,omitempty"`
+}
+
+// SavedTrack is a serializable representation of a track in the queue.
+type SavedTrack struct {
+	ID                string        `json:"id"`
+	URL               string        `json:"url"`
+	Title             string        `json:"title"`
+	Author            string        `json:"author"`
+	AuthorURL         string        `json:"author_url"`
+	Submitter         string        `json:"submitter"`
+	Service           string        `json:"service"`
+	Filename          string        `json:"filename"`
+	ThumbnailURL      string        `json:"thumbnail_url"`
+	Duration          time.Duration `json:"duration"`
+	PlaybackOffset    time.Duration `json:"playback_offset"`
+	End               time.Duration `json:"end,omitempty"`
+	Live              bool          `json:"live,omitempty"`
+	PlaylistID        string        `json:"playlist_id,omitempty"`
+	PlaylistTitle     string        `json:"playlist_title,omitempty"`
+	PlaylistSubmitter string        `json:"playlist_submitter,omitempty"`
+	PlaylistService   string        `json:"playlist_service,omitempty"`
+}
+
+// SaveState writes the tracks currently in the queue and the preferences of
+// each user to the state file. The current track is saved with its playback
+// position so that it resumes where it left off.
+func (dj *MumbleDJ) SaveState() error {
+	state := State{
+		Queue:           make([]SavedTrack, 0),
+		Languages:       dj.Languages.Preferences(),
+		Notifications:   dj.Notifications.Preferences(),
+		PrivateAnnounce: dj.PrivateAnnounce.Preferences(),
+		Intros:          dj.Intros.Preferences(),
+		ShuffleAdds:     dj.ShuffleAdds.Preferences(),
+	}
+
+	// The current track has not started yet while its intro is playing.
+	queue, ok := dj.Queue.(*Queue)
+	playingIntro := ok && queue.PlayingIntro()
+
+	dj.Queue.Traverse(func(i int, t interfaces.Track) {
+		saved := SavedTrack{
+			ID:             t.GetID(),
+			URL:            t.GetURL(),
+			Title:          t.GetTitle(),
+			Author:         t.GetAuthor(),
+			AuthorURL:      t.GetAuthorURL(),
+			Submitter:      t.GetSubmitter(),
+			Service:        t.GetService(),
+			Filename:       t.GetFilename(),
+			ThumbnailURL:   t.GetThumbnailURL(),
+			Duration:       t.GetDuration(),
+			PlaybackOffset: t.GetPlaybackOffset(),
+			End:            playbackEnd(t),
+			Live:           t.IsLive(),
+		}
+		if i == 0 && dj.AudioStream != nil && !t.IsLive() && !playingIntro {
+			saved.PlaybackOffset += dj.AudioStream.Elapsed()
+		}
+		if playlist := t.GetPlaylist(); playlist != nil {
+			saved.PlaylistID = playlist.GetID()
+			saved.PlaylistTitle = playlist.GetTitle()
+			saved.PlaylistSubmitter = playlist.GetSubmitter()
+			saved.PlaylistService = playlist.GetService()
+		}
+		state.Queue = append(state.Queue, saved)
+	})
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	filePath := os.ExpandEnv(viper.GetString("state.file"))
+	if err := os.MkdirAll(filepath.Dir(filePath), 0777); err != nil {
+		return err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"file_path":  filePath,
+		"num_tracks": len(state.Queue),
+	}).Infoln("Saving state...")
+	return ioutil.WriteFile(filePath, data, 0644)
+}
+
+// RestoreState reads the state file written by SaveState, if one exists,
+// restores user preferences and appends the saved tracks to the queue. The state file is removed afterwards
+// so the same tracks are not restored twice.
+func (dj *MumbleDJ) RestoreState() error {
+	filePath := os.ExpandEnv(viper.GetString("state.file"))
+	data, err := ioutil.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer os.Remove(filePath)
+
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"file_path":  filePath,
+		"num_tracks": len(state.Queue),
+	}).Infoln("Restoring state...")
+
+	for name, code := range state.Languages {
+		if err := dj.Languages.Set(name, code); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"user":     name,
+				"language": code,
+				"error":    err.Error(),
+			}).Warnln("Could not restore language preference.")
+		}
+	}
+
+	for name, enabled := range state.Notifications {
+		dj.Notifications.Set(name, enabled)
+	}
+	for name, enabled := range state.PrivateAnnounce {
+		dj.PrivateAnnounce.Set(name, enabled)
+	}
+	for name, enabled := range state.Intros {
+		dj.Intros.Set(name, enabled)
+	}
+	for name, enabled := range state.ShuffleAdds {
+		dj.ShuffleAdds.Set(name, enabled)
+	}
+
+	playlists := make(map[string]*Playlist)
+	for _, saved := range state.Queue {
+		track := Track{
+			ID:             saved.ID,
+			URL:            saved.URL,
+			Title:          saved.Title,
+			Author:         saved.Author,
+			AuthorURL:      saved.AuthorURL,
+			Submitter:      saved.Submitter,
+			Service:        saved.Service,
+			Filename:       saved.Filename,
+			ThumbnailURL:   saved.ThumbnailURL,
+			Duration:       saved.Duration,
+			PlaybackOffset: saved.PlaybackOffset,
+			End:            saved.End,
+			Live:           saved.Live,
+		}
+		if saved.PlaylistID != "" {
+			playlist, ok := playlists[saved.PlaylistID]
+			if !ok {
+				playlist = &Playlist{
+					ID:        saved.PlaylistID,
+					Title:     saved.PlaylistTitle,
+					Submitter: saved.PlaylistSubmitter,
+					Service:   saved.PlaylistService,
+				}
+				playlists[saved.PlaylistID] = playlist
+			}
+			track.Playlist = playlist
+		}
+		dj.Queue.AppendTrack(track)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/state_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/layeh/gumble/gumbleffmpeg"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type StateTestSuite struct {
+	suite.Suite
+	Directory string
+}
+
+func (suite *StateTestSuite) SetupSuite() {
+	DJ = NewMumbleDJ()
+
+	// Trick the tests into thinking audio is already playing to avoid
+	// attempting to play tracks that don't exist.
+	DJ.AudioStream = new(gumbleffmpeg.Stream)
+
+	viper.Set("queue.automatic_shuffle_on", false)
+	viper.Set("queue.max_track_duration", 0)
+}
+
+func (suite *StateTestSuite) SetupTest() {
+	DJ.Queue = NewQueue()
+	suite.Directory, _ = ioutil.TempDir("", "mumbledj")
+	viper.Set("state.file", filepath.Join(suite.Directory, "state.json"))
+}
+
+func (suite *StateTestSuite) TearDownTest() {
+	os.RemoveAll(suite.Directory)
+}
+
+func (suite *StateTestSuite) TestRestoreStateWhenNoFileExists() {
+	err := DJ.RestoreState()
+
+	suite.Nil(err, "No error should be returned when there is no state to restore.")
+	suite.Zero(DJ.Queue.Length(), "The queue should still be empty.")
+}
+
+func (suite *StateTestSuite) TestSaveAndRestoreState() {
+	playlist := &Playlist{ID: "playlist", Title: "Playlist"}
+	duration, _ := time.ParseDuration("3m")
+	DJ.Queue.AppendTrack(Track{ID: "first", Title: "First", Duration: duration})
+	DJ.Queue.AppendTrack(Track{ID: "second", Playlist: playlist})
+	DJ.Queue.AppendTrack(Track{ID: "third", Playlist: playlist})
+
+	err := DJ.SaveState()
+	suite.Nil(err, "No error should be returned when saving the state.")
+
+	DJ.Queue.Reset()
+	err = DJ.RestoreState()
+
+	suite.Nil(err, "No error should be returned when restoring the state.")
+	suite.Equal(3, DJ.Queue.Length(), "All saved tracks should be restored.")
+	suite.Equal("first", DJ.Queue.GetTrack(0).GetID())
+	suite.Equal("First", DJ.Queue.GetTrack(0).GetTitle())
+	suite.Equal(duration, DJ.Queue.GetTrack(0).GetDuration())
+	suite.Nil(DJ.Queue.GetTrack(0).GetPlaylist(), "Tracks without a playlist should not be given one.")
+	suite.Equal("playlist", DJ.Queue.GetTrack(1).GetPlaylist().GetID())
+	suite.True(DJ.Queue.GetTrack(1).GetPlaylist() == DJ.Queue.GetTrack(2).GetPlaylist(),
+		"Tracks from the same playlist should share a playlist.")
+
+	_, err = os.Stat(viper.GetString("state.file"))
+	suite.True(os.IsNotExist(err), "The state file should be removed after it is restored.")
+}
+
+func TestStateTestSuite(t *testing.T) {
+	suite.Run(t, new(StateTestSuite))
+}
@@ -0,0 +1,107 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/tags.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// AudioTags holds the metadata embedded in an audio file, such as ID3 tags
+// in MP3 files or Vorbis comments in Ogg and FLAC files.
+type AudioTags struct {
+	Title    string
+	Artist   string
+	Duration time.Duration
+}
+
+// ReadTags returns the tags of the audio file at `path`, as reported by the
+// probe command that accompanies the configured player command.
+func ReadTags(path string) (AudioTags, error) {
+	command := "ffprobe"
+	if viper.GetString("defaults.player_command") == "avconv" {
+		command = "avprobe"
+	}
+	output, err := exec.Command(command, "-v", "quiet", "-print_format", "json", "-show_format", path).Output()
+	if err != nil {
+		return AudioTags{}, err
+	}
+	return parseProbeOutput(output)
+}
+
+// parseProbeOutput extracts the tags from the JSON format description printed
+// by ffprobe. Tag names are matched case-insensitively, as their case depends
+// on the container.
+func parseProbeOutput(output []byte) (AudioTags, error) {
+	var probe struct {
+		Format struct {
+			Duration string            `json:"duration"`
+			Tags     map[string]string `json:"tags"`
+		} `json:"format"`
+	}
+	if err := json.Unmarshal(output, &probe); err != nil {
+		return AudioTags{}, err
+	}
+
+	var tags AudioTags
+	for name, value := range probe.Format.Tags {
+		switch strings.ToLower(name) {
+		case "title":
+			tags.Title = strings.TrimSpace(value)
+		case "artist":
+			tags.Artist = strings.TrimSpace(value)
+		}
+	}
+	if probe.Format.Duration != "" {
+		tags.Duration, _ = time.ParseDuration(fmt.Sprintf("%ss", probe.Format.Duration))
+	}
+	return tags, nil
+}
+
+// WithTags returns a copy of the track with its title, author and duration
+// replaced by those in `tags` that are present.
+func (t Track) WithTags(tags AudioTags) Track {
+	if tags.Title != "" {
+		t.Title = tags.Title
+	}
+	if tags.Artist != "" {
+		t.Author = tags.Artist
+	}
+	if tags.Duration > 0 {
+		t.Duration = tags.Duration
+	}
+	t.TagsFromFile = false
+	return t
+}
+
+// applyTags returns `t` with the metadata from the tags of its downloaded
+// audio file if the track takes its metadata from the file, along with true.
+// Otherwise, or if the tags cannot be read, `t` is returned unchanged along
+// with false.
+func applyTags(t interfaces.Track) (interfaces.Track, bool) {
+	track, ok := t.(Track)
+	if !ok || !track.TagsFromFile {
+		return t, false
+	}
+	tags, err := ReadTags(cachePath(track.Filename))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"filename": track.Filename,
+			"error":    err.Error(),
+		}).Warnln("Could not read the tags of a downloaded track.")
+		return t, false
+	}
+	return track.WithTags(tags), true
+}
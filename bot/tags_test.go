@@ -0,0 +1,62 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/tags_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TagsTestSuite struct {
+	suite.Suite
+}
+
+func (suite *TagsTestSuite) TestParseProbeOutput() {
+	output := `{"format": {"duration": "215.500000", "tags": {"TITLE": "Test Title", "Artist": "Test Artist"}}}`
+
+	tags, err := parseProbeOutput([]byte(output))
+
+	suite.Nil(err)
+	suite.Equal("Test Title", tags.Title)
+	suite.Equal("Test Artist", tags.Artist)
+	suite.Equal(215500*time.Millisecond, tags.Duration)
+}
+
+func (suite *TagsTestSuite) TestParseProbeOutputWithoutTags() {
+	tags, err := parseProbeOutput([]byte(`{"format": {"duration": "10.0"}}`))
+
+	suite.Nil(err)
+	suite.Equal("", tags.Title)
+	suite.Equal(10*time.Second, tags.Duration)
+
+	_, err = parseProbeOutput([]byte("not json"))
+	suite.NotNil(err)
+}
+
+func (suite *TagsTestSuite) TestWithTags() {
+	track := Track{Title: "song.mp3", Author: "example.com", TagsFromFile: true}
+
+	tagged := track.WithTags(AudioTags{Title: "Song", Duration: time.Minute})
+
+	suite.Equal("Song", tagged.Title)
+	suite.Equal("example.com", tagged.Author)
+	suite.Equal(time.Minute, tagged.Duration)
+	suite.False(tagged.TagsFromFile)
+}
+
+func (suite *TagsTestSuite) TestApplyTagsIgnoresOtherTracks() {
+	_, ok := applyTags(Track{Title: "Test"})
+
+	suite.False(ok)
+}
+
+func TestTagsTestSuite(t *testing.T) {
+	suite.Run(t, new(TagsTestSuite))
+}
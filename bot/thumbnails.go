@@ -0,0 +1,177 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/thumbnails.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	// Thumbnails may be in any of these formats.
+	_ "image/gif"
+	_ "image/png"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+const (
+	// maxThumbnails is the number of embedded thumbnails kept in memory.
+	maxThumbnails = 100
+
+	// maxThumbnailSize is the largest embedded thumbnail in bytes. Mumble
+	// servers refuse messages with larger images by default.
+	maxThumbnailSize = 64 * 1024
+
+	// maxDownloadSize is the largest image in bytes that is downloaded to be
+	// resized, and maxImagePixels the most pixels it may have once decoded.
+	maxDownloadSize = 5 * 1024 * 1024
+	maxImagePixels  = 4096 * 4096
+)
+
+// thumbnailClient retrieves thumbnails. Announcements wait for the thumbnail,
+// so slow image hosts are given up on quickly.
+var thumbnailClient = &http.Client{Timeout: 5 * time.Second}
+
+// Thumbnails downloads the thumbnails of tracks once, resizes them to
+// thumbnails.width pixels wide and keeps them as data URIs, so that
+// announcements show images of the same size without every client loading
+// them from the image host.
+type Thumbnails struct {
+	byURL map[string]string
+	mutex sync.Mutex
+}
+
+// NewThumbnails returns a Thumbnails that holds no images.
+func NewThumbnails() *Thumbnails {
+	return &Thumbnails{
+		byURL: make(map[string]string),
+	}
+}
+
+// Source returns what to put in the src attribute of the image tag that shows
+// the thumbnail at `url`: the resized image as a data URI if thumbnails.embed
+// is enabled, and otherwise, or if the image cannot be retrieved, `url`.
+func (t *Thumbnails) Source(url string) string {
+	if !viper.GetBool("thumbnails.embed") {
+		return url
+	}
+	t.mutex.Lock()
+	source, ok := t.byURL[url]
+	t.mutex.Unlock()
+	if ok {
+		return source
+	}
+
+	source, err := embedThumbnail(url, viper.GetInt("thumbnails.width"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"url":   url,
+			"error": err.Error(),
+		}).Warnln("Could not embed a thumbnail, linking to it instead.")
+		return url
+	}
+
+	t.mutex.Lock()
+	// The thumbnails of tracks that have played are rarely needed again.
+	if len(t.byURL) >= maxThumbnails {
+		t.byURL = make(map[string]string)
+	}
+	t.byURL[url] = source
+	t.mutex.Unlock()
+	return source
+}
+
+// ImageTag returns the image tag that shows the thumbnail at `url` in
+// announcements.
+func (t *Thumbnails) ImageTag(url string) string {
+	return fmt.Sprintf(`<img src="%s" width=%d />`, t.Source(url), viper.GetInt("thumbnails.width"))
+}
+
+// embedThumbnail downloads the image at `url`, resizes it to `width` pixels
+// wide and returns it as a JPEG data URI.
+func embedThumbnail(url string, width int) (string, error) {
+	response, err := thumbnailClient.Get(url)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("The image host returned status %s", response.Status)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(response.Body, maxDownloadSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(data) > maxDownloadSize {
+		return "", fmt.Errorf("The image is larger than the %d bytes allowed", maxDownloadSize)
+	}
+	// Small files may still decode to huge images, so their size is checked
+	// before they are decoded.
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return "", fmt.Errorf("The image is %dx%d pixels, more than the %d allowed", config.Width, config.Height, maxImagePixels)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+
+	var encoded bytes.Buffer
+	if err := jpeg.Encode(&encoded, resizeImage(img, width), &jpeg.Options{Quality: 80}); err != nil {
+		return "", err
+	}
+	if encoded.Len() > maxThumbnailSize {
+		return "", fmt.Errorf("The resized image is %d bytes, more than the %d allowed", encoded.Len(), maxThumbnailSize)
+	}
+	return "data:image/jpeg;base64," + base64.StdEncoding.EncodeToString(encoded.Bytes()), nil
+}
+
+// resizeImage scales `img` to `width` pixels wide, keeping its aspect ratio.
+// Each pixel is the average of the pixels it covers in the original. Images
+// that are narrower already are returned unchanged.
+func resizeImage(img image.Image, width int) image.Image {
+	bounds := img.Bounds()
+	if width <= 0 || bounds.Dx() <= width {
+		return img
+	}
+	height := bounds.Dy() * width / bounds.Dx()
+	if height < 1 {
+		height = 1
+	}
+
+	resized := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := bounds.Min.Y + (y+1)*bounds.Dy()/height
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := bounds.Min.X + (x+1)*bounds.Dx()/width
+			var r, g, b, a, n uint32
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r, g, b, a, n = r+pr, g+pg, b+pb, a+pa, n+1
+				}
+			}
+			resized.Set(x, y, color.RGBA64{uint16(r / n), uint16(g / n), uint16(b / n), uint16(a / n)})
+		}
+	}
+	return resized
+}
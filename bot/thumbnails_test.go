@@ -0,0 +1,126 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/thumbnails_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type ThumbnailsTestSuite struct {
+	suite.Suite
+	Thumbnails *Thumbnails
+	Server     *httptest.Server
+	Requests   int
+}
+
+func (suite *ThumbnailsTestSuite) SetupTest() {
+	viper.Set("thumbnails.embed", true)
+	viper.Set("thumbnails.width", 150)
+	suite.Thumbnails = NewThumbnails()
+	suite.Requests = 0
+	suite.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Requests++
+		switch r.URL.Path {
+		case "/thumbnail.png":
+			png.Encode(w, image.NewRGBA(image.Rect(0, 0, 480, 360)))
+		case "/huge.png":
+			w.Write(pngHeader(100000, 100000))
+		case "/large.png":
+			w.Write(make([]byte, maxDownloadSize+1))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func (suite *ThumbnailsTestSuite) TearDownTest() {
+	suite.Server.Close()
+}
+
+func (suite *ThumbnailsTestSuite) TestResizeImage() {
+	resized := resizeImage(image.NewRGBA(image.Rect(0, 0, 480, 360)), 150)
+
+	suite.Equal(150, resized.Bounds().Dx())
+	suite.Equal(112, resized.Bounds().Dy(), "The aspect ratio should be kept.")
+}
+
+func (suite *ThumbnailsTestSuite) TestSmallImagesAreNotEnlarged() {
+	img := image.NewRGBA(image.Rect(0, 0, 100, 100))
+
+	suite.Equal(img, resizeImage(img, 150))
+}
+
+func (suite *ThumbnailsTestSuite) TestSourceEmbedsImageOnce() {
+	url := suite.Server.URL + "/thumbnail.png"
+
+	source := suite.Thumbnails.Source(url)
+	suite.True(strings.HasPrefix(source, "data:image/jpeg;base64,"))
+	suite.Equal(source, suite.Thumbnails.Source(url))
+	suite.Equal(1, suite.Requests, "The thumbnail should only be downloaded once.")
+}
+
+func (suite *ThumbnailsTestSuite) TestSourceFallsBackToURL() {
+	url := suite.Server.URL + "/missing.png"
+
+	suite.Equal(url, suite.Thumbnails.Source(url))
+}
+
+func (suite *ThumbnailsTestSuite) TestEmbedThumbnailRejectsHugeImages() {
+	_, err := embedThumbnail(suite.Server.URL+"/huge.png", 150)
+
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "pixels", "The size should be checked before the image is decoded.")
+}
+
+func (suite *ThumbnailsTestSuite) TestEmbedThumbnailRejectsLargeDownloads() {
+	_, err := embedThumbnail(suite.Server.URL+"/large.png", 150)
+
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "bytes")
+}
+
+func (suite *ThumbnailsTestSuite) TestSourceWithEmbeddingDisabled() {
+	viper.Set("thumbnails.embed", false)
+	defer viper.Set("thumbnails.embed", true)
+	url := suite.Server.URL + "/thumbnail.png"
+
+	suite.Equal(url, suite.Thumbnails.Source(url))
+	suite.Zero(suite.Requests)
+}
+
+// pngHeader returns the start of a PNG file that claims to be `width` by
+// `height` pixels, which is all that is read to learn its size.
+func pngHeader(width, height uint32) []byte {
+	var header bytes.Buffer
+	header.WriteString("\x89PNG\r\n\x1a\n")
+	chunk := make([]byte, 17)
+	copy(chunk, "IHDR")
+	binary.BigEndian.PutUint32(chunk[4:], width)
+	binary.BigEndian.PutUint32(chunk[8:], height)
+	chunk[12] = 8 // Bit depth.
+	chunk[13] = 6 // Color type: RGBA.
+	binary.Write(&header, binary.BigEndian, uint32(13))
+	header.Write(chunk)
+	binary.Write(&header, binary.BigEndian, crc32.ChecksumIEEE(chunk))
+	return header.Bytes()
+}
+
+func TestThumbnailsTestSuite(t *testing.T) {
+	suite.Run(t, new(ThumbnailsTestSuite))
+}
@@ -0,0 +1,67 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/tiers.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// TierOf returns the name of the tier `user` belongs to, or an empty string if
+// they do not belong to any. Users belong to the first tier in tiers.names
+// whose members include them, and otherwise to the tier set in
+// tiers.registered or tiers.unregistered.
+func (dj *MumbleDJ) TierOf(user *gumble.User) string {
+	if !viper.GetBool("tiers.enabled") {
+		return ""
+	}
+	for _, tier := range viper.GetStringSlice("tiers.names") {
+		for _, member := range viper.GetStringSlice("tiers." + tier + ".members") {
+			if user.Name == member {
+				return tier
+			}
+		}
+	}
+	if user.IsRegistered() {
+		return viper.GetString("tiers.registered")
+	}
+	return viper.GetString("tiers.unregistered")
+}
+
+// CanUse returns true if the tier of `user` may use the command with the
+// aliases `aliases`. Tiers name the commands they cannot use by any of their
+// aliases. Admins are never limited by their tier. The tier of the user is
+// returned as well.
+func (dj *MumbleDJ) CanUse(user *gumble.User, aliases []string) (string, bool) {
+	tier := dj.TierOf(user)
+	if tier == "" || dj.IsAdmin(user) {
+		return tier, true
+	}
+	for _, denied := range viper.GetStringSlice("tiers." + tier + ".denied_commands") {
+		for _, alias := range aliases {
+			if denied == alias {
+				return tier, false
+			}
+		}
+	}
+	return tier, true
+}
+
+// CountVoters returns the number of `users` whose tier lets them use the
+// command with the aliases in the configuration key `aliasesKey`, so that
+// users who cannot vote are left out when votes are counted.
+func (dj *MumbleDJ) CountVoters(users gumble.Users, aliasesKey string) int {
+	aliases := viper.GetStringSlice(aliasesKey)
+	count := 0
+	for _, user := range users {
+		if _, ok := dj.CanUse(user, aliases); ok {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,106 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/tiers_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type TiersTestSuite struct {
+	suite.Suite
+	Guest  *gumble.User
+	Staff  *gumble.User
+	Member *gumble.User
+}
+
+func (suite *TiersTestSuite) SetupSuite() {
+	DJ = NewMumbleDJ()
+	suite.Guest = &gumble.User{Name: "Guest"}
+	suite.Staff = &gumble.User{Name: "Staff", UserID: 2}
+	suite.Member = &gumble.User{Name: "Member", UserID: 3}
+}
+
+func (suite *TiersTestSuite) SetupTest() {
+	viper.Set("tiers.enabled", true)
+	viper.Set("tiers.listeners.members", []string{"Member"})
+	viper.Set("admins.names", []string{"Admin"})
+}
+
+func (suite *TiersTestSuite) TearDownTest() {
+	viper.Set("tiers.enabled", false)
+	viper.Set("tiers.listeners.members", []string{})
+	viper.Set("admins.names", []string{"SuperUser"})
+}
+
+func (suite *TiersTestSuite) TestTierOf() {
+	suite.Equal("guests", DJ.TierOf(suite.Guest), "Unregistered users should belong to tiers.unregistered.")
+	suite.Equal("", DJ.TierOf(suite.Staff), "Registered users should belong to tiers.registered.")
+	suite.Equal("listeners", DJ.TierOf(suite.Member), "Members of a tier should belong to it.")
+}
+
+func (suite *TiersTestSuite) TestTierOfWhenDisabled() {
+	viper.Set("tiers.enabled", false)
+
+	suite.Equal("", DJ.TierOf(suite.Guest))
+}
+
+func (suite *TiersTestSuite) TestCanUse() {
+	skip := []string{"skip", "s"}
+	add := []string{"add", "a"}
+
+	_, ok := DJ.CanUse(suite.Guest, skip)
+	suite.False(ok, "Guests should not be able to vote to skip.")
+	_, ok = DJ.CanUse(suite.Guest, add)
+	suite.True(ok, "Guests should be able to add tracks.")
+	tier, ok := DJ.CanUse(suite.Member, add)
+	suite.False(ok, "Listeners should not be able to add tracks.")
+	suite.Equal("listeners", tier)
+	_, ok = DJ.CanUse(suite.Staff, skip)
+	suite.True(ok, "Users without a tier should not be limited.")
+}
+
+func (suite *TiersTestSuite) TestCanUseMatchesAnyAlias() {
+	_, ok := DJ.CanUse(suite.Guest, []string{"voteskip", "skip"})
+
+	suite.False(ok)
+}
+
+func (suite *TiersTestSuite) TestAdminsAreNotLimited() {
+	admin := &gumble.User{Name: "Admin"}
+
+	_, ok := DJ.CanUse(admin, []string{"skip"})
+
+	suite.True(ok)
+}
+
+func (suite *TiersTestSuite) TestCheckQueuePermission() {
+	suite.Nil(DJ.CheckQueuePermission(suite.Guest), "Guests should be able to queue tracks.")
+	suite.NotNil(DJ.CheckQueuePermission(suite.Member), "Listeners should not be able to queue tracks with any command.")
+}
+
+func (suite *TiersTestSuite) TestCheckQueuePermissionWhenAddIsForAdmins() {
+	viper.Set("commands.add.is_admin", true)
+	defer viper.Set("commands.add.is_admin", false)
+
+	suite.NotNil(DJ.CheckQueuePermission(suite.Staff))
+	suite.Nil(DJ.CheckQueuePermission(&gumble.User{Name: "Admin"}))
+}
+
+func (suite *TiersTestSuite) TestCountVoters() {
+	users := gumble.Users{1: suite.Guest, 2: suite.Staff, 3: suite.Member}
+
+	suite.Equal(2, DJ.CountVoters(users, "commands.skip.aliases"), "Guests should not be counted as voters.")
+}
+
+func TestTiersTestSuite(t *testing.T) {
+	suite.Run(t, new(TiersTestSuite))
+}
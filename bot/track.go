@@ -8,6 +8,8 @@
 package bot
 
 import (
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/matthieugrieger/mumbledj/interfaces"
@@ -27,6 +29,17 @@ type Track struct {
 	Duration       time.Duration
 	PlaybackOffset time.Duration
 	Playlist       interfaces.Playlist
+	Live           bool
+	// TagsFromFile is set for tracks whose title, author and duration are
+	// read from the tags of their audio file once it has been downloaded.
+	TagsFromFile bool
+	// Tracklist lists the songs of a track that holds several, such as an
+	// album uploaded as a single video. Nil for most tracks.
+	Tracklist *Tracklist
+	// End is the position in the file at which playback stops, for tracks
+	// that are part of a longer recording such as a chapter of a video. 0
+	// plays the file to its end.
+	End time.Duration
 }
 
 // GetID returns the ID of the track.
@@ -39,9 +52,10 @@ func (t Track) GetURL() string {
 	return t.URL
 }
 
-// GetTitle returns the title of the track.
+// GetTitle returns the title of the track with clutter such as "[Official
+// Video]" removed. See ScrubTitle.
 func (t Track) GetTitle() string {
-	return t.Title
+	return ScrubTitle(t.Title)
 }
 
 // GetAuthor returns the author of the track.
@@ -92,3 +106,35 @@ func (t Track) GetPlaybackOffset() time.Duration {
 func (t Track) GetPlaylist() interfaces.Playlist {
 	return t.Playlist
 }
+
+// IsLive returns true if the track is a live stream that is relayed as it is
+// broadcast rather than downloaded first. Live tracks have no duration.
+func (t Track) IsLive() bool {
+	return t.Live
+}
+
+// LinkOffset returns where playback of `link` begins, as given by its "t" or
+// "start" parameter, such as "t=1m30s" or "start=90". Shared links may carry
+// the parameter in the fragment instead, as in "#t=90".
+func LinkOffset(link string) time.Duration {
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return 0
+	}
+	params := parsed.Query()
+	if fragment, err := url.ParseQuery(parsed.Fragment); err == nil {
+		for key, values := range fragment {
+			params[key] = append(params[key], values...)
+		}
+	}
+	for _, key := range []string{"t", "start"} {
+		value := params.Get(key)
+		if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		if offset, err := time.ParseDuration(value); err == nil && offset > 0 {
+			return offset
+		}
+	}
+	return 0
+}
@@ -120,6 +120,14 @@ func (suite *TrackTestSuite) TestGetPlaylistWhenNotExists() {
 	suite.Nil(result)
 }
 
+func (suite *TrackTestSuite) TestIsLive() {
+	suite.False(suite.Track.IsLive())
+
+	suite.Track.Live = true
+
+	suite.True(suite.Track.IsLive())
+}
+
 func TestTrackTestSuite(t *testing.T) {
 	suite.Run(t, new(TrackTestSuite))
 }
@@ -0,0 +1,178 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/tracklist.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matthieugrieger/mumbledj/interfaces"
+)
+
+// tracklistTimestampRegex matches a timestamp in a line of a tracklist, such
+// as "4:05" or "1:02:30".
+var tracklistTimestampRegex = regexp.MustCompile(`(?:^|[\s\[(])((?:(\d{1,2}):)?(\d{1,2}):(\d{2}))(?:$|[\s\])])`)
+
+// tracklistNumberRegex matches the numbering that some tracklists put before
+// the title of each track, such as "01." or "3)".
+var tracklistNumberRegex = regexp.MustCompile(`^\d{1,3}[.)]\s+`)
+
+// Chapter is one part of a track, such as a song of an album that was
+// uploaded as a single video.
+type Chapter struct {
+	Start time.Duration
+	Title string
+}
+
+// Tracklist holds the chapters of a track in order.
+type Tracklist struct {
+	Chapters []Chapter
+}
+
+// ParseTracklist finds a tracklist with timestamps in `description`, with a
+// line for each chapter such as "0:00 Intro" or "Intro - 0:00", and returns
+// it. Nil is returned unless there are at least two chapters, starting in
+// order and before `duration` if it is known.
+func ParseTracklist(description string, duration time.Duration) *Tracklist {
+	var chapters []Chapter
+	for _, line := range strings.Split(description, "\n") {
+		match := tracklistTimestampRegex.FindStringSubmatchIndex(line)
+		if match == nil {
+			continue
+		}
+		hours, _ := strconv.Atoi(submatch(line, match, 2))
+		minutes, _ := strconv.Atoi(submatch(line, match, 3))
+		seconds, _ := strconv.Atoi(submatch(line, match, 4))
+		start := time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+
+		title := strings.TrimSpace(line[:match[2]] + " " + line[match[3]:])
+		title = tracklistNumberRegex.ReplaceAllString(title, "")
+		title = strings.Trim(title, " \t\r-–—|:•·[]()")
+		if title == "" {
+			continue
+		}
+
+		if len(chapters) > 0 && start <= chapters[len(chapters)-1].Start {
+			return nil
+		}
+		if duration > 0 && start >= duration {
+			return nil
+		}
+		chapters = append(chapters, Chapter{Start: start, Title: title})
+	}
+	if len(chapters) < 2 {
+		return nil
+	}
+	return &Tracklist{Chapters: chapters}
+}
+
+// Current returns the index of the chapter playing `offset` into the track, or
+// -1 if the first chapter has not started yet.
+func (t *Tracklist) Current(offset time.Duration) int {
+	current := -1
+	for i, chapter := range t.Chapters {
+		if chapter.Start <= offset {
+			current = i
+		}
+	}
+	return current
+}
+
+// TracklistOf returns the tracklist of `track`, or nil if it has none.
+func TracklistOf(track interfaces.Track) *Tracklist {
+	switch t := track.(type) {
+	case Track:
+		return t.Tracklist
+	case *Track:
+		return t.Tracklist
+	}
+	return nil
+}
+
+// SplitChapters replaces each track in `tracks` that has a tracklist with a
+// track for each of its chapters, so that the songs of an album uploaded as a
+// single video can be skipped one by one. The chapters share the file of the
+// track and are grouped as a playlist named after it, unless the track already
+// belongs to one. Other tracks are returned as they are.
+func SplitChapters(tracks []interfaces.Track) []interfaces.Track {
+	var split []interfaces.Track
+	for _, track := range tracks {
+		var full Track
+		switch t := track.(type) {
+		case Track:
+			full = t
+		case *Track:
+			full = *t
+		default:
+			split = append(split, track)
+			continue
+		}
+		if full.Tracklist == nil || full.Live {
+			split = append(split, track)
+			continue
+		}
+
+		playlist := full.Playlist
+		if playlist == nil {
+			playlist = &Playlist{
+				ID:        full.ID,
+				Title:     full.GetTitle(),
+				Submitter: full.Submitter,
+				Service:   full.Service,
+			}
+		}
+		separator := "?"
+		if strings.Contains(full.URL, "?") {
+			separator = "&"
+		}
+		chapters := full.Tracklist.Chapters
+		for i, chapter := range chapters {
+			end := full.Duration
+			if i+1 < len(chapters) {
+				end = chapters[i+1].Start
+			}
+			track := full
+			track.ID = fmt.Sprintf("%s#%d", full.ID, i+1)
+			track.URL = fmt.Sprintf("%s%st=%ds", full.URL, separator, int(chapter.Start.Seconds()))
+			track.Title = chapter.Title
+			track.PlaybackOffset = chapter.Start
+			track.End = end
+			track.Duration = 0
+			if end > 0 {
+				track.Duration = end - chapter.Start
+			}
+			track.Playlist = playlist
+			track.Tracklist = nil
+			split = append(split, track)
+		}
+	}
+	return split
+}
+
+// playbackEnd returns the position in the file of `track` at which playback
+// stops, or 0 if it plays to the end of the file.
+func playbackEnd(track interfaces.Track) time.Duration {
+	switch t := track.(type) {
+	case Track:
+		return t.End
+	case *Track:
+		return t.End
+	}
+	return 0
+}
+
+// submatch returns the `n`th submatch of `s` given the indices in `match`.
+func submatch(s string, match []int, n int) string {
+	if match[2*n] < 0 {
+		return ""
+	}
+	return s[match[2*n]:match[2*n+1]]
+}
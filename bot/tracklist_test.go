@@ -0,0 +1,112 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/tracklist_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/stretchr/testify/suite"
+)
+
+type TracklistTestSuite struct {
+	suite.Suite
+}
+
+func (suite *TracklistTestSuite) TestParseTracklist() {
+	description := "Full album, out now!\n\n" +
+		"Tracklist:\n" +
+		"0:00 Intro\n" +
+		"[4:05] Second Song\n" +
+		"03. 9:30 - Third Song\n" +
+		"The Finale – 1:02:30\n" +
+		"\nFollow us at https://example.com"
+
+	tracklist := ParseTracklist(description, 2*time.Hour)
+
+	suite.Require().NotNil(tracklist)
+	suite.Equal([]Chapter{
+		{Start: 0, Title: "Intro"},
+		{Start: 4*time.Minute + 5*time.Second, Title: "Second Song"},
+		{Start: 9*time.Minute + 30*time.Second, Title: "Third Song"},
+		{Start: time.Hour + 2*time.Minute + 30*time.Second, Title: "The Finale"},
+	}, tracklist.Chapters)
+}
+
+func (suite *TracklistTestSuite) TestParseTracklistNeedsTwoChapters() {
+	suite.Nil(ParseTracklist("The best part starts at 1:30", 0))
+}
+
+func (suite *TracklistTestSuite) TestParseTracklistRejectsTimestampsOutOfOrder() {
+	suite.Nil(ParseTracklist("0:00 One\n5:00 Two\n3:00 Three", 0))
+	suite.Nil(ParseTracklist("0:00 One\n5:00 Two", 4*time.Minute), "Timestamps past the end should not be accepted.")
+}
+
+func (suite *TracklistTestSuite) TestCurrent() {
+	tracklist := &Tracklist{Chapters: []Chapter{{Start: 10 * time.Second}, {Start: time.Minute}}}
+
+	suite.Equal(-1, tracklist.Current(5*time.Second))
+	suite.Equal(0, tracklist.Current(30*time.Second))
+	suite.Equal(1, tracklist.Current(time.Minute))
+}
+
+func (suite *TracklistTestSuite) TestTracklistOf() {
+	tracklist := &Tracklist{}
+
+	suite.Equal(tracklist, TracklistOf(Track{Tracklist: tracklist}))
+	suite.Equal(tracklist, TracklistOf(&Track{Tracklist: tracklist}))
+	suite.Nil(TracklistOf(Track{}))
+}
+
+func (suite *TracklistTestSuite) TestSplitChapters() {
+	album := Track{
+		ID:       "album",
+		URL:      "https://youtube.com/watch?v=album",
+		Title:    "Full Album",
+		Service:  "YouTube",
+		Filename: "album.track",
+		Duration: 10 * time.Minute,
+		Tracklist: &Tracklist{Chapters: []Chapter{
+			{Start: 0, Title: "First Song"},
+			{Start: 4 * time.Minute, Title: "Second Song"},
+		}},
+	}
+	other := Track{ID: "other"}
+
+	tracks := SplitChapters([]interfaces.Track{album, other})
+
+	suite.Require().Len(tracks, 3)
+	first, second := tracks[0].(Track), tracks[1].(Track)
+	suite.Equal("First Song", first.Title)
+	suite.Equal(time.Duration(0), first.PlaybackOffset)
+	suite.Equal(4*time.Minute, first.End)
+	suite.Equal(4*time.Minute, first.Duration)
+	suite.Equal("Second Song", second.Title)
+	suite.Equal("https://youtube.com/watch?v=album&t=240s", second.URL)
+	suite.Equal(4*time.Minute, second.PlaybackOffset)
+	suite.Equal(10*time.Minute, second.End)
+	suite.Equal(6*time.Minute, second.Duration)
+	suite.Equal("album.track", second.Filename, "The chapters should share the download of the video.")
+	suite.NotEqual(first.ID, second.ID)
+	suite.Nil(second.Tracklist)
+	suite.Require().NotNil(first.Playlist)
+	suite.Equal(first.Playlist, second.Playlist)
+	suite.Equal("Full Album", first.Playlist.GetTitle())
+	suite.Equal(other, tracks[2])
+}
+
+func (suite *TracklistTestSuite) TestAnnouncedDurationOfChapter() {
+	chapter := Track{Duration: 6 * time.Minute, PlaybackOffset: 4 * time.Minute, End: 10 * time.Minute}
+
+	suite.Equal("6m0s", announcedDuration(chapter))
+}
+
+func TestTracklistTestSuite(t *testing.T) {
+	suite.Run(t, new(TracklistTestSuite))
+}
@@ -0,0 +1,71 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/usertoggle.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"sync"
+
+	"github.com/spf13/viper"
+)
+
+// UserToggle is a setting that each user may switch on or off for themselves,
+// such as being notified when their track begins playing. Users who have not
+// made a choice get the value of the configuration key it was created with.
+type UserToggle struct {
+	defaultKey  string
+	preferences map[string]bool
+	mutex       sync.RWMutex
+}
+
+// NewUserToggle returns a UserToggle that defaults to the boolean value of the
+// given configuration key.
+func NewUserToggle(defaultKey string) *UserToggle {
+	return &UserToggle{
+		defaultKey:  defaultKey,
+		preferences: make(map[string]bool),
+	}
+}
+
+// Enabled returns true if the setting is on for the user with the given name.
+func (u *UserToggle) Enabled(name string) bool {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	if enabled, ok := u.preferences[name]; ok {
+		return enabled
+	}
+	return viper.GetBool(u.defaultKey)
+}
+
+// Set switches the setting on or off for the user with the given name.
+func (u *UserToggle) Set(name string, enabled bool) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	u.preferences[name] = enabled
+}
+
+// Toggle switches the setting for the user with the given name and returns the
+// new value.
+func (u *UserToggle) Toggle(name string) bool {
+	enabled := !u.Enabled(name)
+	u.Set(name, enabled)
+	return enabled
+}
+
+// Preferences returns a copy of the choice made by each user.
+func (u *UserToggle) Preferences() map[string]bool {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	preferences := make(map[string]bool, len(u.preferences))
+	for name, enabled := range u.preferences {
+		preferences[name] = enabled
+	}
+	return preferences
+}
@@ -0,0 +1,47 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/usertoggle_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type UserToggleTestSuite struct {
+	suite.Suite
+	Toggle *UserToggle
+}
+
+func (suite *UserToggleTestSuite) SetupTest() {
+	suite.Toggle = NewUserToggle("queue.notify_submitters")
+	viper.Set("queue.notify_submitters", false)
+}
+
+func (suite *UserToggleTestSuite) TestEnabledUsesConfigDefault() {
+	suite.False(suite.Toggle.Enabled("test"))
+
+	viper.Set("queue.notify_submitters", true)
+
+	suite.True(suite.Toggle.Enabled("test"))
+}
+
+func (suite *UserToggleTestSuite) TestToggle() {
+	suite.True(suite.Toggle.Toggle("test"), "The setting should now be on.")
+	suite.True(suite.Toggle.Enabled("test"))
+	suite.False(suite.Toggle.Toggle("test"), "The setting should now be off.")
+
+	viper.Set("queue.notify_submitters", true)
+
+	suite.False(suite.Toggle.Enabled("test"), "The user's choice should override the default.")
+}
+
+func TestUserToggleTestSuite(t *testing.T) {
+	suite.Run(t, new(UserToggleTestSuite))
+}
@@ -0,0 +1,268 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/volumeschedule.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// fadeStep is the interval at which the volume is changed while fading.
+const fadeStep = 100 * time.Millisecond
+
+// ErrVolumeOutOfRange is returned when a scheduled volume does not lie between
+// volume.lowest and volume.highest.
+var ErrVolumeOutOfRange = errors.New("The volume is out of range")
+
+// VolumeTarget is a volume that playback is brought to at a time of day.
+type VolumeTarget struct {
+	// Clock is the time of day in HH:MM format.
+	Clock  string
+	Volume float32
+}
+
+// VolumeSchedule changes the volume at set times of day, e.g. lowering it
+// after 22:00. The volume is faded to each target over volume.schedule_fade
+// seconds rather than changed at once.
+type VolumeSchedule struct {
+	targets []VolumeTarget
+	timer   *time.Timer
+	loaded  bool
+	mutex   sync.Mutex
+}
+
+// NewVolumeSchedule returns an empty VolumeSchedule.
+func NewVolumeSchedule() *VolumeSchedule {
+	return &VolumeSchedule{}
+}
+
+// ParseVolumeTarget parses a time of day in HH:MM format and a volume, which
+// must lie between volume.lowest and volume.highest.
+func ParseVolumeTarget(clock, volume string) (VolumeTarget, error) {
+	parsed, err := time.Parse(stopTimeFormat, clock)
+	if err != nil {
+		return VolumeTarget{}, errors.New("The time must be in HH:MM format")
+	}
+	value, err := strconv.ParseFloat(volume, 32)
+	if err != nil {
+		return VolumeTarget{}, errors.New("The volume could not be parsed")
+	}
+	if value <= viper.GetFloat64("volume.lowest") || value >= viper.GetFloat64("volume.highest") {
+		return VolumeTarget{}, ErrVolumeOutOfRange
+	}
+	return VolumeTarget{Clock: parsed.Format(stopTimeFormat), Volume: float32(value)}, nil
+}
+
+// Set adds `target` to the schedule, replacing any target at the same time.
+func (s *VolumeSchedule) Set(target VolumeTarget) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.remove(target.Clock)
+	s.targets = append(s.targets, target)
+	sort.Sort(sortVolumeTargets(s.targets))
+	s.reschedule(time.Now())
+}
+
+// Remove removes the target at the time of day `clock`. Returns false if
+// there is none.
+func (s *VolumeSchedule) Remove(clock string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if parsed, err := time.Parse(stopTimeFormat, clock); err == nil {
+		clock = parsed.Format(stopTimeFormat)
+	}
+	removed := s.remove(clock)
+	s.reschedule(time.Now())
+	return removed
+}
+
+// Clear removes every target.
+func (s *VolumeSchedule) Clear() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.targets = nil
+	s.reschedule(time.Now())
+}
+
+// Targets returns the scheduled targets ordered by time of day.
+func (s *VolumeSchedule) Targets() []VolumeTarget {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]VolumeTarget(nil), s.targets...)
+}
+
+// LoadFromConfig adds the targets listed in volume.schedule, such as
+// "22:00 0.1". The configuration is only loaded once, so that targets changed
+// via command are kept when the bot reconnects.
+func (s *VolumeSchedule) LoadFromConfig() error {
+	s.mutex.Lock()
+	loaded := s.loaded
+	s.loaded = true
+	s.mutex.Unlock()
+	if loaded {
+		return nil
+	}
+
+	for _, entry := range viper.GetStringSlice("volume.schedule") {
+		fields := strings.Fields(entry)
+		if len(fields) != 2 {
+			return errors.New("Volume schedule entries must be a time and a volume, such as \"22:00 0.1\"")
+		}
+		target, err := ParseVolumeTarget(fields[0], fields[1])
+		if err != nil {
+			return err
+		}
+		s.Set(target)
+	}
+	return nil
+}
+
+// ApplyLatest applies the target that passed last at once. The bot may connect
+// after a target has passed, such as at 23:00 with the volume lowered from
+// 22:00, and the volume is reset to volume.default on every connection.
+func (s *VolumeSchedule) ApplyLatest() {
+	s.mutex.Lock()
+	target, ok := s.previous(time.Now())
+	s.mutex.Unlock()
+	if !ok {
+		return
+	}
+	logrus.WithFields(logrus.Fields{
+		"time":   target.Clock,
+		"volume": target.Volume,
+	}).Infoln("Applying the latest scheduled volume...")
+	fadeVolume(target.Volume, 0)
+}
+
+// remove removes the target at `clock`. The caller must hold the mutex.
+func (s *VolumeSchedule) remove(clock string) bool {
+	for i, target := range s.targets {
+		if target.Clock == clock {
+			s.targets = append(s.targets[:i], s.targets[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// reschedule arranges for the next target after `now` to be applied. The
+// caller must hold the mutex.
+func (s *VolumeSchedule) reschedule(now time.Time) {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	target, at, ok := s.next(now)
+	if !ok {
+		return
+	}
+	s.timer = time.AfterFunc(at.Sub(now), func() {
+		s.apply(target)
+		s.mutex.Lock()
+		s.reschedule(time.Now())
+		s.mutex.Unlock()
+	})
+}
+
+// next returns the target whose time of day comes first after `now`, and when
+// that is. The caller must hold the mutex.
+func (s *VolumeSchedule) next(now time.Time) (VolumeTarget, time.Time, bool) {
+	var (
+		next   VolumeTarget
+		nextAt time.Time
+	)
+	for _, target := range s.targets {
+		at, err := ParseStopTime(target.Clock, now)
+		if err != nil {
+			continue
+		}
+		if nextAt.IsZero() || at.Before(nextAt) {
+			next, nextAt = target, at
+		}
+	}
+	return next, nextAt, !nextAt.IsZero()
+}
+
+// previous returns the target whose time of day passed last before `now`,
+// which is the target that comes last after `now`. The caller must hold the
+// mutex.
+func (s *VolumeSchedule) previous(now time.Time) (VolumeTarget, bool) {
+	var (
+		previous   VolumeTarget
+		previousAt time.Time
+	)
+	for _, target := range s.targets {
+		at, err := ParseStopTime(target.Clock, now)
+		if err != nil {
+			continue
+		}
+		if previousAt.IsZero() || at.After(previousAt) {
+			previous, previousAt = target, at
+		}
+	}
+	return previous, !previousAt.IsZero()
+}
+
+// apply fades the volume to `target` over volume.schedule_fade seconds.
+func (s *VolumeSchedule) apply(target VolumeTarget) {
+	logrus.WithFields(logrus.Fields{
+		"time":   target.Clock,
+		"volume": target.Volume,
+	}).Infoln("Applying a scheduled volume...")
+
+	fadeVolume(target.Volume, time.Duration(viper.GetInt("volume.schedule_fade"))*time.Second)
+}
+
+// fadeVolume changes the volume to `target` in small steps over `duration`.
+// The fade is abandoned, returning false, if the volume is changed by other
+// means in the meantime.
+func fadeVolume(target float32, duration time.Duration) bool {
+	start := DJ.Volume
+	steps := int(duration / fadeStep)
+	if steps < 1 {
+		steps = 1
+	}
+	var volume float32
+	for i := 1; i <= steps; i++ {
+		if i > 1 {
+			time.Sleep(fadeStep)
+			if DJ.Volume != volume {
+				return false
+			}
+		}
+		volume = start + (target-start)*float32(i)/float32(steps)
+		if i == steps {
+			volume = target
+		}
+		DJ.Volume = volume
+		if stream := DJ.AudioStream; stream != nil {
+			stream.Volume = volume
+		}
+	}
+	// The monitor player cannot change its volume while playing.
+	DJ.Monitor.Refresh()
+	return true
+}
+
+// sortVolumeTargets sorts volume targets by their time of day.
+type sortVolumeTargets []VolumeTarget
+
+func (a sortVolumeTargets) Len() int           { return len(a) }
+func (a sortVolumeTargets) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a sortVolumeTargets) Less(i, j int) bool { return a[i].Clock < a[j].Clock }
@@ -0,0 +1,152 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/volumeschedule_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type VolumeScheduleTestSuite struct {
+	suite.Suite
+	Schedule *VolumeSchedule
+}
+
+func (suite *VolumeScheduleTestSuite) SetupTest() {
+	DJ = NewMumbleDJ()
+	suite.Schedule = NewVolumeSchedule()
+	viper.Set("volume.lowest", 0.01)
+	viper.Set("volume.highest", 0.8)
+	viper.Set("volume.schedule_fade", 0)
+}
+
+func (suite *VolumeScheduleTestSuite) TearDownTest() {
+	suite.Schedule.Clear()
+	viper.Set("volume.schedule", []string{})
+}
+
+func (suite *VolumeScheduleTestSuite) TestParseVolumeTarget() {
+	target, err := ParseVolumeTarget("9:05", "0.1")
+
+	suite.Nil(err)
+	suite.Equal("09:05", target.Clock)
+	suite.Equal(float32(0.1), target.Volume)
+}
+
+func (suite *VolumeScheduleTestSuite) TestParseVolumeTargetErrors() {
+	_, err := ParseVolumeTarget("25:00", "0.1")
+	suite.NotNil(err)
+
+	_, err = ParseVolumeTarget("22:00", "loud")
+	suite.NotNil(err)
+
+	_, err = ParseVolumeTarget("22:00", "0.9")
+	suite.Equal(ErrVolumeOutOfRange, err)
+}
+
+func (suite *VolumeScheduleTestSuite) TestSetReplacesAndSorts() {
+	suite.Schedule.Set(VolumeTarget{Clock: "22:00", Volume: 0.1})
+	suite.Schedule.Set(VolumeTarget{Clock: "08:00", Volume: 0.3})
+	suite.Schedule.Set(VolumeTarget{Clock: "22:00", Volume: 0.05})
+
+	suite.Equal([]VolumeTarget{
+		{Clock: "08:00", Volume: 0.3},
+		{Clock: "22:00", Volume: 0.05},
+	}, suite.Schedule.Targets())
+}
+
+func (suite *VolumeScheduleTestSuite) TestRemove() {
+	suite.Schedule.Set(VolumeTarget{Clock: "22:00", Volume: 0.1})
+
+	suite.False(suite.Schedule.Remove("21:00"))
+	suite.True(suite.Schedule.Remove("22:00"))
+	suite.Empty(suite.Schedule.Targets())
+}
+
+func (suite *VolumeScheduleTestSuite) TestNext() {
+	suite.Schedule.Set(VolumeTarget{Clock: "08:00", Volume: 0.3})
+	suite.Schedule.Set(VolumeTarget{Clock: "22:00", Volume: 0.1})
+	now := time.Date(2016, 6, 1, 23, 0, 0, 0, time.Local)
+
+	target, at, ok := suite.Schedule.next(now)
+
+	suite.True(ok)
+	suite.Equal("08:00", target.Clock, "The earliest target of the next day should come next.")
+	suite.Equal(time.Date(2016, 6, 2, 8, 0, 0, 0, time.Local), at)
+}
+
+func (suite *VolumeScheduleTestSuite) TestPrevious() {
+	suite.Schedule.Set(VolumeTarget{Clock: "08:00", Volume: 0.3})
+	suite.Schedule.Set(VolumeTarget{Clock: "22:00", Volume: 0.1})
+
+	target, ok := suite.Schedule.previous(time.Date(2016, 6, 1, 23, 0, 0, 0, time.Local))
+	suite.True(ok)
+	suite.Equal("22:00", target.Clock)
+
+	target, _ = suite.Schedule.previous(time.Date(2016, 6, 1, 7, 0, 0, 0, time.Local))
+	suite.Equal("22:00", target.Clock, "The last target of the previous day should still apply.")
+
+	target, _ = suite.Schedule.previous(time.Date(2016, 6, 1, 12, 0, 0, 0, time.Local))
+	suite.Equal("08:00", target.Clock)
+}
+
+func (suite *VolumeScheduleTestSuite) TestApply() {
+	DJ.Volume = 0.5
+
+	suite.Schedule.apply(VolumeTarget{Clock: "22:00", Volume: 0.1})
+
+	suite.Equal(float32(0.1), DJ.Volume)
+}
+
+func (suite *VolumeScheduleTestSuite) TestLoadFromConfig() {
+	viper.Set("volume.schedule", []string{"22:00 0.1", "08:00 0.3"})
+
+	suite.Nil(suite.Schedule.LoadFromConfig())
+	suite.Len(suite.Schedule.Targets(), 2)
+
+	suite.Schedule.Clear()
+	suite.Nil(suite.Schedule.LoadFromConfig())
+	suite.Empty(suite.Schedule.Targets(), "The configuration should only be loaded once.")
+}
+
+func (suite *VolumeScheduleTestSuite) TestApplyLatest() {
+	viper.Set("volume.schedule", []string{"22:00 0.1", "08:00 0.3"})
+	suite.Nil(suite.Schedule.LoadFromConfig())
+	latest, _ := suite.Schedule.previous(time.Now())
+
+	DJ.Volume = 0.5
+	suite.Schedule.ApplyLatest()
+	suite.Equal(latest.Volume, DJ.Volume, "The target that has passed last should be applied at once.")
+
+	// Reconnecting resets the volume to volume.default.
+	DJ.Volume = 0.5
+	suite.Nil(suite.Schedule.LoadFromConfig())
+	suite.Schedule.ApplyLatest()
+	suite.Equal(latest.Volume, DJ.Volume, "The scheduled volume should be applied again after a reconnection.")
+}
+
+func (suite *VolumeScheduleTestSuite) TestApplyLatestWithoutTargets() {
+	DJ.Volume = 0.5
+
+	suite.Schedule.ApplyLatest()
+
+	suite.Equal(float32(0.5), DJ.Volume)
+}
+
+func (suite *VolumeScheduleTestSuite) TestLoadFromConfigWithInvalidEntry() {
+	viper.Set("volume.schedule", []string{"22:00"})
+
+	suite.NotNil(suite.Schedule.LoadFromConfig())
+}
+
+func TestVolumeScheduleTestSuite(t *testing.T) {
+	suite.Run(t, new(VolumeScheduleTestSuite))
+}
@@ -0,0 +1,128 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/votewindow.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+var (
+	// ErrNoVoteWindow is returned when a track is vetoed while no vote window
+	// is open.
+	ErrNoVoteWindow = errors.New("There is no upcoming track to veto")
+
+	// ErrAlreadyVetoed is returned when a user vetoes the same track twice.
+	ErrAlreadyVetoed = errors.New("The upcoming track has already been vetoed by this user")
+)
+
+// VoteWindow pauses for vote_window.duration seconds between tracks so that
+// listeners can veto the upcoming track before it is downloaded and played.
+type VoteWindow struct {
+	track  interfaces.Track
+	vetoes []string
+	vetoed bool
+	done   chan struct{}
+	mutex  sync.Mutex
+}
+
+// NewVoteWindow returns a VoteWindow that is not open.
+func NewVoteWindow() *VoteWindow {
+	return &VoteWindow{}
+}
+
+// Hold opens a vote window for the track after the current one, if
+// vote_window.duration is set, and waits until it closes. The track is removed
+// from the queue if enough listeners vetoed it.
+func (w *VoteWindow) Hold() {
+	seconds := viper.GetInt("vote_window.duration")
+	// The next track is only picked once the current one ends while tracks
+	// are shuffled automatically.
+	if seconds <= 0 || viper.GetBool("queue.automatic_shuffle_on") {
+		return
+	}
+	next, err := DJ.Queue.PeekNextTrack()
+	if err != nil {
+		return
+	}
+
+	duration := time.Duration(seconds) * time.Second
+	done := w.open(next)
+	DJ.Notify("vote_window_opened", next.GetSubmitter(), fmt.Sprintf(viper.GetString("vote_window.messages.window_opened"),
+		next.GetTitle(), next.GetSubmitter(), duration.String()))
+
+	select {
+	case <-done:
+	case <-time.After(duration):
+	}
+
+	track, vetoed := w.close()
+	if queue, ok := DJ.Queue.(*Queue); ok && vetoed && queue.RemoveTrack(track) {
+		logrus.WithFields(logrus.Fields{
+			"title":     track.GetTitle(),
+			"submitter": track.GetSubmitter(),
+		}).Infoln("The upcoming track was vetoed.")
+		DJ.Notify("track_vetoed", track.GetSubmitter(), fmt.Sprintf(viper.GetString("vote_window.messages.track_vetoed"), track.GetTitle()))
+	}
+}
+
+// Veto records a veto of the upcoming track by the user `name` and returns the
+// track. The window closes early, dropping the track, once the share of the
+// `listeners` in the channel who vetoed it reaches vote_window.veto_ratio, or
+// at once if `name` submitted the track.
+func (w *VoteWindow) Veto(name string, listeners int) (interfaces.Track, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.track == nil {
+		return nil, ErrNoVoteWindow
+	}
+	for _, vetoer := range w.vetoes {
+		if vetoer == name {
+			return w.track, ErrAlreadyVetoed
+		}
+	}
+	w.vetoes = append(w.vetoes, name)
+
+	if listeners < 1 {
+		listeners = 1
+	}
+	if !w.vetoed && (name == w.track.GetSubmitter() ||
+		float64(len(w.vetoes))/float64(listeners) >= viper.GetFloat64("vote_window.veto_ratio")) {
+		w.vetoed = true
+		close(w.done)
+	}
+	return w.track, nil
+}
+
+// open opens the window for `track`. The returned channel is closed once the
+// track has been vetoed.
+func (w *VoteWindow) open(track interfaces.Track) chan struct{} {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.track, w.vetoes, w.vetoed = track, nil, false
+	w.done = make(chan struct{})
+	return w.done
+}
+
+// close closes the window and returns its track and whether it was vetoed.
+func (w *VoteWindow) close() (interfaces.Track, bool) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	track, vetoed := w.track, w.vetoed
+	w.track, w.vetoes, w.vetoed = nil, nil, false
+	return track, vetoed
+}
@@ -0,0 +1,83 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/votewindow_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type VoteWindowTestSuite struct {
+	suite.Suite
+	Window *VoteWindow
+	Track  *Track
+}
+
+func (suite *VoteWindowTestSuite) SetupTest() {
+	viper.Set("vote_window.veto_ratio", 0.5)
+	suite.Window = NewVoteWindow()
+	suite.Track = &Track{Title: "track", Submitter: "submitter"}
+}
+
+func (suite *VoteWindowTestSuite) TestVetoWithoutWindow() {
+	_, err := suite.Window.Veto("test", 4)
+
+	suite.Equal(ErrNoVoteWindow, err)
+}
+
+func (suite *VoteWindowTestSuite) TestVetoesReachRatio() {
+	done := suite.Window.open(suite.Track)
+
+	track, err := suite.Window.Veto("first", 4)
+	suite.Nil(err)
+	suite.Equal(suite.Track, track)
+	suite.False(isClosed(done), "One veto out of four users should not be enough.")
+
+	_, err = suite.Window.Veto("first", 4)
+	suite.Equal(ErrAlreadyVetoed, err)
+
+	suite.Window.Veto("second", 4)
+	suite.True(isClosed(done))
+
+	track, vetoed := suite.Window.close()
+	suite.Equal(suite.Track, track)
+	suite.True(vetoed)
+}
+
+func (suite *VoteWindowTestSuite) TestSubmitterVetoesAtOnce() {
+	done := suite.Window.open(suite.Track)
+
+	suite.Window.Veto("submitter", 10)
+
+	suite.True(isClosed(done))
+}
+
+func (suite *VoteWindowTestSuite) TestCloseWithoutVetoes() {
+	suite.Window.open(suite.Track)
+
+	_, vetoed := suite.Window.close()
+	_, err := suite.Window.Veto("test", 1)
+
+	suite.False(vetoed)
+	suite.Equal(ErrNoVoteWindow, err, "The window should no longer be open.")
+}
+
+func isClosed(done chan struct{}) bool {
+	select {
+	case <-done:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestVoteWindowTestSuite(t *testing.T) {
+	suite.Run(t, new(VoteWindowTestSuite))
+}
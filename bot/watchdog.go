@@ -0,0 +1,93 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/watchdog.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumbleffmpeg"
+	"github.com/spf13/viper"
+)
+
+// Watchdog keeps an eye on the audio stream and skips the current track if
+// playback stops making progress while it is supposedly playing.
+type Watchdog struct {
+	stream       *gumbleffmpeg.Stream
+	lastElapsed  time.Duration
+	lastProgress time.Time
+	once         sync.Once
+}
+
+// NewWatchdog creates a Watchdog and returns it.
+func NewWatchdog() *Watchdog {
+	return &Watchdog{}
+}
+
+// Start begins checking the audio stream once per second. Calling Start more
+// than once (for example after a reconnect) has no additional effect.
+func (w *Watchdog) Start() {
+	w.once.Do(func() {
+		go func() {
+			for now := range time.Tick(time.Second) {
+				w.Check(now)
+			}
+		}()
+	})
+}
+
+// Check inspects the current audio stream. If the stream is playing but has not
+// made any progress within the configured timeout, diagnostics are logged and
+// the stream is stopped, which causes the queue to move on to the next track.
+func (w *Watchdog) Check(now time.Time) {
+	stream := DJ.AudioStream
+	if stream == nil || stream.State() != gumbleffmpeg.StatePlaying {
+		w.stream = nil
+		return
+	}
+	if stream != w.stream {
+		w.stream = stream
+		w.reset(stream.Elapsed(), now)
+		return
+	}
+	if !w.isStalled(stream.Elapsed(), now) {
+		return
+	}
+
+	fields := logrus.Fields{
+		"elapsed":       w.lastElapsed.String(),
+		"stalled_since": w.lastProgress.Format(time.RFC3339),
+	}
+	if track, err := DJ.Queue.CurrentTrack(); err == nil {
+		fields["track_id"] = track.GetID()
+		fields["track_url"] = track.GetURL()
+		fields["filename"] = track.GetFilename()
+	}
+	logrus.WithFields(fields).Warnln("Audio playback has stalled. Skipping the current track...")
+
+	w.stream = nil
+	stream.Stop()
+}
+
+// reset records the given elapsed time as the latest progress made.
+func (w *Watchdog) reset(elapsed time.Duration, now time.Time) {
+	w.lastElapsed = elapsed
+	w.lastProgress = now
+}
+
+// isStalled records the latest elapsed time and returns true if playback has
+// not progressed for longer than the configured timeout.
+func (w *Watchdog) isStalled(elapsed time.Duration, now time.Time) bool {
+	timeout := viper.GetInt("queue.watchdog_timeout")
+	if elapsed != w.lastElapsed {
+		w.reset(elapsed, now)
+		return false
+	}
+	return timeout > 0 && now.Sub(w.lastProgress) >= time.Duration(timeout)*time.Second
+}
@@ -0,0 +1,54 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/watchdog_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type WatchdogTestSuite struct {
+	suite.Suite
+	Watchdog *Watchdog
+	Start    time.Time
+}
+
+func (suite *WatchdogTestSuite) SetupTest() {
+	viper.Set("queue.watchdog_timeout", 10)
+	suite.Watchdog = NewWatchdog()
+	suite.Start = time.Now()
+	suite.Watchdog.reset(0, suite.Start)
+}
+
+func (suite *WatchdogTestSuite) TestIsStalledWhenPlaybackProgresses() {
+	for i := 1; i <= 30; i++ {
+		suite.False(suite.Watchdog.isStalled(time.Duration(i)*time.Second, suite.Start.Add(time.Duration(i)*time.Second)),
+			"Playback should not be considered stalled while it is progressing.")
+	}
+}
+
+func (suite *WatchdogTestSuite) TestIsStalledWhenPlaybackStops() {
+	suite.False(suite.Watchdog.isStalled(0, suite.Start.Add(5*time.Second)),
+		"Playback should not be considered stalled before the timeout elapses.")
+	suite.True(suite.Watchdog.isStalled(0, suite.Start.Add(10*time.Second)),
+		"Playback should be considered stalled once the timeout elapses.")
+}
+
+func (suite *WatchdogTestSuite) TestIsStalledWhenDisabled() {
+	viper.Set("queue.watchdog_timeout", 0)
+
+	suite.False(suite.Watchdog.isStalled(0, suite.Start.Add(time.Hour)),
+		"Playback should never be considered stalled when the watchdog is disabled.")
+}
+
+func TestWatchdogTestSuite(t *testing.T) {
+	suite.Run(t, new(WatchdogTestSuite))
+}
@@ -8,54 +8,161 @@
 package bot
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/Sirupsen/logrus"
+	"github.com/antonholmquist/jason"
+	"github.com/layeh/gumble/gumbleffmpeg"
 	"github.com/matthieugrieger/mumbledj/interfaces"
 	"github.com/spf13/viper"
 )
 
+// youtubeDLPrefixRegex matches the extractor and ID prefix that youtube-dl adds
+// to many of its error messages, e.g. "[youtube] KQY9zrjPBjo: ".
+var youtubeDLPrefixRegex = regexp.MustCompile(`^\[[\w:-]+\] [\w-]+: `)
+
 // YouTubeDL is a struct that gathers all methods related to the youtube-dl
 // software.
 // youtube-dl: https://rg3.github.io/youtube-dl/
-type YouTubeDL struct{}
+type YouTubeDL struct {
+	downloads map[string]*download
+	// finished holds the paths of the files that have been downloaded and
+	// not deleted since, so that files evicted from the cache are noticed.
+	finished map[string]bool
+	mutex    sync.Mutex
+}
+
+// download is a download in progress. done is closed once it has finished,
+// after which err holds its result.
+type download struct {
+	done    chan struct{}
+	err     error
+	waiters int
+}
 
 // Download downloads the audio associated with the incoming `track` object
-// and stores it `track.Filename`.
+// and stores it `track.Filename`. If the same file is already being
+// downloaded, for example because two users queued the same video at nearly
+// the same time, Download waits for that download instead of starting another
+// one.
 func (yt *YouTubeDL) Download(t interfaces.Track) error {
-	player := "--prefer-ffmpeg"
-	if viper.GetString("defaults.player_command") == "avconv" {
-		player = "--prefer-avconv"
+	// Live streams are relayed as they are broadcast, see LiveSource, and
+	// local files are played where they are.
+	if t.IsLive() || t.GetService() == LocalService {
+		return nil
 	}
 
 	filepath := os.ExpandEnv(viper.GetString("cache.directory") + "/" + t.GetFilename())
-
-	// Determine which format to use.
-	format := "bestaudio"
-	for _, service := range DJ.AvailableServices {
-		if service.GetReadableName() == t.GetService() {
-			format = service.GetFormat()
+	err := yt.coalesce(filepath, func() error {
+		return yt.download(t, filepath)
+	})
+	if err == nil {
+		yt.mutex.Lock()
+		if yt.finished == nil {
+			yt.finished = make(map[string]bool)
 		}
+		yt.finished[filepath] = true
+		yt.mutex.Unlock()
+	}
+	return err
+}
+
+// Evicted returns true if `t` has been downloaded but its file has been
+// removed since by something other than Delete, such as the cache making room
+// for newer files.
+func (yt *YouTubeDL) Evicted(t interfaces.Track) bool {
+	filepath := os.ExpandEnv(viper.GetString("cache.directory") + "/" + t.GetFilename())
+	yt.mutex.Lock()
+	finished := yt.finished[filepath]
+	yt.mutex.Unlock()
+	if !finished {
+		return false
 	}
+	_, err := os.Stat(filepath)
+	return os.IsNotExist(err)
+}
+
+// coalesce calls `fn` unless a call for `key` is already in progress, in which
+// case it waits for that call and returns its result.
+func (yt *YouTubeDL) coalesce(key string, fn func() error) error {
+	yt.mutex.Lock()
+	if yt.downloads == nil {
+		yt.downloads = make(map[string]*download)
+	}
+	if d, ok := yt.downloads[key]; ok {
+		d.waiters++
+		yt.mutex.Unlock()
+		<-d.done
+		return d.err
+	}
+	d := &download{done: make(chan struct{})}
+	yt.downloads[key] = d
+	yt.mutex.Unlock()
+
+	d.err = fn()
+
+	yt.mutex.Lock()
+	delete(yt.downloads, key)
+	yt.mutex.Unlock()
+	close(d.done)
+	return d.err
+}
+
+// download downloads the audio of `t` to `filepath` unless it is already
+// there, and writes its metadata sidecar if caching is enabled.
+func (yt *YouTubeDL) download(t interfaces.Track, filepath string) error {
+	player := "--prefer-ffmpeg"
+	if viper.GetString("defaults.player_command") == "avconv" {
+		player = "--prefer-avconv"
+	}
+	format := serviceFormat(t)
 
 	// Check to see if track is already downloaded.
 	if _, err := os.Stat(filepath); os.IsNotExist(err) {
-		var cmd *exec.Cmd
+		if IsReplayMode() {
+			return copyDummyAudio(filepath)
+		}
+
+		args := []string{"--verbose", "--no-mtime", "--output", filepath, "--format", format}
 		if t.GetService() == "Mixcloud" {
-			cmd = exec.Command("youtube-dl", "--verbose", "--no-mtime", "--output", filepath, "--format", format, "--external-downloader", "aria2c", player, t.GetURL())
-		} else {
-			cmd = exec.Command("youtube-dl", "--verbose", "--no-mtime", "--output", filepath, "--format", format, player, t.GetURL())
+			args = append(args, "--external-downloader", "aria2c")
+		}
+		args = append(append(args, loginArgs(t.GetService())...), player)
+		cmd, output, stderr, err := runDownloader(append(args, streamURL(t)))
+		// Tracks blocked in the bot's region are tried once more from elsewhere.
+		geoBlocked := err != nil && isGeoBlocked(stderr)
+		if geoBypass := geoBypassArgs(); geoBlocked && geoBypass != nil {
+			logrus.WithFields(logrus.Fields{
+				"url": t.GetURL(),
+			}).Infoln("The track is blocked in this region, retrying around the block...")
+			cmd, output, stderr, err = runDownloader(append(append(args, geoBypass...), streamURL(t)))
 		}
-		output, err := cmd.CombinedOutput()
 		if err != nil {
-			args := ""
-			for s := range cmd.Args {
-				args += cmd.Args[s] + " "
+			reason := explainReason(t.GetService(), parseYouTubeDLError(stderr))
+			if geoBlocked {
+				reason = fmt.Sprintf("%s (the track is blocked in the region the bot is in, and could not be retrieved around the block)", reason)
+			}
+			downloadErr := &DownloadError{
+				Service: t.GetService(),
+				TrackID: t.GetID(),
+				URL:     t.GetURL(),
+				Command: strings.Replace(strings.Join(cmd.Args, " "), streamURL(t), t.GetURL(), -1),
+				Output:  output,
+				Reason:  reason,
+				Err:     err,
 			}
-			logrus.Warnf("%s\n%s\nyoutube-dl: %s", args, string(output), err.Error())
-			return errors.New("Track download failed")
+			logrus.WithFields(ErrorFields(downloadErr)).Warnln("youtube-dl failed to download a track.")
+			return downloadErr
 		}
 
 		if viper.GetBool("cache.enabled") {
@@ -63,13 +170,252 @@ func (yt *YouTubeDL) Download(t interfaces.Track) error {
 		}
 	}
 
+	// Cached tracks can be queued again later without any API calls.
+	if viper.GetBool("cache.enabled") {
+		if _, err := os.Stat(filepath + sidecarExtension); os.IsNotExist(err) {
+			tagged, _ := applyTags(t)
+			if err := WriteSidecar(tagged); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"filename": t.GetFilename(),
+					"error":    err.Error(),
+				}).Warnln("Could not write the metadata sidecar of a cached track.")
+			}
+		}
+	}
+
 	return nil
 }
 
+// runDownloader runs downloads.command with `args` and returns the command
+// along with everything it wrote and what it wrote to standard error.
+func runDownloader(args []string) (*exec.Cmd, string, string, error) {
+	cmd := exec.Command(downloaderCommand(), args...)
+	var output, stderr bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = io.MultiWriter(&output, &stderr)
+	err := cmd.Run()
+	return cmd, output.String(), stderr.String(), err
+}
+
+// isGeoBlocked returns true if the youtube-dl error output `stderr` says that
+// the media is not available in the region the bot is in.
+func isGeoBlocked(stderr string) bool {
+	reason := strings.ToLower(parseYouTubeDLError(stderr))
+	for _, phrase := range []string{"in your country", "geo restrict", "geo-restrict", "not available in your region", "blocked it in your country"} {
+		if strings.Contains(reason, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+// geoBypassArgs returns the youtube-dl arguments that retrieve media around a
+// regional block: through downloads.geo_proxy if set, and by pretending to be
+// elsewhere if downloads.geo_bypass is enabled. Nil is returned if neither is
+// set.
+func geoBypassArgs() []string {
+	var args []string
+	if viper.GetBool("downloads.geo_bypass") {
+		args = append(args, "--geo-bypass")
+	}
+	if proxy := viper.GetString("downloads.geo_proxy"); proxy != "" {
+		args = append(args, "--geo-verification-proxy", proxy, "--proxy", proxy)
+	}
+	return args
+}
+
+// LiveSource returns an audio source that relays the live stream of `t` as it
+// is broadcast, without writing it to disk.
+func (yt *YouTubeDL) LiveSource(t interfaces.Track) gumbleffmpeg.Source {
+	name, args := liveCommand(t)
+	return gumbleffmpeg.SourceExec(name, args...)
+}
+
+// liveCommand returns the command and arguments that write the live stream of
+// `t` to standard output. Streams are relayed through youtube-dl, except for
+// internet radio stations, which are read directly.
+func liveCommand(t interfaces.Track) (string, []string) {
+	profile := CurrentLatencyProfile()
+	if t.GetService() == "Radio" {
+		// Reconnect if the connection drops, so that stations only stop playing
+		// when they are skipped or stopped.
+		args := append([]string{"-loglevel", "error", "-reconnect", "1", "-reconnect_streamed", "1",
+			"-reconnect_delay_max", "30"}, profile.InputArgs...)
+		return playerCommand(), append(args, "-i", t.GetURL(), "-vn", "-f", "wav", "-")
+	}
+
+	// Live streams are often only offered as HLS or DASH with audio and video
+	// combined, so fall back to the smallest of those.
+	format := serviceFormat(t)
+	if !strings.HasSuffix(format, "/worst") {
+		format += "/worst"
+	}
+	args := append([]string{"--quiet", "--no-part", "--format", format, "--output", "-"}, loginArgs(t.GetService())...)
+	if profile.BufferSize != "" {
+		args = append(args, "--buffer-size", profile.BufferSize)
+	}
+	return downloaderCommand(), append(args, streamURL(t))
+}
+
+// streamURL returns the URL that the media of `t` is downloaded from, which is
+// its URL unless its service provides another.
+func streamURL(t interfaces.Track) string {
+	for _, service := range DJ.AvailableServices {
+		if streamService, ok := service.(interfaces.StreamService); ok && service.GetReadableName() == t.GetService() {
+			return streamService.GetStreamURL(t)
+		}
+	}
+	return t.GetURL()
+}
+
+// downloaderCommand returns the command used to retrieve and download media,
+// which is youtube-dl or a compatible fork such as yt-dlp.
+func downloaderCommand() string {
+	if command := viper.GetString("downloads.command"); command != "" {
+		return command
+	}
+	return "youtube-dl"
+}
+
+// loginArgs returns the youtube-dl arguments that log in to `service` with the
+// account set in logins.<service>, e.g. logins.niconico, either with a cookies
+// file exported from a browser or with a username and password. No arguments
+// are returned if no account is set.
+func loginArgs(service string) []string {
+	key := "logins." + strings.ToLower(service)
+	var args []string
+	if cookies := os.ExpandEnv(viper.GetString(key + ".cookies")); cookies != "" {
+		args = append(args, "--cookies", cookies)
+	}
+	username, password := viper.GetString(key+".username"), viper.GetString(key+".password")
+	if username != "" && password != "" {
+		path, err := loginConfigs.path(key, username, password)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"account": key,
+				"error":   err.Error(),
+			}).Warnln("Could not pass the account to youtube-dl.")
+		} else {
+			args = append(args, "--config-location", path)
+		}
+	}
+	return args
+}
+
+// loginConfigFiles keeps the youtube-dl configuration files that pass the
+// username and password of each account in logins. Passwords are kept off the
+// command line of youtube-dl, where any user of the system could read them.
+type loginConfigFiles struct {
+	files map[string]loginConfigFile
+	mutex sync.Mutex
+}
+
+// loginConfigFile is a configuration file written for an account.
+type loginConfigFile struct {
+	username string
+	password string
+	path     string
+}
+
+var loginConfigs = &loginConfigFiles{files: make(map[string]loginConfigFile)}
+
+// path returns the path of the configuration file that passes `username` and
+// `password` for the account set in `key`. The file is only readable by the
+// user the bot runs as, and is written again when the account changes.
+func (l *loginConfigFiles) path(key, username, password string) (string, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	file, ok := l.files[key]
+	if ok && file.username == username && file.password == password {
+		if _, err := os.Stat(file.path); err == nil {
+			return file.path, nil
+		}
+	}
+	if ok {
+		os.Remove(file.path)
+	}
+
+	// Temporary files are created with permissions 0600.
+	config, err := ioutil.TempFile("", "mumbledj-login-")
+	if err != nil {
+		return "", err
+	}
+	defer config.Close()
+	if _, err := fmt.Fprintf(config, "--username %s\n--password %s\n", quoteOption(username), quoteOption(password)); err != nil {
+		os.Remove(config.Name())
+		return "", err
+	}
+	l.files[key] = loginConfigFile{username, password, config.Name()}
+	return config.Name(), nil
+}
+
+// quoteOption quotes `value` for a youtube-dl configuration file, which is
+// split into arguments like a shell command line.
+func quoteOption(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// explainReason adds a hint to the reason youtube-dl gave for failing to
+// retrieve a track of `service` if the track requires an account that is not
+// set, such as for age-restricted YouTube videos.
+func explainReason(service, reason string) string {
+	lower := strings.ToLower(reason)
+	if !strings.Contains(lower, "confirm your age") && !strings.Contains(lower, "age-restricted") && !strings.Contains(lower, "sign in") {
+		return reason
+	}
+	if loginArgs(service) != nil {
+		return reason
+	}
+	return fmt.Sprintf("%s (set logins.%s.cookies to play videos that require signing in)", reason, strings.ToLower(service))
+}
+
+// GetInfo returns the metadata youtube-dl reports for the media at `url`, such
+// as its title and duration, without downloading it. This is used by services
+// that have no public API. The account set for `service` in logins is used, if
+// any.
+func (yt *YouTubeDL) GetInfo(service, url string) (*jason.Object, error) {
+	return yt.info(service, url, "--no-playlist")
+}
+
+// GetPlaylistInfo is like GetInfo, but lists up to `limit` entries of the
+// playlist at `url` without retrieving each of them, so that they only carry
+// their ID, title and URL. A `limit` of 0 lists every entry.
+func (yt *YouTubeDL) GetPlaylistInfo(service, url string, limit int) (*jason.Object, error) {
+	args := []string{"--flat-playlist", "--yes-playlist"}
+	if limit > 0 {
+		args = append(args, "--playlist-end", strconv.Itoa(limit))
+	}
+	return yt.info(service, url, args...)
+}
+
+// info runs downloads.command with `args` to retrieve the metadata of `url`.
+func (yt *YouTubeDL) info(service, url string, args ...string) (*jason.Object, error) {
+	args = append(append([]string{"--dump-single-json"}, args...), loginArgs(service)...)
+	cmd := exec.Command(downloaderCommand(), append(args, url)...)
+	var output, stderr bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"url":    url,
+			"output": stderr.String(),
+			"error":  err.Error(),
+		}).Warnln("youtube-dl failed to retrieve information about a URL.")
+		if reason := explainReason(service, parseYouTubeDLError(stderr.String())); reason != "" {
+			return nil, fmt.Errorf("Could not retrieve information about the URL: %s", reason)
+		}
+		return nil, errors.New("Could not retrieve information about the URL")
+	}
+	return jason.NewObjectFromBytes(output.Bytes())
+}
+
 // Delete deletes the audio file associated with the incoming `track` object.
 func (yt *YouTubeDL) Delete(t interfaces.Track) error {
-	if !viper.GetBool("cache.enabled") {
+	if !viper.GetBool("cache.enabled") && !t.IsLive() && t.GetService() != LocalService {
 		filePath := os.ExpandEnv(viper.GetString("cache.directory") + "/" + t.GetFilename())
+		yt.mutex.Lock()
+		delete(yt.finished, filePath)
+		yt.mutex.Unlock()
 		if _, err := os.Stat(filePath); err == nil {
 			if err := os.Remove(filePath); err == nil {
 				return nil
@@ -79,3 +425,70 @@ func (yt *YouTubeDL) Delete(t interfaces.Track) error {
 	}
 	return nil
 }
+
+// Download modes, set in downloads.mode and downloads.mode_overrides.
+const (
+	// DownloadAuto downloads the audio format preferred by the service, and
+	// falls back to the full video if the extractor does not offer it.
+	DownloadAuto = "auto"
+	// DownloadAudio only downloads the audio format preferred by the service.
+	DownloadAudio = "audio"
+	// DownloadVideo always downloads the full video, from which the audio is
+	// played.
+	DownloadVideo = "video"
+)
+
+// serviceFormat returns the youtube-dl format used for tracks from the service
+// of `t`. Each service prefers an audio format that suits it; the download
+// mode decides whether the full video is used instead.
+func serviceFormat(t interfaces.Track) string {
+	format := "bestaudio"
+	for _, service := range DJ.AvailableServices {
+		if service.GetReadableName() == t.GetService() && service.GetFormat() != "" {
+			format = service.GetFormat()
+		}
+	}
+
+	switch downloadMode(t.GetService()) {
+	case DownloadAudio:
+		return format
+	case DownloadVideo:
+		return "best"
+	}
+	// Some extractors do not offer the preferred format, e.g. "m4a", in which
+	// case youtube-dl moves on to the next format listed.
+	alternatives := strings.Split(format, "/")
+	if last := alternatives[len(alternatives)-1]; last == "best" || last == "worst" {
+		return format
+	}
+	return format + "/best"
+}
+
+// downloadMode returns the download mode for tracks from `service`. A service
+// may be given its own mode in downloads.mode_overrides.
+func downloadMode(service string) string {
+	if key := "downloads.mode_overrides." + strings.ToLower(service); viper.IsSet(key) {
+		return viper.GetString(key)
+	}
+	return viper.GetString("downloads.mode")
+}
+
+// parseYouTubeDLError extracts the most meaningful part of the error output of
+// youtube-dl, such as "Video unavailable". An empty string is returned if no
+// error message is present.
+func parseYouTubeDLError(output string) string {
+	lines := strings.Split(output, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(line, "ERROR:") {
+			continue
+		}
+		message := strings.TrimSpace(strings.TrimPrefix(line, "ERROR:"))
+		message = youtubeDLPrefixRegex.ReplaceAllString(message, "")
+		if index := strings.Index(message, "; please report this issue"); index != -1 {
+			message = message[:index]
+		}
+		return message
+	}
+	return ""
+}
@@ -0,0 +1,233 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * bot/youtube_dl_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package bot
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type YouTubeDLTestSuite struct {
+	suite.Suite
+	YouTubeDL *YouTubeDL
+}
+
+func (suite *YouTubeDLTestSuite) SetupTest() {
+	suite.YouTubeDL = new(YouTubeDL)
+}
+
+func (suite *YouTubeDLTestSuite) TestCoalesceSharesSimultaneousCalls() {
+	var calls int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	downloadErr := errors.New("download failed")
+	fn := func() error {
+		atomic.AddInt32(&calls, 1)
+		close(started)
+		<-release
+		return downloadErr
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 3)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		errs[0] = suite.YouTubeDL.coalesce("track", fn)
+	}()
+	<-started
+	for i := 1; i < len(errs); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = suite.YouTubeDL.coalesce("track", fn)
+		}(i)
+	}
+	// Only let the download finish once both later calls are waiting for it.
+	for waiters := 0; waiters < 2; runtime.Gosched() {
+		suite.YouTubeDL.mutex.Lock()
+		waiters = suite.YouTubeDL.downloads["track"].waiters
+		suite.YouTubeDL.mutex.Unlock()
+	}
+	close(release)
+	wg.Wait()
+
+	suite.Equal(int32(1), atomic.LoadInt32(&calls))
+	for _, err := range errs {
+		suite.Equal(downloadErr, err, "Every call should get the result of the shared download.")
+	}
+	suite.Empty(suite.YouTubeDL.downloads)
+}
+
+func (suite *YouTubeDLTestSuite) TestCoalesceRunsSeparateKeys() {
+	calls := 0
+	suite.YouTubeDL.coalesce("first", func() error { calls++; return nil })
+	suite.YouTubeDL.coalesce("second", func() error { calls++; return nil })
+	suite.YouTubeDL.coalesce("first", func() error { calls++; return nil })
+
+	suite.Equal(3, calls, "Calls that do not overlap should not be shared.")
+}
+
+func (suite *YouTubeDLTestSuite) TestServiceFormat() {
+	DJ = NewMumbleDJ()
+	DJ.AvailableServices = []interfaces.Service{
+		formatService{"Mixcloud", "m4a"},
+		formatService{"Twitch", "audio_only/worst"},
+	}
+	defer viper.Set("downloads.mode_overrides.twitch", nil)
+
+	suite.Equal("m4a/best", serviceFormat(Track{Service: "Mixcloud"}), "The full video should be the fallback.")
+	suite.Equal("audio_only/worst", serviceFormat(Track{Service: "Twitch"}))
+	suite.Equal("bestaudio/best", serviceFormat(Track{Service: "Unknown"}))
+
+	viper.Set("downloads.mode", DownloadAudio)
+	suite.Equal("m4a", serviceFormat(Track{Service: "Mixcloud"}))
+
+	viper.Set("downloads.mode_overrides.twitch", DownloadVideo)
+	suite.Equal("best", serviceFormat(Track{Service: "Twitch"}))
+	suite.Equal("m4a", serviceFormat(Track{Service: "Mixcloud"}))
+	viper.Set("downloads.mode", DownloadAuto)
+}
+
+func (suite *YouTubeDLTestSuite) TestLoginArgs() {
+	suite.Nil(loginArgs("NicoNico"), "No arguments should be added without an account.")
+
+	viper.Set("logins.niconico.username", "user@example.com")
+	viper.Set("logins.niconico.password", "secret")
+	defer viper.Set("logins.niconico.username", "")
+	defer viper.Set("logins.niconico.password", "")
+
+	args := loginArgs("NicoNico")
+	suite.Require().Len(args, 2)
+	defer os.Remove(args[1])
+	suite.Equal("--config-location", args[0])
+	suite.NotContains(args, "secret", "The password should not be passed on the command line.")
+	suite.Equal(args, loginArgs("NicoNico"), "The configuration file should be reused.")
+	suite.Nil(loginArgs("YouTube"), "The account should only be used for its own service.")
+
+	info, err := os.Stat(args[1])
+	suite.Nil(err)
+	suite.Equal(os.FileMode(0600), info.Mode().Perm(), "Only the bot's user should be able to read the password.")
+	config, _ := ioutil.ReadFile(args[1])
+	suite.Equal("--username \"user@example.com\"\n--password \"secret\"\n", string(config))
+}
+
+func (suite *YouTubeDLTestSuite) TestLoginArgsWhenPasswordChanges() {
+	viper.Set("logins.niconico.username", "user@example.com")
+	viper.Set("logins.niconico.password", "secret")
+	defer viper.Set("logins.niconico.username", "")
+	defer viper.Set("logins.niconico.password", "")
+	first := loginArgs("NicoNico")
+
+	viper.Set("logins.niconico.password", `new "secret"`)
+	second := loginArgs("NicoNico")
+	defer os.Remove(second[1])
+
+	_, err := os.Stat(first[1])
+	suite.True(os.IsNotExist(err), "The file with the old password should be removed.")
+	config, _ := ioutil.ReadFile(second[1])
+	suite.Contains(string(config), `--password "new \"secret\""`)
+}
+
+func (suite *YouTubeDLTestSuite) TestQuoteOption() {
+	suite.Equal(`"pa ss"`, quoteOption("pa ss"))
+	suite.Equal(`"a\\b\"c"`, quoteOption(`a\b"c`))
+}
+
+func (suite *YouTubeDLTestSuite) TestLoginArgsWithCookies() {
+	viper.Set("logins.youtube.cookies", "/tmp/cookies.txt")
+	defer viper.Set("logins.youtube.cookies", "")
+
+	suite.Equal([]string{"--cookies", "/tmp/cookies.txt"}, loginArgs("YouTube"))
+}
+
+func (suite *YouTubeDLTestSuite) TestIsGeoBlocked() {
+	suite.True(isGeoBlocked("ERROR: [youtube] abc: The uploader has not made this video available in your country"))
+	suite.False(isGeoBlocked("ERROR: Video unavailable"))
+}
+
+func (suite *YouTubeDLTestSuite) TestGeoBypassArgs() {
+	viper.Set("downloads.geo_bypass", false)
+	defer viper.Set("downloads.geo_bypass", true)
+	suite.Nil(geoBypassArgs(), "Nothing should be retried without a way around the block.")
+
+	viper.Set("downloads.geo_proxy", "socks5://127.0.0.1:1080")
+	defer viper.Set("downloads.geo_proxy", "")
+	suite.Equal([]string{"--geo-verification-proxy", "socks5://127.0.0.1:1080", "--proxy", "socks5://127.0.0.1:1080"}, geoBypassArgs())
+}
+
+func (suite *YouTubeDLTestSuite) TestExplainReason() {
+	suite.Equal("Video unavailable", explainReason("YouTube", "Video unavailable"))
+	suite.Contains(explainReason("YouTube", "Sign in to confirm your age"), "logins.youtube.cookies")
+
+	viper.Set("logins.youtube.cookies", "/tmp/cookies.txt")
+	defer viper.Set("logins.youtube.cookies", "")
+
+	suite.Equal("Sign in to confirm your age", explainReason("YouTube", "Sign in to confirm your age"),
+		"No hint should be given once cookies are set.")
+}
+
+func (suite *YouTubeDLTestSuite) TestStreamURL() {
+	DJ = NewMumbleDJ()
+	DJ.AvailableServices = []interfaces.Service{
+		formatService{"Mixcloud", "m4a"},
+		streamService{formatService{"Subsonic", "best"}},
+	}
+
+	suite.Equal("https://example.com/stream?id=1&token=secret",
+		streamURL(Track{Service: "Subsonic", URL: "https://example.com/stream?id=1"}))
+	suite.Equal("https://www.mixcloud.com/a/b/", streamURL(Track{Service: "Mixcloud", URL: "https://www.mixcloud.com/a/b/"}))
+}
+
+func (suite *YouTubeDLTestSuite) TestLiveCommandLatency() {
+	DJ = NewMumbleDJ()
+	defer viper.Set("output.latency", "normal")
+
+	viper.Set("output.latency", "low")
+	_, args := liveCommand(Track{Service: "Radio", URL: "http://example.com/stream"})
+	suite.Contains(args, "nobuffer")
+	suite.Equal("http://example.com/stream", args[len(args)-5], "Input options should come before the input.")
+
+	viper.Set("output.latency", "high")
+	_, args = liveCommand(Track{Service: "Twitch", URL: "https://www.twitch.tv/example", Live: true})
+	suite.Equal([]string{"--buffer-size", "64K", "https://www.twitch.tv/example"}, args[len(args)-3:])
+}
+
+// formatService is a service that only has a name and a format.
+type formatService struct {
+	name, format string
+}
+
+func (s formatService) GetReadableName() string { return s.name }
+func (s formatService) GetFormat() string       { return s.format }
+func (s formatService) CheckAPIKey() error      { return nil }
+func (s formatService) CheckURL(string) bool    { return false }
+func (s formatService) GetTracks(string, *gumble.User) ([]interfaces.Track, error) {
+	return nil, nil
+}
+
+// streamService is a service that adds a token to the URL of its tracks.
+type streamService struct {
+	formatService
+}
+
+func (s streamService) GetStreamURL(t interfaces.Track) string { return t.GetURL() + "&token=secret" }
+
+func TestYouTubeDLTestSuite(t *testing.T) {
+	suite.Run(t, new(YouTubeDLTestSuite))
+}
@@ -10,8 +10,14 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"html"
+	"math/rand"
+	"strings"
+	"time"
 
+	"github.com/Sirupsen/logrus"
 	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
 	"github.com/matthieugrieger/mumbledj/interfaces"
 	"github.com/spf13/viper"
 )
@@ -36,6 +42,20 @@ func (c *AddCommand) IsAdminCommand() bool {
 	return viper.GetBool("commands.add.is_admin")
 }
 
+// Signature returns the arguments and flags that the command accepts.
+func (c *AddCommand) Signature() interfaces.Signature {
+	return interfaces.Signature{
+		Arguments: []interfaces.Argument{
+			{Name: "url", Variadic: true},
+		},
+		Flags: []interfaces.Flag{
+			{Name: "next"},
+			{Name: "shuffle"},
+			{Name: "chapters"},
+		},
+	}
+}
+
 // Execute executes the command with the given user and arguments.
 // Return value descriptions:
 //    string: A message to be returned to the user upon successful execution.
@@ -46,35 +66,193 @@ func (c *AddCommand) IsAdminCommand() bool {
 // Example return statement:
 //    return "This is a private message!", true, nil
 func (c *AddCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	return bot.ExecuteWithArguments(user, c, args)
+}
+
+// ExecuteArguments executes the command with the given user and the arguments
+// checked against its signature.
+func (c *AddCommand) ExecuteArguments(user *gumble.User, parsed interfaces.Arguments) (string, bool, error) {
 	var (
-		allTracks      []interfaces.Track
-		tracks         []interfaces.Track
-		service        interfaces.Service
-		err            error
-		lastTrackAdded interfaces.Track
+		allTracks []interfaces.Track
+		tracks    []interfaces.Track
+		service   interfaces.Service
+		err       error
+		lastErr   error
+		notes     []string
+		results   []urlResult
 	)
 
+	args := parsed.Strings("url")
 	if len(args) == 0 {
-		return "", true, errors.New(viper.GetString("commands.add.messages.no_url_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.add.messages.no_url_error"))
+	}
+
+	// Adding tracks as the next item is left to the addnext command, which is
+	// usually restricted to admins.
+	if parsed.Flag("next") {
+		next := new(AddNextCommand)
+		if viper.GetBool("admins.enabled") && next.IsAdminCommand() && !DJ.IsAdmin(user) {
+			return "", true, errors.New(DJ.Localize(user, "commands.add.messages.next_not_allowed_error"))
+		}
+		var nextArgs []string
+		if parsed.Flag("shuffle") {
+			nextArgs = append(nextArgs, "--shuffle")
+		}
+		if parsed.Flag("chapters") {
+			nextArgs = append(nextArgs, "--chapters")
+		}
+		return next.Execute(user, append(append(nextArgs, "--"), args...)...)
+	}
+
+	// Guests supply a one-time code after the URL(s) with their first request.
+	if args, err = DJ.Guests.CheckRequest(user, args); err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.add.messages.guest_code_required_error"))
+	}
+
+	// Search terms may contain spaces, so a search takes up the whole request.
+	if _, _, ok := DJ.GetSearch(args[0]); ok || DJ.IsPlainSearch(args[0]) {
+		args = []string{strings.Join(args, " ")}
 	}
 
 	for _, arg := range args {
-		if service, err = DJ.GetService(arg); err == nil {
+		// Tracks that are already cached are queued from their sidecar, and a
+		// search such as "youtube:search terms" queues the best match.
+		if cached, ok := DJ.Cache.FindTrack(arg); ok {
+			tracks, err = []interfaces.Track{cached.Track(user.Name)}, nil
+		} else if service, err = DJ.GetService(arg); err == nil {
 			tracks, err = service.GetTracks(arg, user)
-			if err == nil {
-				allTracks = append(allTracks, tracks...)
+		} else if searchService, query, ok := DJ.GetSearch(arg); ok {
+			tracks, err = searchService.SearchTracks(query, user, 1)
+		} else if DJ.IsPlainSearch(arg) {
+			// Anything else, such as "artist - song", is searched for too.
+			var searchService interfaces.SearchService
+			if searchService, err = DJ.GetSearchService(viper.GetString("commands.add.search_service")); err == nil {
+				tracks, err = searchService.SearchTracks(arg, user, 1)
+			}
+		} else if err == bot.ErrUnsupportedURL {
+			// Links such as internet radio streams are only recognized by
+			// connecting to them.
+			if probed, ok := DJ.ProbeService(arg, user); ok {
+				tracks, err = probed.GetTracks(arg, user)
+			} else {
+				err = unsupportedURLError(user, arg)
+			}
+		}
+		// Premieres are queued once they start, if they are not rejected.
+		if upcoming, ok := err.(*bot.UpcomingError); ok {
+			var note string
+			if note, err = watchPremiere(user, arg, upcoming); err == nil {
+				notes = append(notes, note)
+				continue
 			}
 		}
+		if err == nil {
+			allTracks = append(allTracks, tracks...)
+			results = append(results, urlResult{url: arg, tracks: len(tracks)})
+		} else {
+			lastErr = err
+			results = append(results, urlResult{url: arg, err: err})
+			fields := bot.ErrorFields(err)
+			fields["url"] = arg
+			logrus.WithFields(fields).Warnln("Could not retrieve tracks for URL.")
+		}
 	}
 
 	if len(allTracks) == 0 {
-		return "", true, errors.New(viper.GetString("commands.add.messages.no_valid_tracks_error"))
+		if len(notes) > 0 {
+			return strings.Join(notes, "<br>"), false, nil
+		}
+		if len(results) > 1 {
+			return "", true, fmt.Errorf("%s%s", DJ.Localize(user, "commands.add.messages.no_valid_tracks_error"),
+				urlSummary(results, func(key string) string { return DJ.Localize(user, key) }))
+		}
+		if lastErr != nil {
+			return "", true, fmt.Errorf("%s<br>%s", DJ.Localize(user, "commands.add.messages.no_valid_tracks_error"), lastErr.Error())
+		}
+		return "", true, errors.New(DJ.Localize(user, "commands.add.messages.no_valid_tracks_error"))
+	}
+
+	if parsed.Flag("chapters") || viper.GetBool("queue.split_chapters") {
+		allTracks = bot.SplitChapters(allTracks)
+	}
+	message, private, err := addTracks(user, allTracks, parsed.Flag("shuffle") || DJ.ShuffleAdds.Enabled(user.Name))
+	if err != nil {
+		return message, private, err
+	}
+	message += urlSummary(results, viper.GetString)
+	if len(notes) > 0 {
+		message += "<br>" + strings.Join(notes, "<br>")
+	}
+	return message, private, nil
+}
+
+// checkGuestCode returns an error if `user` is a guest who has not redeemed a
+// guest code yet. Codes are redeemed with !add and !addnext, so every other
+// way of queueing tracks is closed to guests until they have done so.
+func checkGuestCode(user *gumble.User) error {
+	if DJ.Guests.NeedsCode(user) {
+		return errors.New(DJ.Localize(user, "commands.add.messages.guest_code_required_error"))
+	}
+	return nil
+}
+
+// checkCanQueue returns an error if `user` may not add tracks to the queue.
+// Users who may not use the add command may not queue tracks with any other
+// command either, and guests must have redeemed a guest code first.
+func checkCanQueue(user *gumble.User) error {
+	if err := DJ.CheckQueuePermission(user); err != nil {
+		return err
+	}
+	return checkGuestCode(user)
+}
+
+// addTracks adds the tracks requested by `user` to the queue, or suggests them
+// while a draft is active, and returns the message announcing them. The tracks
+// are added in random order if `shuffle` is true.
+func addTracks(user *gumble.User, allTracks []interfaces.Track, shuffle bool) (string, bool, error) {
+	var (
+		err            error
+		lastTrackAdded interfaces.Track
+	)
+
+	if err = checkCanQueue(user); err != nil {
+		return "", true, err
+	}
+
+	allTracks, numRecentlyPlayed := DJ.History.FilterPlaylistTracks(allTracks)
+	if len(allTracks) == 0 {
+		return "", true, errors.New(DJ.Localize(user, "commands.add.messages.all_tracks_recently_played_error"))
+	}
+	if shuffle {
+		for i := range allTracks {
+			j := rand.Intn(i + 1)
+			allTracks[i], allTracks[j] = allTracks[j], allTracks[i]
+		}
+	}
+
+	if DJ.Draft.IsActive() {
+		first := DJ.Draft.Add(allTracks...)
+		return fmt.Sprintf(viper.GetString("commands.add.messages.tracks_suggested"),
+			user.Name, len(allTracks), first, first+len(allTracks)-1), false, nil
 	}
 
 	numTooLong := 0
+	numOverLimit := 0
 	numAdded := 0
+	// During event mode, tracks added by hosts skip ahead of everybody else's.
+	position := -1
+	if bot.IsEventHost(user.Name) {
+		position = DJ.EventInsertPosition()
+	}
 	for _, track := range allTracks {
-		if err = DJ.Queue.AppendTrack(track); err != nil {
+		if position < 0 {
+			err = DJ.Queue.AppendTrack(track)
+		} else if err = DJ.Queue.InsertTrack(position, track); err == nil {
+			position++
+		}
+		if err == bot.ErrQueueDurationLimit {
+			numOverLimit++
+		} else if err != nil {
 			numTooLong++
 		} else {
 			numAdded++
@@ -82,16 +260,86 @@ func (c *AddCommand) Execute(user *gumble.User, args ...string) (string, bool, e
 		}
 	}
 
-	if numAdded == 0 {
-		return "", true, errors.New(viper.GetString("commands.add.messages.tracks_too_long_error"))
-	} else if numAdded == 1 {
-		return fmt.Sprintf(viper.GetString("commands.add.messages.one_track_added"),
-			user.Name, lastTrackAdded.GetTitle(), lastTrackAdded.GetService()), false, nil
+	if numAdded == 0 && numOverLimit != 0 {
+		maxDuration := time.Duration(viper.GetInt("queue.max_queue_duration")) * time.Second
+		return "", true, fmt.Errorf(DJ.Localize(user, "commands.add.messages.queue_duration_limit_error"), maxDuration.String())
+	} else if numAdded == 0 {
+		return "", true, errors.New(DJ.Localize(user, "commands.add.messages.tracks_too_long_error"))
+	}
+
+	if numAdded == 1 {
+		retString := fmt.Sprintf(viper.GetString("commands.add.messages.one_track_added"),
+			user.Name, lastTrackAdded.GetTitle(), lastTrackAdded.GetService())
+		if numRecentlyPlayed != 0 {
+			retString += fmt.Sprintf(viper.GetString("commands.add.messages.num_tracks_recently_played"), numRecentlyPlayed)
+		}
+		return retString, false, nil
 	}
 
 	retString := fmt.Sprintf(viper.GetString("commands.add.messages.many_tracks_added"), user.Name, numAdded)
 	if numTooLong != 0 {
 		retString += fmt.Sprintf(viper.GetString("commands.add.messages.num_tracks_too_long"), numTooLong)
 	}
+	if numOverLimit != 0 {
+		retString += fmt.Sprintf(viper.GetString("commands.add.messages.num_tracks_over_duration_limit"), numOverLimit)
+	}
+	if numRecentlyPlayed != 0 {
+		retString += fmt.Sprintf(viper.GetString("commands.add.messages.num_tracks_recently_played"), numRecentlyPlayed)
+	}
 	return retString, false, nil
 }
+
+// urlResult is the outcome of looking up one of the URLs given to add.
+type urlResult struct {
+	url    string
+	tracks int
+	err    error
+}
+
+// urlSummary returns a line for each of `results` that tells how many tracks
+// were found at the URL or why it failed, using the messages returned by
+// `message`. Requests with a single URL need no summary, so nothing is
+// returned for them.
+func urlSummary(results []urlResult, message func(key string) string) string {
+	if len(results) < 2 {
+		return ""
+	}
+	var summary string
+	for _, result := range results {
+		if result.err != nil {
+			summary += fmt.Sprintf(message("commands.add.messages.url_failed"), html.EscapeString(result.url), result.err.Error())
+		} else {
+			summary += fmt.Sprintf(message("commands.add.messages.url_found"), html.EscapeString(result.url), result.tracks)
+		}
+	}
+	return summary
+}
+
+// watchPremiere arranges for `url`, which has not started yet, to be queued
+// once it starts if premieres.auto_queue is enabled, and returns the message
+// that tells the channel so. Otherwise, or if the premiere cannot be waited
+// for, an error that tells `user` when to add it is returned.
+func watchPremiere(user *gumble.User, url string, upcoming *bot.UpcomingError) (string, error) {
+	if !viper.GetBool("premieres.auto_queue") {
+		return "", upcoming
+	}
+	switch err := DJ.Premieres.Watch(url, user, upcoming.StartsAt); err {
+	case nil:
+	case bot.ErrPremiereTooFar:
+		return "", fmt.Errorf(DJ.Localize(user, "premieres.messages.premiere_too_far_error"), upcoming.Title, upcoming.StartsIn())
+	case bot.ErrPremiereWatched:
+		return "", fmt.Errorf(DJ.Localize(user, "premieres.messages.premiere_watched_error"), upcoming.Title)
+	default:
+		return "", err
+	}
+	if upcoming.StartsAt.IsZero() {
+		return fmt.Sprintf(viper.GetString("premieres.messages.premiere_watched_unscheduled"), user.Name, upcoming.Title), nil
+	}
+	return fmt.Sprintf(viper.GetString("premieres.messages.premiere_watched"), user.Name, upcoming.Title, upcoming.StartsIn()), nil
+}
+
+// unsupportedURLError returns the error shown to `user` when `url` does not
+// match any enabled service, listing the sites that are supported.
+func unsupportedURLError(user *gumble.User, url string) error {
+	return fmt.Errorf(DJ.Localize(user, "commands.common_messages.unsupported_url_error"), url, strings.Join(DJ.ServiceNames(), ", "))
+}
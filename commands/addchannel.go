@@ -0,0 +1,95 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/addchannel.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// AddChannelCommand is a command that adds the latest uploads of a channel,
+// such as a YouTube channel, to the queue.
+type AddChannelCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *AddChannelCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.addchannel.aliases")
+}
+
+// Description returns the description for the command.
+func (c *AddChannelCommand) Description() string {
+	return viper.GetString("commands.addchannel.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *AddChannelCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.addchannel.is_admin")
+}
+
+// Signature returns the arguments and flags that the command accepts.
+func (c *AddChannelCommand) Signature() interfaces.Signature {
+	return interfaces.Signature{
+		Arguments: []interfaces.Argument{
+			{Name: "url"},
+			{Name: "count", Type: interfaces.IntArgument, Optional: true},
+		},
+	}
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *AddChannelCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	return bot.ExecuteWithArguments(user, c, args)
+}
+
+// ExecuteArguments executes the command with the given user and the arguments
+// checked against its signature.
+func (c *AddChannelCommand) ExecuteArguments(user *gumble.User, parsed interfaces.Arguments) (string, bool, error) {
+	// Users who may not queue tracks are turned away before the uploads are
+	// looked up.
+	if err := checkCanQueue(user); err != nil {
+		return "", true, err
+	}
+	count := viper.GetInt("commands.addchannel.default_count")
+	if parsed.Has("count") {
+		count = parsed.Int("count")
+	}
+	if count < 1 {
+		return "", true, errors.New(DJ.Localize(user, "commands.addchannel.messages.invalid_count_error"))
+	}
+
+	url := parsed.String("url")
+	service, err := DJ.GetChannelService(url)
+	if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.addchannel.messages.not_channel_error"))
+	}
+	tracks, err := service.GetChannelTracks(url, user, count)
+	if err != nil {
+		fields := bot.ErrorFields(err)
+		fields["url"] = url
+		logrus.WithFields(fields).Warnln("Could not retrieve the uploads of a channel.")
+		return "", true, fmt.Errorf("%s<br>%s", DJ.Localize(user, "commands.add.messages.no_valid_tracks_error"), err.Error())
+	}
+
+	// The uploads are added newest first, as listed by the channel.
+	return addTracks(user, tracks, false)
+}
@@ -0,0 +1,130 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/addliked.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// AddLikedCommand is a command that adds the videos a user has liked on their
+// YouTube account to the queue. The first time it is used, the user is asked
+// to link their account.
+type AddLikedCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *AddLikedCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.addliked.aliases")
+}
+
+// Description returns the description for the command.
+func (c *AddLikedCommand) Description() string {
+	return viper.GetString("commands.addliked.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *AddLikedCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.addliked.is_admin")
+}
+
+// Signature returns the arguments and flags that the command accepts.
+func (c *AddLikedCommand) Signature() interfaces.Signature {
+	return interfaces.Signature{
+		Arguments: []interfaces.Argument{
+			{Name: "count", Type: interfaces.IntArgument, Optional: true},
+		},
+		Flags: []interfaces.Flag{
+			{Name: "unlink"},
+		},
+	}
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *AddLikedCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	return bot.ExecuteWithArguments(user, c, args)
+}
+
+// ExecuteArguments executes the command with the given user and the arguments
+// checked against its signature.
+func (c *AddLikedCommand) ExecuteArguments(user *gumble.User, parsed interfaces.Arguments) (string, bool, error) {
+	if parsed.Flag("unlink") {
+		unlinked, err := DJ.Accounts.Unlink(user)
+		if err != nil {
+			logrus.WithFields(bot.ErrorFields(err)).Warnln("Could not save the linked accounts.")
+			return "", true, err
+		}
+		if !unlinked {
+			return "", true, errors.New(DJ.Localize(user, "commands.addliked.messages.not_linked_error"))
+		}
+		return DJ.Localize(user, "commands.addliked.messages.unlinked"), true, nil
+	}
+
+	service, err := DJ.GetLikedService()
+	if err != nil || !DJ.Accounts.Configured() {
+		return "", true, errors.New(DJ.Localize(user, "commands.addliked.messages.not_configured_error"))
+	}
+	if err := checkCanQueue(user); err != nil {
+		return "", true, err
+	}
+	// Names are not protected for users who are not registered, so only
+	// registered users can link an account that nobody else can use.
+	if !user.IsRegistered() {
+		return "", true, errors.New(DJ.Localize(user, "commands.addliked.messages.not_registered_error"))
+	}
+	count := viper.GetInt("commands.addliked.default_count")
+	if parsed.Has("count") {
+		count = parsed.Int("count")
+	}
+	if count < 1 {
+		return "", true, errors.New(DJ.Localize(user, "commands.addliked.messages.invalid_count_error"))
+	}
+
+	if !DJ.Accounts.Linked(user) {
+		return c.link(user)
+	}
+	tracks, err := service.GetLikedTracks(user, count)
+	if err == bot.ErrAccountNotLinked {
+		// The account was unlinked on YouTube's side, so it is linked anew.
+		return c.link(user)
+	}
+	if err != nil {
+		logrus.WithFields(bot.ErrorFields(err)).Warnln("Could not retrieve the liked videos of a user.")
+		return "", true, fmt.Errorf("%s<br>%s", DJ.Localize(user, "commands.add.messages.no_valid_tracks_error"), err.Error())
+	}
+	return addTracks(user, tracks, false)
+}
+
+// link starts linking the YouTube account of `user` and tells them where to
+// enter their code.
+func (c *AddLikedCommand) link(user *gumble.User) (string, bool, error) {
+	code, err := DJ.Accounts.Link(user)
+	if err == bot.ErrLinkPending {
+		return "", true, errors.New(DJ.Localize(user, "commands.addliked.messages.link_pending_error"))
+	}
+	if err != nil {
+		logrus.WithFields(bot.ErrorFields(err)).Warnln("Could not start linking an account.")
+		return "", true, fmt.Errorf(DJ.Localize(user, "accounts.messages.link_failed"), err.Error())
+	}
+	return fmt.Sprintf(DJ.Localize(user, "commands.addliked.messages.link_instructions"),
+		code.VerificationURL, code.VerificationURL, code.UserCode), true, nil
+}
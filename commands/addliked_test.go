@@ -0,0 +1,8 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ * commands/addliked_test.go
+ */
+
+package commands
@@ -10,8 +10,13 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"math/rand"
+	"strings"
+	"time"
 
+	"github.com/Sirupsen/logrus"
 	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
 	"github.com/matthieugrieger/mumbledj/interfaces"
 	"github.com/spf13/viper"
 )
@@ -36,6 +41,19 @@ func (c *AddNextCommand) IsAdminCommand() bool {
 	return viper.GetBool("commands.addnext.is_admin")
 }
 
+// Signature returns the arguments and flags that the command accepts.
+func (c *AddNextCommand) Signature() interfaces.Signature {
+	return interfaces.Signature{
+		Arguments: []interfaces.Argument{
+			{Name: "url", Variadic: true},
+		},
+		Flags: []interfaces.Flag{
+			{Name: "shuffle"},
+			{Name: "chapters"},
+		},
+	}
+}
+
 // Execute executes the command with the given user and arguments.
 // Return value descriptions:
 //    string: A message to be returned to the user upon successful execution.
@@ -46,36 +64,122 @@ func (c *AddNextCommand) IsAdminCommand() bool {
 // Example return statement:
 //    return "This is a private message!", true, nil
 func (c *AddNextCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	return bot.ExecuteWithArguments(user, c, args)
+}
+
+// ExecuteArguments executes the command with the given user and the arguments
+// checked against its signature.
+func (c *AddNextCommand) ExecuteArguments(user *gumble.User, parsed interfaces.Arguments) (string, bool, error) {
 	var (
 		allTracks      []interfaces.Track
 		tracks         []interfaces.Track
 		service        interfaces.Service
 		err            error
+		lastErr        error
 		lastTrackAdded interfaces.Track
+		notes          []string
+		results        []urlResult
 	)
 
+	args := parsed.Strings("url")
 	if len(args) == 0 {
-		return "", true, errors.New(viper.GetString("commands.add.messages.no_url_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.add.messages.no_url_error"))
+	}
+
+	if err = DJ.CheckQueuePermission(user); err != nil {
+		return "", true, err
+	}
+
+	// Guests supply a one-time code after the URL(s) with their first request.
+	if args, err = DJ.Guests.CheckRequest(user, args); err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.add.messages.guest_code_required_error"))
+	}
+
+	// Search terms may contain spaces, so a search takes up the whole request.
+	if _, _, ok := DJ.GetSearch(args[0]); ok || DJ.IsPlainSearch(args[0]) {
+		args = []string{strings.Join(args, " ")}
 	}
 
 	for _, arg := range args {
-		if service, err = DJ.GetService(arg); err == nil {
+		// Tracks that are already cached are queued from their sidecar, and a
+		// search such as "youtube:search terms" queues the best match.
+		if cached, ok := DJ.Cache.FindTrack(arg); ok {
+			tracks, err = []interfaces.Track{cached.Track(user.Name)}, nil
+		} else if service, err = DJ.GetService(arg); err == nil {
 			tracks, err = service.GetTracks(arg, user)
-			if err == nil {
-				allTracks = append(allTracks, tracks...)
+		} else if searchService, query, ok := DJ.GetSearch(arg); ok {
+			tracks, err = searchService.SearchTracks(query, user, 1)
+		} else if DJ.IsPlainSearch(arg) {
+			// Anything else, such as "artist - song", is searched for too.
+			var searchService interfaces.SearchService
+			if searchService, err = DJ.GetSearchService(viper.GetString("commands.add.search_service")); err == nil {
+				tracks, err = searchService.SearchTracks(arg, user, 1)
+			}
+		} else if err == bot.ErrUnsupportedURL {
+			// Links such as internet radio streams are only recognized by
+			// connecting to them.
+			if probed, ok := DJ.ProbeService(arg, user); ok {
+				tracks, err = probed.GetTracks(arg, user)
+			} else {
+				err = unsupportedURLError(user, arg)
 			}
 		}
+		// Premieres are queued once they start, if they are not rejected.
+		if upcoming, ok := err.(*bot.UpcomingError); ok {
+			var note string
+			if note, err = watchPremiere(user, arg, upcoming); err == nil {
+				notes = append(notes, note)
+				continue
+			}
+		}
+		if err == nil {
+			allTracks = append(allTracks, tracks...)
+			results = append(results, urlResult{url: arg, tracks: len(tracks)})
+		} else {
+			lastErr = err
+			results = append(results, urlResult{url: arg, err: err})
+			fields := bot.ErrorFields(err)
+			fields["url"] = arg
+			logrus.WithFields(fields).Warnln("Could not retrieve tracks for URL.")
+		}
 	}
 
 	if len(allTracks) == 0 {
-		return "", true, errors.New(viper.GetString("commands.add.messages.no_valid_tracks_error"))
+		if len(notes) > 0 {
+			return strings.Join(notes, "<br>"), false, nil
+		}
+		if len(results) > 1 {
+			return "", true, fmt.Errorf("%s%s", DJ.Localize(user, "commands.add.messages.no_valid_tracks_error"),
+				urlSummary(results, func(key string) string { return DJ.Localize(user, key) }))
+		}
+		if lastErr != nil {
+			return "", true, fmt.Errorf("%s<br>%s", DJ.Localize(user, "commands.add.messages.no_valid_tracks_error"), lastErr.Error())
+		}
+		return "", true, errors.New(DJ.Localize(user, "commands.add.messages.no_valid_tracks_error"))
+	}
+
+	if parsed.Flag("chapters") || viper.GetBool("queue.split_chapters") {
+		allTracks = bot.SplitChapters(allTracks)
+	}
+	allTracks, numRecentlyPlayed := DJ.History.FilterPlaylistTracks(allTracks)
+	if len(allTracks) == 0 {
+		return "", true, errors.New(DJ.Localize(user, "commands.add.messages.all_tracks_recently_played_error"))
+	}
+	if parsed.Flag("shuffle") || DJ.ShuffleAdds.Enabled(user.Name) {
+		for i := range allTracks {
+			j := rand.Intn(i + 1)
+			allTracks[i], allTracks[j] = allTracks[j], allTracks[i]
+		}
 	}
 
 	numTooLong := 0
+	numOverLimit := 0
 	numAdded := 0
 	// We must loop backwards here to preserve the track order when inserting tracks.
 	for i := len(allTracks) - 1; i >= 0; i-- {
-		if err = DJ.Queue.InsertTrack(1, allTracks[i]); err != nil {
+		if err = DJ.Queue.InsertTrack(1, allTracks[i]); err == bot.ErrQueueDurationLimit {
+			numOverLimit++
+		} else if err != nil {
 			numTooLong++
 		} else {
 			numAdded++
@@ -83,16 +187,39 @@ func (c *AddNextCommand) Execute(user *gumble.User, args ...string) (string, boo
 		}
 	}
 
-	if numAdded == 0 {
-		return "", true, errors.New(viper.GetString("commands.add.messages.tracks_too_long_error"))
-	} else if numAdded == 1 {
-		return fmt.Sprintf(viper.GetString("commands.add.messages.one_track_added"),
-			user.Name, lastTrackAdded.GetTitle(), lastTrackAdded.GetService()), false, nil
+	if numAdded == 0 && numOverLimit != 0 {
+		maxDuration := time.Duration(viper.GetInt("queue.max_queue_duration")) * time.Second
+		return "", true, fmt.Errorf(DJ.Localize(user, "commands.add.messages.queue_duration_limit_error"), maxDuration.String())
+	} else if numAdded == 0 {
+		return "", true, errors.New(DJ.Localize(user, "commands.add.messages.tracks_too_long_error"))
+	}
+
+	if numAdded == 1 {
+		retString := fmt.Sprintf(viper.GetString("commands.add.messages.one_track_added"),
+			user.Name, lastTrackAdded.GetTitle(), lastTrackAdded.GetService())
+		if numRecentlyPlayed != 0 {
+			retString += fmt.Sprintf(viper.GetString("commands.add.messages.num_tracks_recently_played"), numRecentlyPlayed)
+		}
+		retString += urlSummary(results, viper.GetString)
+		if len(notes) > 0 {
+			retString += "<br>" + strings.Join(notes, "<br>")
+		}
+		return retString, false, nil
 	}
 
 	retString := fmt.Sprintf(viper.GetString("commands.add.messages.many_tracks_added"), user.Name, numAdded)
 	if numTooLong != 0 {
 		retString += fmt.Sprintf(viper.GetString("commands.add.messages.num_tracks_too_long"), numTooLong)
 	}
+	if numOverLimit != 0 {
+		retString += fmt.Sprintf(viper.GetString("commands.add.messages.num_tracks_over_duration_limit"), numOverLimit)
+	}
+	if numRecentlyPlayed != 0 {
+		retString += fmt.Sprintf(viper.GetString("commands.add.messages.num_tracks_recently_played"), numRecentlyPlayed)
+	}
+	retString += urlSummary(results, viper.GetString)
+	if len(notes) > 0 {
+		retString += "<br>" + strings.Join(notes, "<br>")
+	}
 	return retString, false, nil
 }
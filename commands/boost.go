@@ -0,0 +1,70 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/boost.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// BoostCommand is a command that boosts a track in the queue, which may move it
+// ahead of tracks with fewer boosts.
+type BoostCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *BoostCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.boost.aliases")
+}
+
+// Description returns the description for the command.
+func (c *BoostCommand) Description() string {
+	return viper.GetString("commands.boost.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *BoostCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.boost.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *BoostCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if len(args) == 0 {
+		return "", true, errors.New(DJ.Localize(user, "commands.boost.messages.no_position_error"))
+	}
+
+	position, err := strconv.Atoi(args[0])
+	if err != nil || position < 2 || position > DJ.Queue.Length() {
+		return "", true, errors.New(DJ.Localize(user, "commands.boost.messages.invalid_position_error"))
+	}
+
+	track := DJ.Queue.GetTrack(position - 1)
+	if track.GetSubmitter() == user.Name {
+		return "", true, errors.New(DJ.Localize(user, "commands.boost.messages.own_track_error"))
+	}
+
+	newIndex, err := DJ.Queue.Boost(position-1, user.Name)
+	if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.boost.messages.already_boosted_error"))
+	}
+
+	return fmt.Sprintf(viper.GetString("commands.boost.messages.boosted"),
+		user.Name, track.GetTitle(), DJ.Queue.Boosts(track), newIndex+1), false, nil
+}
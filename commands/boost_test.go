@@ -0,0 +1,8 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ * commands/boost_test.go
+ */
+
+package commands
@@ -0,0 +1,98 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/cached.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/spf13/viper"
+)
+
+// CachedCommand is a command that searches the tracks stored in the cache and
+// adds one of the results to the queue by number, without any API calls.
+type CachedCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *CachedCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.cached.aliases")
+}
+
+// Description returns the description for the command.
+func (c *CachedCommand) Description() string {
+	return viper.GetString("commands.cached.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *CachedCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.cached.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *CachedCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if !viper.GetBool("cache.enabled") {
+		return "", true, errors.New(DJ.Localize(user, "commands.common_messages.caching_disabled_error"))
+	}
+
+	if len(args) == 1 {
+		if n, err := strconv.Atoi(args[0]); err == nil {
+			return c.addResult(user, n)
+		}
+	}
+
+	results := DJ.Cache.Search(user.Name, args...)
+	if len(results) == 0 {
+		return "", true, errors.New(DJ.Localize(user, "commands.cached.messages.no_results_error"))
+	}
+
+	message := DJ.Localize(user, "commands.cached.messages.results_header")
+	maxResults := viper.GetInt("commands.cached.max_results")
+	for i, cached := range results {
+		if maxResults > 0 && i >= maxResults {
+			message += fmt.Sprintf(DJ.Localize(user, "commands.cached.messages.more_results"), len(results)-maxResults)
+			break
+		}
+		message += fmt.Sprintf(viper.GetString("commands.cached.messages.result_listing"),
+			i+1, cached.Track(user.Name).GetTitle(), cached.Duration.String())
+	}
+	return message, true, nil
+}
+
+// addResult appends result number `n` of the last search made by `user` to the
+// queue.
+func (c *CachedCommand) addResult(user *gumble.User, n int) (string, bool, error) {
+	cached, err := DJ.Cache.SearchResult(user.Name, n)
+	if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.cached.messages.no_result_error"))
+	}
+
+	if err := checkCanQueue(user); err != nil {
+		return "", true, err
+	}
+	track := cached.Track(user.Name)
+	if err := DJ.Queue.AppendTrack(track); err == bot.ErrQueueDurationLimit {
+		maxDuration := time.Duration(viper.GetInt("queue.max_queue_duration")) * time.Second
+		return "", true, fmt.Errorf(DJ.Localize(user, "commands.add.messages.queue_duration_limit_error"), maxDuration.String())
+	} else if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.add.messages.tracks_too_long_error"))
+	}
+	return fmt.Sprintf(viper.GetString("commands.cached.messages.track_added"), user.Name, track.GetTitle()), false, nil
+}
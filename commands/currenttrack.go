@@ -12,6 +12,7 @@ import (
 	"fmt"
 
 	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
 	"github.com/matthieugrieger/mumbledj/interfaces"
 	"github.com/spf13/viper"
 )
@@ -52,9 +53,17 @@ func (c *CurrentTrackCommand) Execute(user *gumble.User, args ...string) (string
 	)
 
 	if currentTrack, err = DJ.Queue.CurrentTrack(); err != nil {
-		return "", true, errors.New(viper.GetString("commands.common_messages.no_tracks_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.common_messages.no_tracks_error"))
 	}
 
-	return fmt.Sprintf(viper.GetString("commands.currenttrack.messages.current_track"),
+	if title := DJ.Radio.StreamTitle(); title != "" {
+		return fmt.Sprintf(DJ.Localize(user, "commands.currenttrack.messages.current_radio_track"),
+			currentTrack.GetTitle(), title, currentTrack.GetSubmitter()), true, nil
+	}
+	if info := bot.ParseSongInfo(currentTrack); info.Artist != "" {
+		return fmt.Sprintf(DJ.Localize(user, "commands.currenttrack.messages.current_track_with_artist"),
+			info.Title, info.Artist, currentTrack.GetSubmitter()), true, nil
+	}
+	return fmt.Sprintf(DJ.Localize(user, "commands.currenttrack.messages.current_track"),
 		currentTrack.GetTitle(), currentTrack.GetSubmitter()), true, nil
 }
@@ -0,0 +1,66 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/eventmode.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// EventModeCommand is a command that turns event mode on or off. While event
+// mode is on, tracks added by the configured hosts are played next.
+type EventModeCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *EventModeCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.eventmode.aliases")
+}
+
+// Description returns the description for the command.
+func (c *EventModeCommand) Description() string {
+	return viper.GetString("commands.eventmode.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *EventModeCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.eventmode.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *EventModeCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if len(args) == 0 {
+		if viper.GetBool("queue.event_mode_on") {
+			return DJ.Localize(user, "commands.eventmode.messages.status_on"), true, nil
+		}
+		return DJ.Localize(user, "commands.eventmode.messages.status_off"), true, nil
+	}
+
+	switch strings.ToLower(args[0]) {
+	case "on":
+		viper.Set("queue.event_mode_on", true)
+		return fmt.Sprintf(viper.GetString("commands.eventmode.messages.event_mode_on"),
+			strings.Join(viper.GetStringSlice("queue.event_hosts"), ", ")), false, nil
+	case "off":
+		viper.Set("queue.event_mode_on", false)
+		return viper.GetString("commands.eventmode.messages.event_mode_off"), false, nil
+	}
+	return "", true, errors.New(DJ.Localize(user, "commands.eventmode.messages.invalid_argument_error"))
+}
@@ -0,0 +1,76 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/failures.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"strconv"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/layeh/gumble/gumbleutil"
+	"github.com/spf13/viper"
+)
+
+// FailuresCommand is a command that lists recent commands that were not
+// recognized, were denied, or returned an error.
+type FailuresCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *FailuresCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.failures.aliases")
+}
+
+// Description returns the description for the command.
+func (c *FailuresCommand) Description() string {
+	return viper.GetString("commands.failures.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *FailuresCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.failures.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *FailuresCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	numFailuresToList := viper.GetInt("commands.failures.history_size")
+	if len(args) != 0 {
+		if parsedNum, err := strconv.Atoi(args[0]); err == nil && parsedNum > 0 {
+			numFailuresToList = parsedNum
+		} else {
+			return "", true, errors.New(DJ.Localize(user, "commands.failures.messages.invalid_integer_error"))
+		}
+	}
+
+	failures := DJ.Failures.Recent(numFailuresToList)
+	if len(failures) == 0 {
+		return DJ.Localize(user, "commands.failures.messages.no_failures"), true, nil
+	}
+
+	var buffer bytes.Buffer
+	for _, failure := range failures {
+		// Reasons may come from youtube-dl or an API, and are listed as plain
+		// text like the commands themselves.
+		reason := gumbleutil.PlainText(&gumble.TextMessage{Message: failure.Reason})
+		buffer.WriteString(fmt.Sprintf(DJ.Localize(user, "commands.failures.messages.failure_listing"),
+			failure.Time.Format("15:04:05"), html.EscapeString(failure.User), html.EscapeString(failure.Message),
+			html.EscapeString(reason)))
+	}
+	return buffer.String(), true, nil
+}
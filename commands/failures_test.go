@@ -0,0 +1,42 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ * commands/failures_test.go
+ */
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/stretchr/testify/suite"
+)
+
+type FailuresCommandTestSuite struct {
+	Command FailuresCommand
+	suite.Suite
+}
+
+func (suite *FailuresCommandTestSuite) SetupTest() {
+	DJ = bot.NewMumbleDJ()
+	bot.DJ = DJ
+}
+
+func (suite *FailuresCommandTestSuite) TestExecuteEscapesFailures() {
+	DJ.Failures.Record("<i>Eve</i>", "add <img src=x>", "ERROR: <script>alert(1)</script> & <b>more</b>")
+
+	message, _, err := suite.Command.Execute(&gumble.User{Name: "Admin"})
+
+	suite.Nil(err)
+	suite.Contains(message, "&lt;i&gt;Eve&lt;/i&gt;")
+	suite.Contains(message, "add &lt;img src=x&gt;")
+	suite.NotContains(message, "<script>")
+	suite.NotContains(message, "<img")
+}
+
+func TestFailuresCommandTestSuite(t *testing.T) {
+	suite.Run(t, new(FailuresCommandTestSuite))
+}
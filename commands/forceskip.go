@@ -45,7 +45,7 @@ func (c *ForceSkipCommand) IsAdminCommand() bool {
 //    return "This is a private message!", true, nil
 func (c *ForceSkipCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
 	if DJ.Queue.Length() == 0 {
-		return "", true, errors.New(viper.GetString("commands.common_messages.no_tracks_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.common_messages.no_tracks_error"))
 	}
 
 	DJ.Queue.StopCurrent()
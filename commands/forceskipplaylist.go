@@ -52,11 +52,11 @@ func (c *ForceSkipPlaylistCommand) Execute(user *gumble.User, args ...string) (s
 	)
 
 	if currentTrack, err = DJ.Queue.CurrentTrack(); err != nil {
-		return "", true, errors.New(viper.GetString("commands.common_messages.no_tracks_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.common_messages.no_tracks_error"))
 	}
 
 	if playlist := currentTrack.GetPlaylist(); playlist == nil {
-		return "", true, errors.New(viper.GetString("commands.forceskipplaylist.messages.no_playlist_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.forceskipplaylist.messages.no_playlist_error"))
 	}
 
 	DJ.Queue.SkipPlaylist()
@@ -0,0 +1,57 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/guestcode.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// GuestCodeCommand is a command that generates a one-time code that a guest
+// supplies with their first request.
+type GuestCodeCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *GuestCodeCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.guestcode.aliases")
+}
+
+// Description returns the description for the command.
+func (c *GuestCodeCommand) Description() string {
+	return viper.GetString("commands.guestcode.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *GuestCodeCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.guestcode.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *GuestCodeCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	code, err := DJ.Guests.NewCode()
+	if err != nil {
+		return "", true, err
+	}
+	message := fmt.Sprintf(DJ.Localize(user, "commands.guestcode.messages.code_generated"),
+		code, viper.GetInt("guests.code_lifetime"))
+	if !viper.GetBool("guests.require_code") {
+		message += DJ.Localize(user, "commands.guestcode.messages.codes_not_required")
+	}
+	return message, true, nil
+}
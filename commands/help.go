@@ -9,8 +9,13 @@ package commands
 
 import (
 	"fmt"
+	"html"
+	"reflect"
+	"strings"
 
 	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
 	"github.com/spf13/viper"
 )
 
@@ -49,8 +54,24 @@ func (c *HelpCommand) Execute(user *gumble.User, args ...string) (string, bool,
 	adminCommands := ""
 	totalString := ""
 
+	language := ""
+	if user != nil {
+		language = DJ.Languages.Get(user.Name)
+	}
+
 	for _, command := range Commands {
-		currentString := fmt.Sprintf(commandString, command.Aliases(), command.Description())
+		description := command.Description()
+		// Command types are named after their configuration section, such as
+		// ListTracksCommand for commands.listtracks.
+		name := strings.ToLower(strings.TrimSuffix(reflect.TypeOf(command).Elem().Name(), "Command"))
+		if translated, ok := DJ.Languages.Lookup(language, "commands."+name+".description"); ok {
+			description = translated
+		}
+		// Commands that declare their arguments show how they are used.
+		if signatureCommand, ok := command.(interfaces.SignatureCommand); ok && len(command.Aliases()) > 0 {
+			description += " <i>" + html.EscapeString(bot.Usage(command.Aliases()[0], signatureCommand.Signature())) + "</i>"
+		}
+		currentString := fmt.Sprintf(commandString, command.Aliases(), description)
 		if command.IsAdminCommand() {
 			adminCommands += currentString
 		} else {
@@ -58,7 +79,7 @@ func (c *HelpCommand) Execute(user *gumble.User, args ...string) (string, bool,
 		}
 	}
 
-	totalString = viper.GetString("commands.help.messages.commands_header") + regularCommands
+	totalString = DJ.Localize(user, "commands.help.messages.commands_header") + regularCommands
 
 	isAdmin := false
 	if viper.GetBool("admins.enabled") {
@@ -68,7 +89,7 @@ func (c *HelpCommand) Execute(user *gumble.User, args ...string) (string, bool,
 	}
 
 	if isAdmin {
-		totalString += viper.GetString("commands.help.messages.admin_commands_header") + adminCommands
+		totalString += DJ.Localize(user, "commands.help.messages.admin_commands_header") + adminCommands
 	}
 
 	return totalString, true, nil
@@ -0,0 +1,52 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/hold.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// HoldCommand is a command that puts the music on hold while a meeting takes
+// place in the channel.
+type HoldCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *HoldCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.hold.aliases")
+}
+
+// Description returns the description for the command.
+func (c *HoldCommand) Description() string {
+	return viper.GetString("commands.hold.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *HoldCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.hold.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *HoldCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if err := DJ.Hold.Start(); err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.hold.messages.already_held_error"))
+	}
+	return fmt.Sprintf(viper.GetString("commands.hold.messages.held"), user.Name), false, nil
+}
@@ -0,0 +1,59 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/import.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/spf13/viper"
+)
+
+// ImportCommand is a command that imports aliases and settings from another
+// MumbleDJ instance's configuration file.
+type ImportCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *ImportCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.import.aliases")
+}
+
+// Description returns the description for the command.
+func (c *ImportCommand) Description() string {
+	return viper.GetString("commands.import.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *ImportCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.import.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *ImportCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if len(args) == 0 {
+		return "", true, errors.New(DJ.Localize(user, "commands.import.messages.no_source_error"))
+	}
+
+	keys, err := bot.ImportSettings(args[0])
+	if err != nil {
+		return "", true, err
+	}
+
+	return fmt.Sprintf(DJ.Localize(user, "commands.import.messages.settings_imported"), len(keys), args[0]), true, nil
+}
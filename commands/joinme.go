@@ -47,12 +47,12 @@ func (c *JoinMeCommand) IsAdminCommand() bool {
 func (c *JoinMeCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
 	if DJ.AudioStream != nil && DJ.AudioStream.State() == gumbleffmpeg.StatePlaying &&
 		len(DJ.Client.Self.Channel.Users) > 1 {
-		return "", true, errors.New(viper.GetString("commands.joinme.messages.others_are_listening_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.joinme.messages.others_are_listening_error"))
 	}
 
 	DJ.Client.Do(func() {
 		DJ.Client.Self.Move(user.Channel)
 	})
 
-	return viper.GetString("commands.joinme.messages.in_your_channel"), true, nil
+	return DJ.Localize(user, "commands.joinme.messages.in_your_channel"), true, nil
 }
@@ -0,0 +1,90 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/jump.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// JumpCommand is a command that plays the current track from the start of
+// one of the songs in its tracklist.
+type JumpCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *JumpCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.jump.aliases")
+}
+
+// Description returns the description for the command.
+func (c *JumpCommand) Description() string {
+	return viper.GetString("commands.jump.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *JumpCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.jump.is_admin")
+}
+
+// Signature returns the arguments and flags that the command accepts.
+func (c *JumpCommand) Signature() interfaces.Signature {
+	return interfaces.Signature{
+		Arguments: []interfaces.Argument{
+			{Name: "number", Type: interfaces.IntArgument},
+		},
+	}
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *JumpCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	return bot.ExecuteWithArguments(user, c, args)
+}
+
+// ExecuteArguments executes the command with the given user and the arguments
+// checked against its signature.
+func (c *JumpCommand) ExecuteArguments(user *gumble.User, parsed interfaces.Arguments) (string, bool, error) {
+	track, err := DJ.Queue.CurrentTrack()
+	if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.common_messages.no_tracks_error"))
+	}
+	tracklist := bot.TracklistOf(track)
+	if tracklist == nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.tracklist.messages.no_tracklist_error"))
+	}
+	// Jumping around affects everyone listening, so it is left to the
+	// submitter of the track and admins.
+	if track.GetSubmitter() != user.Name && !DJ.IsAdmin(user) {
+		return "", true, errors.New(DJ.Localize(user, "commands.jump.messages.not_allowed_error"))
+	}
+	number := parsed.Int("number")
+	if number < 1 || number > len(tracklist.Chapters) {
+		return "", true, fmt.Errorf(DJ.Localize(user, "commands.jump.messages.invalid_number_error"), number)
+	}
+
+	chapter := tracklist.Chapters[number-1]
+	if err := DJ.Queue.(*bot.Queue).Seek(chapter.Start); err != nil {
+		logrus.WithFields(bot.ErrorFields(err)).Warnln("Could not jump within the current track.")
+		return "", true, err
+	}
+	return fmt.Sprintf(viper.GetString("commands.jump.messages.jumped"), user.Name, chapter.Title), false, nil
+}
@@ -0,0 +1,85 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/karaoke.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/spf13/viper"
+)
+
+// KaraokeCommand is a command that searches for a karaoke or instrumental
+// version of the current track and adds it as the next item in the queue.
+type KaraokeCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *KaraokeCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.karaoke.aliases")
+}
+
+// Description returns the description for the command.
+func (c *KaraokeCommand) Description() string {
+	return viper.GetString("commands.karaoke.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *KaraokeCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.karaoke.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *KaraokeCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if err := checkCanQueue(user); err != nil {
+		return "", true, err
+	}
+	currentTrack, err := DJ.Queue.CurrentTrack()
+	if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.common_messages.no_tracks_error"))
+	}
+
+	service, err := DJ.GetSearchService(currentTrack.GetService())
+	if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.karaoke.messages.no_search_service_error"))
+	}
+
+	info := bot.ParseSongInfo(currentTrack)
+	query := strings.TrimSpace(fmt.Sprintf("%s %s %s", info.Artist, info.Title, viper.GetString("commands.karaoke.search_terms")))
+	results, err := service.SearchTracks(query, user, 5)
+	if err != nil {
+		fields := bot.ErrorFields(err)
+		fields["query"] = query
+		logrus.WithFields(fields).Warnln("Karaoke search failed.")
+		return "", true, errors.New(DJ.Localize(user, "commands.karaoke.messages.no_results_error"))
+	}
+
+	for _, track := range results {
+		if track.GetID() == currentTrack.GetID() {
+			continue
+		}
+		if err := DJ.Queue.InsertTrack(1, track); err != nil {
+			continue
+		}
+		return fmt.Sprintf(viper.GetString("commands.karaoke.messages.karaoke_added"),
+			user.Name, track.GetTitle(), currentTrack.GetTitle()), false, nil
+	}
+	return "", true, errors.New(DJ.Localize(user, "commands.karaoke.messages.no_results_error"))
+}
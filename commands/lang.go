@@ -0,0 +1,68 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/lang.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// LangCommand is a command that sets the language of the private replies sent
+// to the user.
+type LangCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *LangCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.lang.aliases")
+}
+
+// Description returns the description for the command.
+func (c *LangCommand) Description() string {
+	return viper.GetString("commands.lang.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *LangCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.lang.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *LangCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if len(args) == 0 {
+		current := DJ.Languages.Get(user.Name)
+		if current == "" {
+			current = "default"
+		}
+		available := append([]string{"default"}, DJ.Languages.Available()...)
+		return fmt.Sprintf(DJ.Localize(user, "commands.lang.messages.current_language"),
+			current, strings.Join(available, ", ")), true, nil
+	}
+
+	code := strings.ToLower(args[0])
+	if code == "default" {
+		DJ.Languages.Set(user.Name, "")
+		return DJ.Localize(user, "commands.lang.messages.language_reset"), true, nil
+	}
+	if err := DJ.Languages.Set(user.Name, code); err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.lang.messages.unavailable_error"))
+	}
+	return fmt.Sprintf(DJ.Localize(user, "commands.lang.messages.language_set"), code), true, nil
+}
@@ -0,0 +1,64 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/monitor.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// MonitorCommand is a command that switches the local device the audio sent
+// to Mumble is also played on, so it can be previewed on the machine running
+// the bot.
+type MonitorCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *MonitorCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.monitor.aliases")
+}
+
+// Description returns the description for the command.
+func (c *MonitorCommand) Description() string {
+	return viper.GetString("commands.monitor.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *MonitorCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.monitor.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *MonitorCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if len(args) == 0 {
+		return fmt.Sprintf(DJ.Localize(user, "commands.monitor.messages.current_device"), DJ.Monitor.Device()), true, nil
+	}
+
+	device := strings.ToLower(args[0])
+	switch device {
+	case "pulse", "alsa", "off":
+	default:
+		return "", true, errors.New(DJ.Localize(user, "commands.monitor.messages.invalid_device_error"))
+	}
+	if err := DJ.Monitor.SetDevice(device); err != nil {
+		return "", true, err
+	}
+	return fmt.Sprintf(DJ.Localize(user, "commands.monitor.messages.device_changed"), device), true, nil
+}
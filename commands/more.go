@@ -0,0 +1,108 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/more.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// MoreCommand is a command that adds the next batch of tracks of the last
+// playlist the user added that was cut off at queue.max_tracks_per_playlist.
+type MoreCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *MoreCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.more.aliases")
+}
+
+// Description returns the description for the command.
+func (c *MoreCommand) Description() string {
+	return viper.GetString("commands.more.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *MoreCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.more.is_admin")
+}
+
+// Signature returns the arguments and flags that the command accepts.
+func (c *MoreCommand) Signature() interfaces.Signature {
+	return interfaces.Signature{
+		Arguments: []interfaces.Argument{
+			{Name: "count", Type: interfaces.IntArgument, Optional: true},
+		},
+	}
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *MoreCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	return bot.ExecuteWithArguments(user, c, args)
+}
+
+// ExecuteArguments executes the command with the given user and the arguments
+// checked against its signature.
+func (c *MoreCommand) ExecuteArguments(user *gumble.User, parsed interfaces.Arguments) (string, bool, error) {
+	// Users who may not queue tracks are turned away before the playlist is
+	// looked up again.
+	if err := checkCanQueue(user); err != nil {
+		return "", true, err
+	}
+	count := math.MaxInt32
+	if max := viper.GetInt("queue.max_tracks_per_playlist"); max > 0 {
+		count = max
+	}
+	if parsed.Has("count") {
+		if parsed.Int("count") < 1 {
+			return "", true, errors.New(DJ.Localize(user, "commands.more.messages.invalid_count_error"))
+		}
+		if parsed.Int("count") < count {
+			count = parsed.Int("count")
+		}
+	}
+
+	continuation, ok := DJ.Continuations.Get(user.Name)
+	if !ok {
+		return "", true, errors.New(DJ.Localize(user, "commands.more.messages.no_playlist_error"))
+	}
+	var service interfaces.ContinuationService
+	for _, available := range DJ.AvailableServices {
+		if s, ok := available.(interfaces.ContinuationService); ok && s.GetReadableName() == continuation.Playlist.GetService() {
+			service = s
+		}
+	}
+	if service == nil {
+		DJ.Continuations.Clear(user.Name)
+		return "", true, errors.New(DJ.Localize(user, "commands.more.messages.no_playlist_error"))
+	}
+
+	tracks, err := service.ContinuePlaylist(continuation.Playlist, continuation.Token, count, user)
+	if err != nil {
+		fields := bot.ErrorFields(err)
+		fields["playlist"] = continuation.Playlist.GetID()
+		logrus.WithFields(fields).Warnln("Could not continue a playlist.")
+		return "", true, fmt.Errorf("%s<br>%s", DJ.Localize(user, "commands.add.messages.no_valid_tracks_error"), err.Error())
+	}
+	return addTracks(user, tracks, DJ.ShuffleAdds.Enabled(user.Name))
+}
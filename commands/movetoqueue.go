@@ -0,0 +1,83 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/movetoqueue.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// MoveToQueueCommand is a command that moves a track from one named queue to
+// another.
+type MoveToQueueCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *MoveToQueueCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.movetoqueue.aliases")
+}
+
+// Description returns the description for the command.
+func (c *MoveToQueueCommand) Description() string {
+	return viper.GetString("commands.movetoqueue.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *MoveToQueueCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.movetoqueue.is_admin")
+}
+
+// Signature returns the arguments and flags that the command accepts.
+func (c *MoveToQueueCommand) Signature() interfaces.Signature {
+	return interfaces.Signature{
+		Arguments: []interfaces.Argument{
+			{Name: "position", Type: interfaces.IntArgument},
+			{Name: "queue"},
+		},
+		Flags: []interfaces.Flag{
+			{Name: "from", TakesValue: true},
+		},
+	}
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *MoveToQueueCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	return bot.ExecuteWithArguments(user, c, args)
+}
+
+// ExecuteArguments executes the command with the given user and the arguments
+// checked against its signature.
+func (c *MoveToQueueCommand) ExecuteArguments(user *gumble.User, parsed interfaces.Arguments) (string, bool, error) {
+	from := parsed.String("from")
+	track, err := DJ.Queues.Move(from, parsed.Int("position"), parsed.String("queue"))
+	switch err {
+	case nil:
+	case bot.ErrSameQueue:
+		return "", true, errors.New(DJ.Localize(user, "commands.movetoqueue.messages.same_queue_error"))
+	case bot.ErrUnknownQueue:
+		return "", true, fmt.Errorf(DJ.Localize(user, "commands.movetoqueue.messages.unknown_queue_error"), from)
+	case bot.ErrNoTrackAtPosition:
+		return "", true, fmt.Errorf(DJ.Localize(user, "commands.movetoqueue.messages.invalid_position_error"), parsed.Int("position"))
+	default:
+		return "", true, err
+	}
+	return fmt.Sprintf(viper.GetString("commands.movetoqueue.messages.moved"), user.Name, track.GetTitle(), parsed.String("queue")), false, nil
+}
@@ -0,0 +1,75 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/movetrack.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// MoveTrackCommand is a command that moves a track to another position in the
+// queue.
+type MoveTrackCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *MoveTrackCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.movetrack.aliases")
+}
+
+// Description returns the description for the command.
+func (c *MoveTrackCommand) Description() string {
+	return viper.GetString("commands.movetrack.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *MoveTrackCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.movetrack.is_admin")
+}
+
+// Signature returns the arguments and flags that the command accepts.
+func (c *MoveTrackCommand) Signature() interfaces.Signature {
+	return interfaces.Signature{
+		Arguments: []interfaces.Argument{
+			{Name: "from", Type: interfaces.IntArgument},
+			{Name: "to", Type: interfaces.IntArgument},
+		},
+	}
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *MoveTrackCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	return bot.ExecuteWithArguments(user, c, args)
+}
+
+// ExecuteArguments executes the command with the given user and the arguments
+// checked against its signature.
+func (c *MoveTrackCommand) ExecuteArguments(user *gumble.User, parsed interfaces.Arguments) (string, bool, error) {
+	// Positions are shown by !listtracks, where the current track is 1.
+	from, to := parsed.Int("from"), parsed.Int("to")
+	track, err := DJ.Queue.MoveTrack(from-1, to-1)
+	if err == bot.ErrNoTrackAtPosition {
+		return "", true, errors.New(DJ.Localize(user, "commands.movetrack.messages.invalid_position_error"))
+	} else if err != nil {
+		return "", true, err
+	}
+	return fmt.Sprintf(viper.GetString("commands.movetrack.messages.moved"), user.Name, track.GetTitle(), from, to), false, nil
+}
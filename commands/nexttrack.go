@@ -47,14 +47,14 @@ func (c *NextTrackCommand) IsAdminCommand() bool {
 func (c *NextTrackCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
 	length := DJ.Queue.Length()
 	if length == 0 {
-		return "", true, errors.New(viper.GetString("commands.common_messages.no_tracks_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.common_messages.no_tracks_error"))
 	}
 	if length == 1 {
-		return "", true, errors.New(viper.GetString("commands.nexttrack.messages.current_track_only_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.nexttrack.messages.current_track_only_error"))
 	}
 
 	nextTrack, _ := DJ.Queue.PeekNextTrack()
 
-	return fmt.Sprintf(viper.GetString("commands.nexttrack.messages.next_track"),
+	return fmt.Sprintf(DJ.Localize(user, "commands.nexttrack.messages.next_track"),
 		nextTrack.GetTitle(), nextTrack.GetSubmitter()), true, nil
 }
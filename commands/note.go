@@ -0,0 +1,104 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/note.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"html"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// NoteCommand is a command that attaches a note to the current track, which
+// is shown whenever the track is played again.
+type NoteCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *NoteCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.note.aliases")
+}
+
+// Description returns the description for the command.
+func (c *NoteCommand) Description() string {
+	return viper.GetString("commands.note.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *NoteCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.note.is_admin")
+}
+
+// Signature returns the arguments and flags that the command accepts.
+func (c *NoteCommand) Signature() interfaces.Signature {
+	return interfaces.Signature{
+		Arguments: []interfaces.Argument{
+			{Name: "text", Optional: true, Variadic: true},
+		},
+		Flags: []interfaces.Flag{
+			{Name: "clear"},
+		},
+	}
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *NoteCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	return bot.ExecuteWithArguments(user, c, args)
+}
+
+// ExecuteArguments executes the command with the given user and the arguments
+// checked against its signature.
+func (c *NoteCommand) ExecuteArguments(user *gumble.User, parsed interfaces.Arguments) (string, bool, error) {
+	track, err := DJ.Queue.CurrentTrack()
+	if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.common_messages.no_tracks_error"))
+	}
+
+	if parsed.Flag("clear") {
+		if _, ok := DJ.History.Note(track); !ok {
+			return "", true, errors.New(DJ.Localize(user, "commands.note.messages.no_note_error"))
+		}
+		if err := DJ.History.SetNote(track, "", user.Name); err != nil {
+			logrus.WithFields(bot.ErrorFields(err)).Warnln("Could not save the notes.")
+			return "", true, err
+		}
+		return fmt.Sprintf(DJ.Localize(user, "commands.note.messages.note_cleared"), track.GetTitle()), true, nil
+	}
+
+	if !parsed.Has("text") {
+		note, ok := DJ.History.Note(track)
+		if !ok {
+			return "", true, errors.New(DJ.Localize(user, "commands.note.messages.no_note_error"))
+		}
+		return fmt.Sprintf(DJ.Localize(user, "commands.note.messages.note_listing"),
+			track.GetTitle(), html.EscapeString(note.Text), note.Author), true, nil
+	}
+
+	text := parsed.String("text")
+	if max := viper.GetInt("commands.note.max_length"); max > 0 && len([]rune(text)) > max {
+		return "", true, fmt.Errorf(DJ.Localize(user, "commands.note.messages.note_too_long_error"), max)
+	}
+	if err := DJ.History.SetNote(track, text, user.Name); err != nil {
+		logrus.WithFields(bot.ErrorFields(err)).Warnln("Could not save the notes.")
+		return "", true, err
+	}
+	return fmt.Sprintf(DJ.Localize(user, "commands.note.messages.note_saved"), track.GetTitle()), true, nil
+}
@@ -0,0 +1,49 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/notify.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// NotifyCommand is a command that toggles private messages letting the user know
+// when a track they added begins playing.
+type NotifyCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *NotifyCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.notify.aliases")
+}
+
+// Description returns the description for the command.
+func (c *NotifyCommand) Description() string {
+	return viper.GetString("commands.notify.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *NotifyCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.notify.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *NotifyCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if DJ.Notifications.Toggle(user.Name) {
+		return DJ.Localize(user, "commands.notify.messages.notifications_on"), true, nil
+	}
+	return DJ.Localize(user, "commands.notify.messages.notifications_off"), true, nil
+}
@@ -46,8 +46,8 @@ func (c *NumTracksCommand) IsAdminCommand() bool {
 func (c *NumTracksCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
 	length := DJ.Queue.Length()
 	if length == 1 {
-		return viper.GetString("commands.numtracks.messages.one_track"), true, nil
+		return DJ.Localize(user, "commands.numtracks.messages.one_track"), true, nil
 	}
 
-	return fmt.Sprintf(viper.GetString("commands.numtracks.messages.plural_tracks"), length), true, nil
+	return fmt.Sprintf(DJ.Localize(user, "commands.numtracks.messages.plural_tracks"), length), true, nil
 }
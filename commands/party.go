@@ -0,0 +1,71 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/party.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// PartyCommand is a command that links the bot's transmissions to several
+// channels for a listening party, or ends the running listening party.
+type PartyCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *PartyCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.party.aliases")
+}
+
+// Description returns the description for the command.
+func (c *PartyCommand) Description() string {
+	return viper.GetString("commands.party.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *PartyCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.party.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *PartyCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if len(args) == 0 {
+		return "", true, errors.New(DJ.Localize(user, "commands.party.messages.no_channel_error"))
+	}
+
+	if len(args) == 1 && strings.ToLower(args[0]) == "end" {
+		if err := DJ.Party.End(); err != nil {
+			return "", true, errors.New(DJ.Localize(user, "commands.party.messages.no_party_error"))
+		}
+		return fmt.Sprintf(viper.GetString("commands.party.messages.party_ended"), user.Name), false, nil
+	}
+
+	linked, skipped, err := DJ.Party.Start(args)
+	if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.party.messages.no_valid_channels_error"))
+	}
+	message := fmt.Sprintf(viper.GetString("commands.party.messages.party_started"),
+		user.Name, strings.Join(linked, ", "))
+	if len(skipped) > 0 {
+		message += fmt.Sprintf(viper.GetString("commands.party.messages.channels_skipped"),
+			strings.Join(skipped, ", "))
+	}
+	return message, false, nil
+}
@@ -46,7 +46,7 @@ func (c *PauseCommand) IsAdminCommand() bool {
 func (c *PauseCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
 	err := DJ.Queue.PauseCurrent()
 	if err != nil {
-		return "", true, errors.New(viper.GetString("commands.pause.messages.no_audio_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.pause.messages.no_audio_error"))
 	}
 	return fmt.Sprintf(viper.GetString("commands.pause.messages.paused"), user.Name), false, nil
 }
@@ -21,30 +21,70 @@ var Commands []interfaces.Command
 func init() {
 	Commands = []interfaces.Command{
 		new(AddCommand),
+		new(AddChannelCommand),
+		new(AddLikedCommand),
 		new(AddNextCommand),
+		new(BoostCommand),
+		new(CachedCommand),
 		new(CacheSizeCommand),
 		new(CurrentTrackCommand),
+		new(EventModeCommand),
+		new(FailuresCommand),
 		new(ForceSkipCommand),
 		new(ForceSkipPlaylistCommand),
+		new(GuestCodeCommand),
 		new(HelpCommand),
+		new(HoldCommand),
+		new(ImportCommand),
 		new(JoinMeCommand),
+		new(JumpCommand),
+		new(KaraokeCommand),
 		new(KillCommand),
+		new(LangCommand),
 		new(ListTracksCommand),
+		new(MonitorCommand),
+		new(MoreCommand),
 		new(MoveCommand),
+		new(MoveToQueueCommand),
+		new(MoveTrackCommand),
 		new(NextTrackCommand),
+		new(NoteCommand),
+		new(NotifyCommand),
 		new(NumCachedCommand),
 		new(NumTracksCommand),
+		new(PartyCommand),
 		new(PauseCommand),
+		new(PlanCommand),
+		new(PlexCommand),
+		new(PreviewCommand),
+		new(PrivateAnnounceCommand),
+		new(PurgeUserCommand),
+		new(QuotaCommand),
 		new(RegisterCommand),
 		new(ReloadCommand),
+		new(RemoveCommand),
 		new(ResetCommand),
+		new(RestartCommand),
 		new(ResumeCommand),
+		new(SCSearchCommand),
 		new(SetCommentCommand),
+		new(SetDefaultCommand),
+		new(ShoutoutCommand),
 		new(ShuffleCommand),
+		new(ShutdownCommand),
 		new(SkipCommand),
 		new(SkipPlaylistCommand),
+		new(StartPartyCommand),
+		new(StopAtCommand),
+		new(StopLiveCommand),
 		new(ToggleShuffleCommand),
+		new(TracklistCommand),
+		new(UnholdCommand),
+		new(UpvoteCommand),
+		new(UseQueueCommand),
 		new(VersionCommand),
+		new(VetoCommand),
 		new(VolumeCommand),
+		new(VolumeScheduleCommand),
 	}
 }
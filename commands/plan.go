@@ -0,0 +1,52 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/plan.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// PlanCommand is a command that starts a planning phase in which
+// added tracks are collected into a draft and voted on instead of queued.
+type PlanCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *PlanCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.plan.aliases")
+}
+
+// Description returns the description for the command.
+func (c *PlanCommand) Description() string {
+	return viper.GetString("commands.plan.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *PlanCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.plan.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *PlanCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if err := DJ.Draft.Start(); err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.plan.messages.already_planning_error"))
+	}
+	return fmt.Sprintf(viper.GetString("commands.plan.messages.planning_started"), user.Name), false, nil
+}
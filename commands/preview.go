@@ -0,0 +1,118 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/preview.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// PreviewCommand is a command that describes the track or playlist behind a URL
+// to the user without adding it to the queue.
+type PreviewCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *PreviewCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.preview.aliases")
+}
+
+// Description returns the description for the command.
+func (c *PreviewCommand) Description() string {
+	return viper.GetString("commands.preview.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *PreviewCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.preview.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *PreviewCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	var (
+		tracks  []interfaces.Track
+		service interfaces.Service
+		err     error
+	)
+
+	if len(args) == 0 {
+		return "", true, errors.New(DJ.Localize(user, "commands.preview.messages.no_url_error"))
+	}
+	arg := args[0]
+	// Search terms may contain spaces, so a search takes up the whole request.
+	if _, _, ok := DJ.GetSearch(arg); ok {
+		arg = strings.Join(args, " ")
+	}
+	if service, err = DJ.GetService(arg); err == nil {
+		tracks, err = service.GetTracks(arg, user)
+	} else if searchService, query, ok := DJ.GetSearch(arg); ok {
+		tracks, err = searchService.SearchTracks(query, user, 1)
+	} else if err == bot.ErrUnsupportedURL {
+		// Links such as internet radio streams are only recognized by
+		// connecting to them.
+		if probed, ok := DJ.ProbeService(arg, user); ok {
+			tracks, err = probed.GetTracks(arg, user)
+		} else {
+			err = unsupportedURLError(user, arg)
+		}
+	}
+	if err != nil {
+		fields := bot.ErrorFields(err)
+		fields["url"] = arg
+		logrus.WithFields(fields).Warnln("Could not retrieve tracks for URL.")
+		return "", true, fmt.Errorf("%s<br>%s", DJ.Localize(user, "commands.preview.messages.no_valid_tracks_error"), err.Error())
+	}
+	if len(tracks) == 0 {
+		return "", true, errors.New(DJ.Localize(user, "commands.preview.messages.no_valid_tracks_error"))
+	}
+
+	track := tracks[0]
+	message := ""
+	if thumbnail := track.GetThumbnailURL(); thumbnail != "" {
+		message += DJ.Thumbnails.ImageTag(thumbnail) + "<br>"
+	}
+	message += fmt.Sprintf(DJ.Localize(user, "commands.preview.messages.track_preview"),
+		track.GetURL(), track.GetTitle(), previewDuration(user, track), track.GetService())
+	if author := track.GetAuthor(); author != "" {
+		message += fmt.Sprintf(DJ.Localize(user, "commands.preview.messages.track_author"), author)
+	}
+
+	if playlist := track.GetPlaylist(); playlist != nil {
+		var total time.Duration
+		for _, t := range tracks {
+			total += t.GetDuration()
+		}
+		message += fmt.Sprintf(DJ.Localize(user, "commands.preview.messages.playlist_preview"),
+			playlist.GetTitle(), len(tracks), total.String())
+	}
+	return message, true, nil
+}
+
+// previewDuration returns the duration of `track` for display to `user`.
+func previewDuration(user *gumble.User, track interfaces.Track) string {
+	if track.IsLive() {
+		return DJ.Localize(user, "queue.messages.live")
+	}
+	return track.GetDuration().String()
+}
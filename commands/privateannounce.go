@@ -0,0 +1,49 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/privateannounce.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// PrivateAnnounceCommand is a command that toggles whether announcements for
+// tracks added by the user are sent privately to them instead of the channel.
+type PrivateAnnounceCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *PrivateAnnounceCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.privateannounce.aliases")
+}
+
+// Description returns the description for the command.
+func (c *PrivateAnnounceCommand) Description() string {
+	return viper.GetString("commands.privateannounce.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *PrivateAnnounceCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.privateannounce.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *PrivateAnnounceCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if DJ.PrivateAnnounce.Toggle(user.Name) {
+		return DJ.Localize(user, "commands.privateannounce.messages.private_on"), true, nil
+	}
+	return DJ.Localize(user, "commands.privateannounce.messages.private_off"), true, nil
+}
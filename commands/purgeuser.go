@@ -0,0 +1,65 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/purgeuser.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// PurgeUserCommand is a command that removes all of a user's tracks from the
+// queue at once.
+type PurgeUserCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *PurgeUserCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.purgeuser.aliases")
+}
+
+// Description returns the description for the command.
+func (c *PurgeUserCommand) Description() string {
+	return viper.GetString("commands.purgeuser.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *PurgeUserCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.purgeuser.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *PurgeUserCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if len(args) == 0 {
+		return "", true, errors.New(DJ.Localize(user, "commands.purgeuser.messages.no_user_error"))
+	}
+	name := strings.Join(args, " ")
+
+	removed, current := DJ.Queue.RemoveSubmitter(name)
+	if current {
+		// The current track is removed by the skip that follows stopping it.
+		DJ.Queue.StopCurrent()
+		removed++
+	}
+	if removed == 0 {
+		return "", true, fmt.Errorf(DJ.Localize(user, "commands.purgeuser.messages.no_tracks_error"), name)
+	}
+
+	return fmt.Sprintf(viper.GetString("commands.purgeuser.messages.tracks_purged"), user.Name, removed, name), false, nil
+}
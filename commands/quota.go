@@ -0,0 +1,59 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/quota.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// QuotaCommand is a command that outputs the estimated YouTube API usage of
+// the day.
+type QuotaCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *QuotaCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.quota.aliases")
+}
+
+// Description returns the description for the command.
+func (c *QuotaCommand) Description() string {
+	return viper.GetString("commands.quota.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *QuotaCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.quota.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *QuotaCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	units, calls := DJ.Quota.Usage()
+	budget := viper.GetInt("quota.youtube_daily_units")
+	if budget <= 0 {
+		return fmt.Sprintf(DJ.Localize(user, "commands.quota.messages.usage_unlimited"), units, calls), true, nil
+	}
+
+	remaining := budget - units
+	message := fmt.Sprintf(DJ.Localize(user, "commands.quota.messages.usage"), units, budget, calls, remaining)
+	if remaining < viper.GetInt("quota.low_priority_reserve") {
+		message += DJ.Localize(user, "commands.quota.messages.low_priority_deferred")
+	}
+	return message, true, nil
+}
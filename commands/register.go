@@ -44,10 +44,10 @@ func (c *RegisterCommand) IsAdminCommand() bool {
 //    return "This is a private message!", true, nil
 func (c *RegisterCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
 	if DJ.Client.Self.IsRegistered() {
-		return "", true, errors.New(viper.GetString("commands.register.messages.already_registered_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.register.messages.already_registered_error"))
 	}
 
 	DJ.Client.Self.Register()
 
-	return viper.GetString("commands.register.messages.registered"), true, nil
+	return DJ.Localize(user, "commands.register.messages.registered"), true, nil
 }
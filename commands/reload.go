@@ -47,6 +47,6 @@ func (c *ReloadCommand) Execute(user *gumble.User, args ...string) (string, bool
 		return "", true, err
 	}
 
-	return viper.GetString("commands.reload.messages.reloaded"),
+	return DJ.Localize(user, "commands.reload.messages.reloaded"),
 		true, nil
 }
@@ -0,0 +1,84 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/remove.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// RemoveCommand is a command that removes a track from the queue by its
+// position.
+type RemoveCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *RemoveCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.remove.aliases")
+}
+
+// Description returns the description for the command.
+func (c *RemoveCommand) Description() string {
+	return viper.GetString("commands.remove.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *RemoveCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.remove.is_admin")
+}
+
+// Signature returns the arguments and flags that the command accepts.
+func (c *RemoveCommand) Signature() interfaces.Signature {
+	return interfaces.Signature{
+		Arguments: []interfaces.Argument{
+			{Name: "position", Type: interfaces.IntArgument},
+		},
+	}
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *RemoveCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	return bot.ExecuteWithArguments(user, c, args)
+}
+
+// ExecuteArguments executes the command with the given user and the arguments
+// checked against its signature.
+func (c *RemoveCommand) ExecuteArguments(user *gumble.User, parsed interfaces.Arguments) (string, bool, error) {
+	// Positions are shown by !listtracks, where the current track is 1.
+	position := parsed.Int("position")
+	// Users other than admins can only remove the tracks they added.
+	var allowed func(interfaces.Track) bool
+	if !DJ.IsAdmin(user) {
+		allowed = func(track interfaces.Track) bool {
+			return track.GetSubmitter() == user.Name
+		}
+	}
+
+	track, err := DJ.Queue.RemoveTrackAt(position-1, allowed)
+	if err == bot.ErrNoTrackAtPosition {
+		return "", true, errors.New(DJ.Localize(user, "commands.remove.messages.invalid_position_error"))
+	} else if err == bot.ErrNotAllowedToRemove {
+		return "", true, errors.New(DJ.Localize(user, "commands.remove.messages.not_allowed_error"))
+	} else if err != nil {
+		return "", true, err
+	}
+	return fmt.Sprintf(viper.GetString("commands.remove.messages.removed"), user.Name, track.GetTitle(), position), false, nil
+}
@@ -45,12 +45,13 @@ func (c *ResetCommand) IsAdminCommand() bool {
 //    return "This is a private message!", true, nil
 func (c *ResetCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
 	if DJ.Queue.Length() == 0 {
-		return "", true, errors.New(viper.GetString("commands.common_messages.no_tracks_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.common_messages.no_tracks_error"))
 	}
 
 	if DJ.AudioStream != nil {
 		DJ.AudioStream.Stop()
 		DJ.AudioStream = nil
+		DJ.Monitor.Stop()
 	}
 
 	DJ.Queue.Reset()
@@ -0,0 +1,66 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/restart.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// RestartCommand is a command that saves the queue and restarts the bot.
+type RestartCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *RestartCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.restart.aliases")
+}
+
+// Description returns the description for the command.
+func (c *RestartCommand) Description() string {
+	return viper.GetString("commands.restart.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *RestartCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.restart.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *RestartCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	binary, err := os.Executable()
+	if err != nil {
+		return "", true, err
+	}
+	if err := DJ.SaveState(); err != nil {
+		return "", true, err
+	}
+
+	DJ.Client.Do(func() {
+		DJ.Client.Self.Channel.Send(fmt.Sprintf(viper.GetString("commands.restart.messages.restarting"), user.Name), false)
+	})
+
+	// The connection to the server is closed when the process image is
+	// replaced, so there is no need to disconnect beforehand.
+	if err := syscall.Exec(binary, os.Args, os.Environ()); err != nil {
+		return "", true, err
+	}
+	return "", true, nil
+}
@@ -46,7 +46,7 @@ func (c *ResumeCommand) IsAdminCommand() bool {
 func (c *ResumeCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
 	err := DJ.Queue.ResumeCurrent()
 	if err != nil {
-		return "", true, errors.New(viper.GetString("commands.resume.messages.audio_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.resume.messages.audio_error"))
 	}
 	return fmt.Sprintf(viper.GetString("commands.resume.messages.resumed"), user.Name), false, nil
 }
@@ -0,0 +1,61 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/scsearch.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// SCSearchCommand is a command that searches SoundCloud for a track and adds
+// the top match to the queue.
+type SCSearchCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *SCSearchCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.scsearch.aliases")
+}
+
+// Description returns the description for the command.
+func (c *SCSearchCommand) Description() string {
+	return viper.GetString("commands.scsearch.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *SCSearchCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.scsearch.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *SCSearchCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	// The add command is executed directly, without the checks made on the
+	// commands users send.
+	if err := DJ.CheckQueuePermission(user); err != nil {
+		return "", true, err
+	}
+	if len(args) == 0 {
+		return "", true, errors.New(DJ.Localize(user, "commands.scsearch.messages.no_query_error"))
+	}
+	if _, _, ok := DJ.GetSearch("soundcloud:"); !ok {
+		return "", true, errors.New(DJ.Localize(user, "commands.scsearch.messages.soundcloud_disabled_error"))
+	}
+
+	// The search is added like any other request, e.g. "!add soundcloud:search terms".
+	return new(AddCommand).Execute(user, append([]string{"soundcloud:"}, args...)...)
+}
@@ -0,0 +1,54 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ * commands/scsearch_test.go
+ */
+
+package commands
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type SCSearchCommandTestSuite struct {
+	Command SCSearchCommand
+	suite.Suite
+}
+
+func (suite *SCSearchCommandTestSuite) SetupSuite() {
+	DJ = bot.NewMumbleDJ()
+	bot.DJ = DJ
+}
+
+func (suite *SCSearchCommandTestSuite) SetupTest() {
+	DJ.Queue = bot.NewQueue()
+}
+
+func (suite *SCSearchCommandTestSuite) TearDownTest() {
+	viper.Set("tiers.enabled", false)
+	viper.Set("tiers.listeners.members", []string{})
+}
+
+func (suite *SCSearchCommandTestSuite) TestExecuteWhenUserCannotAdd() {
+	viper.Set("tiers.enabled", true)
+	viper.Set("tiers.listeners.members", []string{"Listener"})
+
+	message, isPrivateMessage, err := suite.Command.Execute(&gumble.User{Name: "Listener"}, "song")
+
+	suite.Equal("", message, "No message should be returned since an error occurred.")
+	suite.True(isPrivateMessage, "This should be a private message.")
+	suite.Equal(fmt.Sprintf(viper.GetString("tiers.messages.command_denied_error"), "listeners"), err.Error(),
+		"Users who cannot add tracks should not be able to search SoundCloud for them.")
+	suite.Zero(DJ.Queue.Length())
+}
+
+func TestSCSearchCommandTestSuite(t *testing.T) {
+	suite.Run(t, new(SCSearchCommandTestSuite))
+}
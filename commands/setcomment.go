@@ -48,7 +48,7 @@ func (c *SetCommentCommand) Execute(user *gumble.User, args ...string) (string,
 		DJ.Client.Do(func() {
 			DJ.Client.Self.SetComment("")
 		})
-		return viper.GetString("commands.setcomment.messages.comment_removed"), true, nil
+		return DJ.Localize(user, "commands.setcomment.messages.comment_removed"), true, nil
 	}
 
 	var newComment string
@@ -61,6 +61,6 @@ func (c *SetCommentCommand) Execute(user *gumble.User, args ...string) (string,
 		DJ.Client.Self.SetComment(newComment)
 	})
 
-	return fmt.Sprintf(viper.GetString("commands.setcomment.messages.comment_changed"),
+	return fmt.Sprintf(DJ.Localize(user, "commands.setcomment.messages.comment_changed"),
 		newComment), true, nil
 }
@@ -0,0 +1,154 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/setdefault.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// userDefault is a personal default that can be set with the setdefault
+// command. Each one is kept in a UserToggle, and its two values are named
+// after what they do, such as "pm" and "channel" for announcements.
+type userDefault struct {
+	name    string
+	on, off string
+	toggle  func() *bot.UserToggle
+}
+
+// userDefaults are the personal defaults in the order they are listed.
+var userDefaults = []userDefault{
+	{"shuffle", "on", "off", func() *bot.UserToggle { return DJ.ShuffleAdds }},
+	{"announcements", "pm", "channel", func() *bot.UserToggle { return DJ.PrivateAnnounce }},
+	{"notify", "on", "off", func() *bot.UserToggle { return DJ.Notifications }},
+}
+
+// SetDefaultCommand is a command that sets personal defaults that are applied
+// to the tracks the user adds and the messages they are sent.
+type SetDefaultCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *SetDefaultCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.setdefault.aliases")
+}
+
+// Description returns the description for the command.
+func (c *SetDefaultCommand) Description() string {
+	return viper.GetString("commands.setdefault.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *SetDefaultCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.setdefault.is_admin")
+}
+
+// Signature returns the arguments and flags that the command accepts.
+func (c *SetDefaultCommand) Signature() interfaces.Signature {
+	return interfaces.Signature{
+		Arguments: []interfaces.Argument{
+			{Name: "option=value", Optional: true, Variadic: true},
+		},
+	}
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *SetDefaultCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	return bot.ExecuteWithArguments(user, c, args)
+}
+
+// ExecuteArguments executes the command with the given user and the arguments
+// checked against its signature.
+func (c *SetDefaultCommand) ExecuteArguments(user *gumble.User, parsed interfaces.Arguments) (string, bool, error) {
+	args := parsed.Strings("option=value")
+	if len(args) == 0 {
+		return fmt.Sprintf(DJ.Localize(user, "commands.setdefault.messages.current_defaults"),
+			describeUserDefaults(user.Name)), true, nil
+	}
+
+	// Every option is checked before any is applied, so that a typo does not
+	// leave the defaults half changed.
+	values := make(map[int]bool, len(args))
+	for _, arg := range args {
+		// Options may be separated by commas, as in "shuffle=on, notify=on".
+		arg = strings.Trim(arg, ",")
+		if arg == "" {
+			continue
+		}
+		i, enabled, ok := parseUserDefault(arg)
+		if !ok {
+			return "", true, fmt.Errorf(DJ.Localize(user, "commands.setdefault.messages.invalid_option_error"),
+				arg, userDefaultChoices())
+		}
+		values[i] = enabled
+	}
+	for i, enabled := range values {
+		userDefaults[i].toggle().Set(user.Name, enabled)
+	}
+
+	return fmt.Sprintf(DJ.Localize(user, "commands.setdefault.messages.defaults_set"),
+		describeUserDefaults(user.Name)), true, nil
+}
+
+// parseUserDefault parses an option such as "shuffle=on" and returns the index
+// of the default in userDefaults and whether it is switched on.
+func parseUserDefault(arg string) (int, bool, bool) {
+	parts := strings.SplitN(strings.ToLower(arg), "=", 2)
+	if len(parts) != 2 {
+		return 0, false, false
+	}
+	for i, d := range userDefaults {
+		if d.name != parts[0] {
+			continue
+		}
+		switch parts[1] {
+		case d.on:
+			return i, true, true
+		case d.off:
+			return i, false, true
+		}
+	}
+	return 0, false, false
+}
+
+// describeUserDefaults lists the defaults of the user with the given name,
+// such as "shuffle=off, announcements=pm, notify=on".
+func describeUserDefaults(name string) string {
+	described := make([]string, 0, len(userDefaults))
+	for _, d := range userDefaults {
+		value := d.off
+		if d.toggle().Enabled(name) {
+			value = d.on
+		}
+		described = append(described, d.name+"="+value)
+	}
+	return strings.Join(described, ", ")
+}
+
+// userDefaultChoices lists the values each default accepts, such as
+// "shuffle=on|off".
+func userDefaultChoices() string {
+	choices := make([]string, 0, len(userDefaults))
+	for _, d := range userDefaults {
+		choices = append(choices, d.name+"="+d.on+"|"+d.off)
+	}
+	return strings.Join(choices, ", ")
+}
@@ -0,0 +1,49 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/shoutout.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// ShoutoutCommand is a command that toggles a spoken shout-out to the user
+// before the tracks they added begin playing.
+type ShoutoutCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *ShoutoutCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.shoutout.aliases")
+}
+
+// Description returns the description for the command.
+func (c *ShoutoutCommand) Description() string {
+	return viper.GetString("commands.shoutout.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *ShoutoutCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.shoutout.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *ShoutoutCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if DJ.Intros.Toggle(user.Name) {
+		return DJ.Localize(user, "commands.shoutout.messages.intros_on"), true, nil
+	}
+	return DJ.Localize(user, "commands.shoutout.messages.intros_off"), true, nil
+}
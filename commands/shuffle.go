@@ -9,8 +9,13 @@ package commands
 
 import (
 	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
 
 	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
 	"github.com/spf13/viper"
 )
 
@@ -45,13 +50,44 @@ func (c *ShuffleCommand) IsAdminCommand() bool {
 func (c *ShuffleCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
 	length := DJ.Queue.Length()
 	if length == 0 {
-		return "", true, errors.New(viper.GetString("commands.common_messages.no_tracks_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.common_messages.no_tracks_error"))
 	}
 	if length <= 2 {
-		return "", true, errors.New(viper.GetString("commands.shuffle.messages.not_enough_tracks_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.shuffle.messages.not_enough_tracks_error"))
 	}
 
-	DJ.Queue.ShuffleTracks()
+	// A seed may be given as "!shuffle 1234" or "!shuffle seed 1234" to repeat
+	// an earlier shuffle. Otherwise a new seed is chosen and reported.
+	seed := rand.Int63n(1000000)
+	if len(args) != 0 {
+		if strings.ToLower(args[0]) == "seed" {
+			args = args[1:]
+		}
+		if len(args) == 0 {
+			return "", true, errors.New(DJ.Localize(user, "commands.shuffle.messages.invalid_seed_error"))
+		}
+		parsedSeed, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return "", true, errors.New(DJ.Localize(user, "commands.shuffle.messages.invalid_seed_error"))
+		}
+		seed = parsedSeed
+	}
+
+	// Unless the command is admin-only, users vote to shuffle the queue, and
+	// it is only shuffled once enough of the channel agrees. Admins shuffle
+	// the queue at once.
+	if viper.GetFloat64("commands.shuffle.vote_ratio") > 0 && !DJ.IsAdmin(user) {
+		votes, needed, passed, err := DJ.ShuffleVotes.Add(user.Name,
+			DJ.CountVoters(DJ.Client.Self.Channel.Users, "commands.shuffle.aliases"))
+		if err == bot.ErrAlreadyVotedToShuffle {
+			return "", true, errors.New(DJ.Localize(user, "commands.shuffle.messages.already_voted_error"))
+		}
+		if !passed {
+			return fmt.Sprintf(viper.GetString("commands.shuffle.messages.vote_added"), user.Name, votes, needed), false, nil
+		}
+	}
+
+	DJ.Queue.ShuffleTracksWithSeed(seed)
 
-	return viper.GetString("commands.shuffle.messages.shuffled"), false, nil
+	return fmt.Sprintf(viper.GetString("commands.shuffle.messages.shuffled"), seed), false, nil
 }
@@ -0,0 +1,60 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/shutdown.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// ShutdownCommand is a command that saves the queue and shuts down the bot.
+type ShutdownCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *ShutdownCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.shutdown.aliases")
+}
+
+// Description returns the description for the command.
+func (c *ShutdownCommand) Description() string {
+	return viper.GetString("commands.shutdown.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *ShutdownCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.shutdown.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *ShutdownCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if err := DJ.SaveState(); err != nil {
+		return "", true, err
+	}
+
+	DJ.Client.Do(func() {
+		DJ.Client.Self.Channel.Send(fmt.Sprintf(viper.GetString("commands.shutdown.messages.shutting_down"), user.Name), false)
+	})
+	if err := DJ.Shutdown(); err != nil {
+		return "", true, err
+	}
+
+	os.Exit(0)
+	return "", true, nil
+}
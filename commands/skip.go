@@ -45,7 +45,7 @@ func (c *SkipCommand) IsAdminCommand() bool {
 //    return "This is a private message!", true, nil
 func (c *SkipCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
 	if DJ.Queue.Length() == 0 {
-		return "", true, errors.New(viper.GetString("commands.common_messages.no_tracks_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.common_messages.no_tracks_error"))
 	}
 	if DJ.Queue.GetTrack(0).GetSubmitter() == user.Name {
 		// The user who submitted the track is skipping, this means we skip this track immediately.
@@ -53,7 +53,7 @@ func (c *SkipCommand) Execute(user *gumble.User, args ...string) (string, bool,
 		return fmt.Sprintf(viper.GetString("commands.skip.messages.submitter_voted"), user.Name), false, nil
 	}
 	if err := DJ.Skips.AddTrackSkip(user); err != nil {
-		return "", true, errors.New(viper.GetString("commands.skip.messages.already_voted_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.skip.messages.already_voted_error"))
 	}
 
 	return fmt.Sprintf(viper.GetString("commands.skip.messages.voted"), user.Name), false, nil
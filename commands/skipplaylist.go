@@ -52,18 +52,18 @@ func (c *SkipPlaylistCommand) Execute(user *gumble.User, args ...string) (string
 	)
 
 	if currentTrack, err = DJ.Queue.CurrentTrack(); err != nil {
-		return "", true, errors.New(viper.GetString("commands.common_messages.no_tracks_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.common_messages.no_tracks_error"))
 	}
 
 	if playlist := currentTrack.GetPlaylist(); playlist == nil {
-		return "", true, errors.New(viper.GetString("commands.skipplaylist.messages.no_playlist_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.skipplaylist.messages.no_playlist_error"))
 	}
 	if currentTrack.GetPlaylist().GetSubmitter() == user.Name {
 		DJ.Queue.SkipPlaylist()
 		return fmt.Sprintf(viper.GetString("commands.skipplaylist.messages.submitter_voted"), user.Name), false, nil
 	}
 	if err := DJ.Skips.AddPlaylistSkip(user); err != nil {
-		return "", true, errors.New(viper.GetString("commands.skipplaylist.messages.already_voted_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.skipplaylist.messages.already_voted_error"))
 	}
 
 	return fmt.Sprintf(viper.GetString("commands.skipplaylist.messages.voted"), user.Name), false, nil
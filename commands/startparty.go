@@ -0,0 +1,64 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/startparty.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// StartPartyCommand is a command that ends the planning phase and adds the
+// suggested tracks to the queue, most upvoted first.
+type StartPartyCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *StartPartyCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.startparty.aliases")
+}
+
+// Description returns the description for the command.
+func (c *StartPartyCommand) Description() string {
+	return viper.GetString("commands.startparty.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *StartPartyCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.startparty.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *StartPartyCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if err := DJ.CheckQueuePermission(user); err != nil {
+		return "", true, err
+	}
+	tracks, err := DJ.Draft.Finish()
+	if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.startparty.messages.not_planning_error"))
+	}
+
+	numAdded := 0
+	for _, track := range tracks {
+		if err := DJ.Queue.AppendTrack(track); err == nil {
+			numAdded++
+		}
+	}
+
+	return fmt.Sprintf(viper.GetString("commands.startparty.messages.party_started"), user.Name, numAdded), false, nil
+}
@@ -0,0 +1,71 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/stopat.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/spf13/viper"
+)
+
+// StopAtCommand is a command that schedules audio playback to stop at a given
+// time of day.
+type StopAtCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *StopAtCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.stopat.aliases")
+}
+
+// Description returns the description for the command.
+func (c *StopAtCommand) Description() string {
+	return viper.GetString("commands.stopat.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *StopAtCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.stopat.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *StopAtCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if len(args) == 0 {
+		if at, ok := DJ.AutoStop.Next(); ok {
+			return fmt.Sprintf(DJ.Localize(user, "commands.stopat.messages.current_stop"), at.Format("15:04")), true, nil
+		}
+		return DJ.Localize(user, "commands.stopat.messages.no_stop"), true, nil
+	}
+
+	if args[0] == "off" {
+		if !DJ.AutoStop.Cancel() {
+			return "", true, errors.New(DJ.Localize(user, "commands.stopat.messages.no_stop"))
+		}
+		return fmt.Sprintf(viper.GetString("commands.stopat.messages.cancelled"), user.Name), false, nil
+	}
+
+	at, err := bot.ParseStopTime(args[0], time.Now())
+	if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.stopat.messages.parsing_error"))
+	}
+	DJ.AutoStop.Schedule(at)
+
+	return fmt.Sprintf(viper.GetString("commands.stopat.messages.scheduled"), user.Name, at.Format("15:04")), false, nil
+}
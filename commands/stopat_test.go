@@ -0,0 +1,94 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/stopat_test.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+type StopAtCommandTestSuite struct {
+	Command StopAtCommand
+	suite.Suite
+}
+
+func (suite *StopAtCommandTestSuite) SetupSuite() {
+	DJ = bot.NewMumbleDJ()
+
+	viper.Set("commands.stopat.aliases", []string{"stopat", "sa"})
+	viper.Set("commands.stopat.description", "stopat")
+	viper.Set("commands.stopat.is_admin", true)
+}
+
+func (suite *StopAtCommandTestSuite) TearDownTest() {
+	DJ.AutoStop.Cancel()
+}
+
+func (suite *StopAtCommandTestSuite) TestAliases() {
+	suite.Equal([]string{"stopat", "sa"}, suite.Command.Aliases())
+}
+
+func (suite *StopAtCommandTestSuite) TestDescription() {
+	suite.Equal("stopat", suite.Command.Description())
+}
+
+func (suite *StopAtCommandTestSuite) TestIsAdminCommand() {
+	suite.True(suite.Command.IsAdminCommand())
+}
+
+func (suite *StopAtCommandTestSuite) TestExecuteWithNoArgsAndNoStopScheduled() {
+	message, isPrivateMessage, err := suite.Command.Execute(nil)
+
+	suite.NotEqual("", message, "A message should be returned.")
+	suite.True(isPrivateMessage, "This should be a private message.")
+	suite.Nil(err, "No error should be returned.")
+}
+
+func (suite *StopAtCommandTestSuite) TestExecuteWithValidTime() {
+	dummyUser := &gumble.User{
+		Name: "test",
+	}
+	message, isPrivateMessage, err := suite.Command.Execute(dummyUser, "23:30")
+
+	suite.Contains(message, "23:30", "The returned string should contain the stop time.")
+	suite.False(isPrivateMessage, "This should not be a private message.")
+	suite.Nil(err, "No error should be returned.")
+
+	at, ok := DJ.AutoStop.Next()
+	suite.True(ok, "A stop should be scheduled.")
+	suite.Equal(23, at.Hour())
+	suite.Equal(30, at.Minute())
+}
+
+func (suite *StopAtCommandTestSuite) TestExecuteWithInvalidTime() {
+	message, isPrivateMessage, err := suite.Command.Execute(nil, "25:99")
+
+	suite.Equal("", message, "No message should be returned.")
+	suite.True(isPrivateMessage, "This should be a private message.")
+	suite.NotNil(err, "An error should be returned for an invalid time.")
+}
+
+func (suite *StopAtCommandTestSuite) TestExecuteOffCancelsStop() {
+	dummyUser := &gumble.User{
+		Name: "test",
+	}
+	suite.Command.Execute(dummyUser, "23:30")
+	_, _, err := suite.Command.Execute(dummyUser, "off")
+
+	suite.Nil(err, "No error should be returned.")
+	_, ok := DJ.AutoStop.Next()
+	suite.False(ok, "The stop should have been cancelled.")
+}
+
+func TestStopAtCommandTestSuite(t *testing.T) {
+	suite.Run(t, new(StopAtCommandTestSuite))
+}
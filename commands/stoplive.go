@@ -0,0 +1,59 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/stoplive.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// StopLiveCommand is a command that stops the live stream currently being
+// relayed and moves on to the next track.
+type StopLiveCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *StopLiveCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.stoplive.aliases")
+}
+
+// Description returns the description for the command.
+func (c *StopLiveCommand) Description() string {
+	return viper.GetString("commands.stoplive.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *StopLiveCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.stoplive.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *StopLiveCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	currentTrack, err := DJ.Queue.CurrentTrack()
+	if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.common_messages.no_tracks_error"))
+	}
+	if !currentTrack.IsLive() {
+		return "", true, errors.New(DJ.Localize(user, "commands.stoplive.messages.not_live_error"))
+	}
+
+	DJ.Queue.StopCurrent()
+
+	return fmt.Sprintf(viper.GetString("commands.stoplive.messages.live_stopped"), user.Name), false, nil
+}
@@ -0,0 +1,73 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/tracklist.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/spf13/viper"
+)
+
+// TracklistCommand is a command that lists the songs within the current
+// track, such as an album uploaded as a single video.
+type TracklistCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *TracklistCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.tracklist.aliases")
+}
+
+// Description returns the description for the command.
+func (c *TracklistCommand) Description() string {
+	return viper.GetString("commands.tracklist.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *TracklistCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.tracklist.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *TracklistCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	track, err := DJ.Queue.CurrentTrack()
+	if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.common_messages.no_tracks_error"))
+	}
+	tracklist := bot.TracklistOf(track)
+	if tracklist == nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.tracklist.messages.no_tracklist_error"))
+	}
+
+	position := track.GetPlaybackOffset()
+	if stream := DJ.AudioStream; stream != nil {
+		position += stream.Elapsed()
+	}
+	current := tracklist.Current(position)
+
+	message := fmt.Sprintf(DJ.Localize(user, "commands.tracklist.messages.tracklist_header"), track.GetTitle())
+	for i, chapter := range tracklist.Chapters {
+		listing := "commands.tracklist.messages.chapter_listing"
+		if i == current {
+			listing = "commands.tracklist.messages.current_chapter_listing"
+		}
+		message += fmt.Sprintf(DJ.Localize(user, listing), i+1, chapter.Start.String(), chapter.Title)
+	}
+	return message, true, nil
+}
@@ -0,0 +1,54 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/unhold.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/spf13/viper"
+)
+
+// UnholdCommand is a command that takes the music off hold and fades it back
+// in.
+type UnholdCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *UnholdCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.unhold.aliases")
+}
+
+// Description returns the description for the command.
+func (c *UnholdCommand) Description() string {
+	return viper.GetString("commands.unhold.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *UnholdCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.unhold.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *UnholdCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	held, err := DJ.Hold.End()
+	if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.unhold.messages.not_held_error"))
+	}
+	return fmt.Sprintf(viper.GetString("commands.unhold.messages.unheld"), user.Name, held/time.Second*time.Second), false, nil
+}
@@ -0,0 +1,78 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/upvote.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/spf13/viper"
+)
+
+// UpvoteCommand is a command that votes for a suggested track while a
+// party is being planned. Without arguments it lists the current suggestions.
+type UpvoteCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *UpvoteCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.upvote.aliases")
+}
+
+// Description returns the description for the command.
+func (c *UpvoteCommand) Description() string {
+	return viper.GetString("commands.upvote.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *UpvoteCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.upvote.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *UpvoteCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if !DJ.Draft.IsActive() {
+		return "", true, errors.New(DJ.Localize(user, "commands.upvote.messages.not_planning_error"))
+	}
+
+	if len(args) == 0 {
+		var buffer bytes.Buffer
+		DJ.Draft.Traverse(func(i int, suggestion *bot.Suggestion) {
+			buffer.WriteString(fmt.Sprintf(DJ.Localize(user, "commands.upvote.messages.suggestion_listing"),
+				i+1, suggestion.Track.GetTitle(), suggestion.Track.GetSubmitter(), len(suggestion.Voters)))
+		})
+		if buffer.Len() == 0 {
+			return "", true, errors.New(DJ.Localize(user, "commands.upvote.messages.no_suggestions_error"))
+		}
+		return buffer.String(), true, nil
+	}
+
+	number, err := strconv.Atoi(args[0])
+	if err != nil || number < 1 || number > DJ.Draft.Length() {
+		return "", true, errors.New(DJ.Localize(user, "commands.upvote.messages.invalid_number_error"))
+	}
+
+	suggestion, err := DJ.Draft.Upvote(number, user.Name)
+	if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.upvote.messages.already_voted_error"))
+	}
+	return fmt.Sprintf(viper.GetString("commands.upvote.messages.upvoted"),
+		user.Name, suggestion.Track.GetTitle(), len(suggestion.Voters)), false, nil
+}
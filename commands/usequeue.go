@@ -0,0 +1,67 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/usequeue.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/spf13/viper"
+)
+
+// UseQueueCommand is a command that switches which named queue feeds
+// playback, or lists the queues.
+type UseQueueCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *UseQueueCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.usequeue.aliases")
+}
+
+// Description returns the description for the command.
+func (c *UseQueueCommand) Description() string {
+	return viper.GetString("commands.usequeue.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *UseQueueCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.usequeue.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *UseQueueCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if len(args) == 0 {
+		message := DJ.Localize(user, "commands.usequeue.messages.queues_header")
+		for i, name := range DJ.Queues.Names() {
+			listing := "commands.usequeue.messages.queue_listing"
+			if i == 0 {
+				listing = "commands.usequeue.messages.active_queue_listing"
+			}
+			message += fmt.Sprintf(DJ.Localize(user, listing), name, DJ.Queues.Length(name))
+		}
+		return message, true, nil
+	}
+
+	upcoming, err := DJ.Queues.Use(args[0])
+	if err == bot.ErrQueueActive {
+		return "", true, fmt.Errorf(DJ.Localize(user, "commands.usequeue.messages.already_active_error"), DJ.Queues.Active())
+	} else if err != nil {
+		return "", true, err
+	}
+	return fmt.Sprintf(viper.GetString("commands.usequeue.messages.switched"), user.Name, DJ.Queues.Active(), upcoming), false, nil
+}
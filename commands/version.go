@@ -9,6 +9,7 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/layeh/gumble/gumble"
 	"github.com/spf13/viper"
@@ -43,5 +44,12 @@ func (c *VersionCommand) IsAdminCommand() bool {
 // Example return statement:
 //    return "This is a private message!", true, nil
 func (c *VersionCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
-	return fmt.Sprintf(viper.GetString("commands.version.messages.version"), DJ.Version), true, nil
+	info := DJ.BuildInfo()
+	message := fmt.Sprintf(DJ.Localize(user, "commands.version.messages.version"), info.Version)
+	message += fmt.Sprintf(DJ.Localize(user, "commands.version.messages.build_info"),
+		info.Commit, info.GoVersion, info.Downloader, strings.Join(info.Services, ", "))
+	if latest := DJ.Updates.Latest(); latest != "" {
+		message += fmt.Sprintf(DJ.Localize(user, "commands.version.messages.update_available"), latest)
+	}
+	return message, true, nil
 }
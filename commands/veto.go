@@ -0,0 +1,56 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/veto.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/spf13/viper"
+)
+
+// VetoCommand is a command that places a vote against the upcoming track
+// while the window between tracks is open.
+type VetoCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *VetoCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.veto.aliases")
+}
+
+// Description returns the description for the command.
+func (c *VetoCommand) Description() string {
+	return viper.GetString("commands.veto.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *VetoCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.veto.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *VetoCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	track, err := DJ.VoteWindow.Veto(user.Name, DJ.CountVoters(DJ.Client.Self.Channel.Users, "commands.veto.aliases"))
+	if err == bot.ErrNoVoteWindow {
+		return "", true, errors.New(DJ.Localize(user, "commands.veto.messages.no_window_error"))
+	} else if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.veto.messages.already_vetoed_error"))
+	}
+	return fmt.Sprintf(viper.GetString("commands.veto.messages.vetoed"), user.Name, track.GetTitle()), false, nil
+}
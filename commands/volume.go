@@ -47,16 +47,16 @@ func (c *VolumeCommand) IsAdminCommand() bool {
 func (c *VolumeCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
 	if len(args) == 0 {
 		// Send the user the current volume level.
-		return fmt.Sprintf(viper.GetString("commands.volume.messages.current_volume"), DJ.Volume), true, nil
+		return fmt.Sprintf(DJ.Localize(user, "commands.volume.messages.current_volume"), DJ.Volume), true, nil
 	}
 
 	newVolume, err := strconv.ParseFloat(args[0], 32)
 	if err != nil {
-		return "", true, errors.New(viper.GetString("commands.volume.messages.parsing_error"))
+		return "", true, errors.New(DJ.Localize(user, "commands.volume.messages.parsing_error"))
 	}
 
 	if newVolume <= viper.GetFloat64("volume.lowest") || newVolume >= viper.GetFloat64("volume.highest") {
-		return "", true, fmt.Errorf(viper.GetString("commands.volume.messages.out_of_range_error"),
+		return "", true, fmt.Errorf(DJ.Localize(user, "commands.volume.messages.out_of_range_error"),
 			viper.GetFloat64("volume.lowest"), viper.GetFloat64("volume.highest"))
 	}
 
@@ -66,6 +66,8 @@ func (c *VolumeCommand) Execute(user *gumble.User, args ...string) (string, bool
 		DJ.AudioStream.Volume = newVolume32
 	}
 	DJ.Volume = newVolume32
+	// The monitor player cannot change its volume while playing.
+	DJ.Monitor.Refresh()
 
 	return fmt.Sprintf(viper.GetString("commands.volume.messages.volume_changed"),
 		user.Name, newVolume32), false, nil
@@ -0,0 +1,83 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * commands/volumeschedule.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/spf13/viper"
+)
+
+// VolumeScheduleCommand is a command that manages the volumes the bot changes
+// to at set times of day.
+type VolumeScheduleCommand struct{}
+
+// Aliases returns the current aliases for the command.
+func (c *VolumeScheduleCommand) Aliases() []string {
+	return viper.GetStringSlice("commands.volumeschedule.aliases")
+}
+
+// Description returns the description for the command.
+func (c *VolumeScheduleCommand) Description() string {
+	return viper.GetString("commands.volumeschedule.description")
+}
+
+// IsAdminCommand returns true if the command is only for admin use, and
+// returns false otherwise.
+func (c *VolumeScheduleCommand) IsAdminCommand() bool {
+	return viper.GetBool("commands.volumeschedule.is_admin")
+}
+
+// Execute executes the command with the given user and arguments.
+// Return value descriptions:
+//    string: A message to be returned to the user upon successful execution.
+//    bool:   Whether the message should be private or not. true = private,
+//            false = public (sent to whole channel).
+//    error:  An error message to be returned upon unsuccessful execution.
+//            If no error has occurred, pass nil instead.
+// Example return statement:
+//    return "This is a private message!", true, nil
+func (c *VolumeScheduleCommand) Execute(user *gumble.User, args ...string) (string, bool, error) {
+	if len(args) == 0 {
+		targets := DJ.VolumeSchedule.Targets()
+		if len(targets) == 0 {
+			return DJ.Localize(user, "commands.volumeschedule.messages.no_schedule"), true, nil
+		}
+		message := DJ.Localize(user, "commands.volumeschedule.messages.schedule_header")
+		for _, target := range targets {
+			message += fmt.Sprintf(DJ.Localize(user, "commands.volumeschedule.messages.schedule_entry"),
+				target.Clock, target.Volume)
+		}
+		return message, true, nil
+	}
+
+	if args[0] == "remove" && len(args) == 2 {
+		if !DJ.VolumeSchedule.Remove(args[1]) {
+			return "", true, fmt.Errorf(DJ.Localize(user, "commands.volumeschedule.messages.not_scheduled_error"), args[1])
+		}
+		return fmt.Sprintf(viper.GetString("commands.volumeschedule.messages.removed"), user.Name, args[1]), false, nil
+	}
+
+	if len(args) != 2 {
+		return "", true, errors.New(DJ.Localize(user, "commands.volumeschedule.messages.parsing_error"))
+	}
+	target, err := bot.ParseVolumeTarget(args[0], args[1])
+	if err == bot.ErrVolumeOutOfRange {
+		return "", true, fmt.Errorf(DJ.Localize(user, "commands.volumeschedule.messages.out_of_range_error"),
+			viper.GetFloat64("volume.lowest"), viper.GetFloat64("volume.highest"))
+	} else if err != nil {
+		return "", true, errors.New(DJ.Localize(user, "commands.volumeschedule.messages.parsing_error"))
+	}
+	DJ.VolumeSchedule.Set(target)
+
+	return fmt.Sprintf(viper.GetString("commands.volumeschedule.messages.scheduled"),
+		user.Name, target.Volume, target.Clock), false, nil
+}
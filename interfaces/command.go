@@ -7,7 +7,11 @@
 
 package interfaces
 
-import "github.com/layeh/gumble/gumble"
+import (
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+)
 
 // Command is an interface that all commands must implement.
 type Command interface {
@@ -16,3 +20,62 @@ type Command interface {
 	IsAdminCommand() bool
 	Execute(user *gumble.User, args ...string) (string, bool, error)
 }
+
+// ArgumentType is the type of the value of a command argument or flag.
+type ArgumentType int
+
+// The types of argument values.
+const (
+	// TextArgument accepts any text.
+	TextArgument ArgumentType = iota
+	// IntArgument accepts whole numbers.
+	IntArgument
+	// DurationArgument accepts a number of seconds, a time such as "1:30", or
+	// a duration such as "1m30s".
+	DurationArgument
+)
+
+// Argument describes a positional argument of a command.
+type Argument struct {
+	Name string
+	Type ArgumentType
+	// Optional arguments may be left out. They must follow the required ones.
+	Optional bool
+	// A variadic argument takes all remaining arguments and must come last.
+	Variadic bool
+}
+
+// Flag describes an option such as "--next" that may be given anywhere among
+// the arguments of a command. Flags that take a value are given it in the
+// following argument or after "=", as in "--limit 5" or "--limit=5".
+type Flag struct {
+	Name       string
+	TakesValue bool
+	Type       ArgumentType
+}
+
+// Signature describes the arguments and flags that a command accepts.
+type Signature struct {
+	Arguments []Argument
+	Flags     []Flag
+}
+
+// Arguments are the arguments of a command once they have been checked
+// against its signature.
+type Arguments interface {
+	Has(name string) bool
+	String(name string) string
+	Strings(name string) []string
+	Int(name string) int
+	Duration(name string) time.Duration
+	Flag(name string) bool
+}
+
+// SignatureCommand is implemented by commands that declare their arguments.
+// Their arguments are checked before the command is executed with them, and
+// their usage is shown by the help command.
+type SignatureCommand interface {
+	Command
+	Signature() Signature
+	ExecuteArguments(user *gumble.User, args Arguments) (string, bool, error)
+}
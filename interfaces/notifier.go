@@ -0,0 +1,17 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * interfaces/notifier.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package interfaces
+
+// Notifier is an interface of methods to be implemented by the places the bot
+// sends announcements to, such as the Mumble channel or a webhook.
+type Notifier interface {
+	// Notify sends `message` about `event`. `user` is the name of the user
+	// the event concerns, or an empty string if it concerns nobody in
+	// particular.
+	Notify(event, user, message string) error
+}
@@ -0,0 +1,21 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * interfaces/output.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package interfaces
+
+import "time"
+
+// AudioOutput is an interface of methods to be implemented by the places the
+// audio of the current track is played on, such as the Mumble channel or a
+// local sound device.
+type AudioOutput interface {
+	// Play begins playing `track`, starting `offset` into it.
+	Play(track Track, offset time.Duration) error
+	Pause() error
+	Resume() error
+	Stop() error
+}
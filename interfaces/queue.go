@@ -19,9 +19,16 @@ type Queue interface {
 	PeekNextTrack() (Track, error)
 	Traverse(func(int, Track))
 	ShuffleTracks()
+	ShuffleTracksWithSeed(int64)
 	RandomNextTrack(bool)
+	Boost(int, string) (int, error)
+	Boosts(Track) int
+	MoveTrack(int, int) (Track, error)
+	RemoveTrackAt(int, func(Track) bool) (Track, error)
 	Skip()
 	SkipPlaylist()
+	RemoveSubmitter(string) (int, bool)
+	DemoteSubmitter(string) int
 	PlayCurrent() error
 	PauseCurrent() error
 	ResumeCurrent() error
@@ -18,3 +18,61 @@ type Service interface {
 	CheckURL(string) bool
 	GetTracks(string, *gumble.User) ([]Track, error)
 }
+
+// SearchService is implemented by services that are also able to search for
+// tracks using keywords.
+type SearchService interface {
+	Service
+	SearchTracks(string, *gumble.User, int) ([]Track, error)
+}
+
+// IdentifyingService is implemented by services that can tell which track a
+// link points to from the link alone. The string is the ID the service gives
+// the track, and the bool is false if the link does not point to a single
+// track, such as a link to a playlist.
+type IdentifyingService interface {
+	Service
+	GetTrackID(string) (string, bool)
+}
+
+// ChannelService is implemented by services that can list the latest uploads
+// of a channel, such as a YouTube channel.
+type ChannelService interface {
+	Service
+	CheckChannelURL(string) bool
+	GetChannelTracks(string, *gumble.User, int) ([]Track, error)
+}
+
+// LikedService is implemented by services that can list the tracks a user has
+// liked on an account they linked to the bot, such as their YouTube "Liked
+// videos". The number is the most tracks to return.
+type LikedService interface {
+	Service
+	GetLikedTracks(*gumble.User, int) ([]Track, error)
+}
+
+// ContinuationService is implemented by services that can add more tracks of a
+// playlist that was cut off at queue.max_tracks_per_playlist. The token is the
+// one the service stored with the playlist, and the number is the most tracks
+// to return.
+type ContinuationService interface {
+	Service
+	ContinuePlaylist(Playlist, string, int, *gumble.User) ([]Track, error)
+}
+
+// StreamService is implemented by services whose tracks are downloaded from a
+// different URL than the one shown to users, such as one that carries the
+// credentials for a private server.
+type StreamService interface {
+	Service
+	GetStreamURL(Track) string
+}
+
+// ProbingService is implemented by services that also recognize links that look
+// like any other by connecting to them, such as internet radio streams. They
+// are only asked once no service has recognized a link by its form, and only
+// on behalf of the given user.
+type ProbingService interface {
+	Service
+	ProbeURL(string, *gumble.User) bool
+}
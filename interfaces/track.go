@@ -23,4 +23,5 @@ type Track interface {
 	GetDuration() time.Duration
 	GetPlaybackOffset() time.Duration
 	GetPlaylist() Playlist
+	IsLive() bool
 }
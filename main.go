@@ -20,6 +20,10 @@ import (
 	"github.com/urfave/cli"
 )
 
+// commit is the git commit the bot was built from. It is set at build time,
+// see the Makefile.
+var commit string
+
 // DJ is a global variable that holds various details about the bot's state.
 var DJ = bot.NewMumbleDJ()
 
@@ -33,6 +37,7 @@ func init() {
 	bot.DJ = DJ
 
 	DJ.Version = "v3.2.1"
+	DJ.Commit = commit
 
 	logrus.SetLevel(logrus.WarnLevel)
 }
@@ -101,6 +106,10 @@ func main() {
 			Name:  "debug, d",
 			Usage: "if present, all debug messages will be shown",
 		},
+		cli.BoolFlag{
+			Name:  "setup",
+			Usage: "if present, the bot will interactively ask for connection details, API keys, and admins and write them to the configuration file",
+		},
 	}
 
 	hiddenFlags := make([]cli.Flag, len(viper.AllKeys()))
@@ -117,6 +126,22 @@ func main() {
 			logrus.SetLevel(logrus.InfoLevel)
 		}
 
+		if c.Bool("setup") {
+			if err := bot.RunSetup(os.Stdin, os.Stdout, c.String("config")); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"file":  c.String("config"),
+					"error": err.Error(),
+				}).Fatalln("An error occurred during setup.")
+			}
+		}
+
+		if _, err := bot.MigrateConfig(c.String("config")); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"file":  c.String("config"),
+				"error": err.Error(),
+			}).Warnln("An error occurred while migrating a legacy configuration file.")
+		}
+
 		for _, configValue := range viper.AllKeys() {
 			if c.GlobalIsSet(configValue) {
 				if strings.Contains(c.String(configValue), ",") {
@@ -186,13 +211,31 @@ func main() {
 		if viper.GetString("defaults.channel") != "" {
 			defaultChannel := strings.Split(viper.GetString("defaults.channel"), "/")
 			DJ.Client.Do(func() {
-				DJ.Client.Self.Move(DJ.Client.Channels.Find(defaultChannel...))
+				if channel := DJ.Client.Channels.Find(defaultChannel...); channel != nil {
+					DJ.Client.Self.Move(channel)
+				}
 			})
 		}
 
 		DJ.Client.Do(func() {
 			DJ.Client.Self.SetComment(viper.GetString("defaults.comment"))
 		})
+
+		if err := DJ.RestoreState(); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Warnln("An error occurred while restoring the saved state.")
+		}
+
+		if err := DJ.SeedQueue(); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"source": viper.GetString("seed.source"),
+				"error":  err.Error(),
+			}).Warnln("An error occurred while seeding the queue.")
+		}
+
+		go DJ.ServeAPI()
+
 		<-DJ.KeepAlive
 
 		return nil
@@ -0,0 +1,271 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/archive.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	neturl "net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/antonholmquist/jason"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// archiveFormats are the archive.org file formats that are played, from most
+// to least preferred. Items usually hold each recording in several formats, so
+// only the files of the most preferred format present are used.
+var archiveFormats = []string{"VBR MP3", "MP3", "128Kbps MP3", "64Kbps MP3", "Ogg Vorbis", "Flac", "24bit Flac"}
+
+// archiveFileRegex matches links to a single file of an archive.org item.
+var archiveFileRegex = regexp.MustCompile(`https?:\/\/(www\.)?archive\.org\/(details|download)\/(?P<id>[\w.-]+)\/(?P<file>[^?#\s]+)`)
+
+// Archive is a wrapper around the Internet Archive metadata API.
+// https://archive.org/developers/md-read.html
+type Archive struct {
+	*GenericService
+}
+
+// NewArchiveService returns an initialized Archive service object.
+func NewArchiveService() *Archive {
+	return &Archive{
+		&GenericService{
+			ReadableName: "Archive",
+			Format:       "bestaudio",
+			TrackRegex: []*regexp.Regexp{
+				archiveFileRegex,
+			},
+			PlaylistRegex: []*regexp.Regexp{
+				regexp.MustCompile(`^https?:\/\/(www\.)?archive\.org\/details\/(?P<id>[\w.-]+)\/?([?#]\S*)?$`),
+			},
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the API key
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (ar *Archive) CheckAPIKey() error {
+	// The Internet Archive does not require an API key, so we can just return nil.
+	return nil
+}
+
+// GetTracks uses the passed URL to find and return
+// tracks associated with the URL. An error is returned
+// if any error occurs during the API call.
+func (ar *Archive) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	id, err := ar.getID(url)
+	if err != nil {
+		return nil, err
+	}
+	v, err := ar.getJSON("https://archive.org/metadata/" + id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := v.GetObject("metadata"); err != nil {
+		return nil, &bot.TrackError{
+			Service: ar.ReadableName,
+			TrackID: id,
+			Message: "This Internet Archive item does not exist",
+		}
+	}
+
+	itemTitle, _ := v.GetString("metadata", "title")
+	creator := getFirstString(v, []string{"metadata", "creator"})
+	thumbnail := "https://archive.org/services/img/" + id
+	files, _ := v.GetObjectArray("files")
+	item := newArchiveItem(files)
+
+	if ar.isPlaylist(url) {
+		playlist := &bot.Playlist{
+			ID:        id,
+			Title:     itemTitle,
+			Submitter: submitter.Name,
+			Service:   ar.ReadableName,
+		}
+
+		maxItems := math.MaxInt32
+		if viper.GetInt("queue.max_tracks_per_playlist") > 0 {
+			maxItems = viper.GetInt("queue.max_tracks_per_playlist")
+		}
+
+		var tracks []interfaces.Track
+		for _, file := range item.audioFiles() {
+			track := ar.getTrack(id, item, file, creator, thumbnail, submitter)
+			track.Playlist = playlist
+			tracks = append(tracks, track)
+
+			if len(tracks) >= maxItems {
+				break
+			}
+		}
+		if len(tracks) == 0 {
+			return nil, errors.New("This Internet Archive item contains no audio files")
+		}
+		return tracks, nil
+	}
+
+	name := archiveFileRegex.FindStringSubmatch(url)[4]
+	if unescaped, err := neturl.QueryUnescape(name); err == nil {
+		name = unescaped
+	}
+	for _, file := range files {
+		if fileName, _ := file.GetString("name"); fileName == name {
+			return []interfaces.Track{ar.getTrack(id, item, file, creator, thumbnail, submitter)}, nil
+		}
+	}
+	return nil, &bot.TrackError{
+		Service: ar.ReadableName,
+		TrackID: id,
+		Message: fmt.Sprintf("The file \"%s\" does not exist in this Internet Archive item", name),
+	}
+}
+
+func (ar *Archive) getTrack(id string, item *archiveItem, file *jason.Object, creator, thumbnail string, submitter *gumble.User) bot.Track {
+	name, _ := file.GetString("name")
+	title := item.field(file, "title")
+	if title == "" {
+		title = strings.TrimSuffix(path.Base(name), path.Ext(name))
+	}
+	if fileCreator := item.field(file, "creator", "artist"); fileCreator != "" {
+		creator = fileCreator
+	}
+	length, _ := file.GetString("length")
+	hash := sha1.Sum([]byte(id + "/" + name))
+	fileID := hex.EncodeToString(hash[:])[:16]
+	offset, _ := time.ParseDuration("0s")
+
+	return bot.Track{
+		ID:             fileID,
+		URL:            "https://archive.org/download/" + id + "/" + (&neturl.URL{Path: name}).EscapedPath(),
+		Title:          title,
+		Author:         creator,
+		AuthorURL:      "https://archive.org/details/" + id,
+		Submitter:      submitter.Name,
+		Service:        ar.ReadableName,
+		Filename:       "archive-" + fileID + ".track",
+		ThumbnailURL:   thumbnail,
+		Duration:       parseArchiveLength(length),
+		PlaybackOffset: offset,
+		Playlist:       nil,
+	}
+}
+
+// archiveItem holds the files of an archive.org item.
+type archiveItem struct {
+	files  []*jason.Object
+	byName map[string]*jason.Object
+}
+
+func newArchiveItem(files []*jason.Object) *archiveItem {
+	item := &archiveItem{
+		files:  files,
+		byName: make(map[string]*jason.Object, len(files)),
+	}
+	for _, file := range files {
+		name, _ := file.GetString("name")
+		item.byName[name] = file
+	}
+	return item
+}
+
+// field returns the first non-empty value of `keys` for `file`. Derived files,
+// such as MP3s made from FLAC recordings, often only carry titles and track
+// numbers on the original file they were made from, so the original is
+// consulted as well.
+func (item *archiveItem) field(file *jason.Object, keys ...string) string {
+	paths := make([][]string, len(keys))
+	for i, key := range keys {
+		paths[i] = []string{key}
+	}
+	if value := getFirstString(file, paths...); value != "" {
+		return value
+	}
+	if originalName, err := file.GetString("original"); err == nil {
+		if original, ok := item.byName[originalName]; ok {
+			return getFirstString(original, paths...)
+		}
+	}
+	return ""
+}
+
+// audioFiles returns the files of the most preferred audio format present in
+// the item, ordered by their track number and then by name.
+func (item *archiveItem) audioFiles() []*jason.Object {
+	for _, format := range archiveFormats {
+		var selected []*jason.Object
+		for _, file := range item.files {
+			if fileFormat, _ := file.GetString("format"); fileFormat == format {
+				selected = append(selected, file)
+			}
+		}
+		if len(selected) > 0 {
+			sort.Stable(sortArchiveFiles{selected, item})
+			return selected
+		}
+	}
+	return nil
+}
+
+// sortArchiveFiles sorts the files of an archive.org item by their track
+// number and then by name.
+type sortArchiveFiles struct {
+	files []*jason.Object
+	item  *archiveItem
+}
+
+func (a sortArchiveFiles) Len() int      { return len(a.files) }
+func (a sortArchiveFiles) Swap(i, j int) { a.files[i], a.files[j] = a.files[j], a.files[i] }
+func (a sortArchiveFiles) Less(i, j int) bool {
+	trackI, trackJ := a.item.trackNumber(a.files[i]), a.item.trackNumber(a.files[j])
+	if trackI != trackJ {
+		return trackI < trackJ
+	}
+	nameI, _ := a.files[i].GetString("name")
+	nameJ, _ := a.files[j].GetString("name")
+	return nameI < nameJ
+}
+
+// trackNumber returns the track number of `file`. Track numbers may be given
+// as e.g. "3" or "3/12". Files without one sort last.
+func (item *archiveItem) trackNumber(file *jason.Object) int {
+	track := item.field(file, "track")
+	if i := strings.Index(track, "/"); i != -1 {
+		track = track[:i]
+	}
+	number, err := strconv.Atoi(strings.TrimSpace(track))
+	if err != nil {
+		return math.MaxInt32
+	}
+	return number
+}
+
+// parseArchiveLength parses the length of an archive.org file, which is given
+// either in seconds, e.g. "245.37", or as "MM:SS" or "HH:MM:SS".
+func parseArchiveLength(length string) time.Duration {
+	var seconds float64
+	for _, part := range strings.Split(length, ":") {
+		value, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return 0
+		}
+		seconds = seconds*60 + value
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
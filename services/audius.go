@@ -0,0 +1,197 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/audius.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"errors"
+	"math"
+	neturl "net/url"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/antonholmquist/jason"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// audiusAppName identifies the bot to the Audius API, which asks every app to
+// name itself instead of using an API key.
+const audiusAppName = "MumbleDJ"
+
+// audiusGateway lists the discovery nodes that serve the Audius API, and is
+// used itself if none can be found.
+const audiusGateway = "https://api.audius.co"
+
+// Audius is a wrapper around the public API of Audius discovery nodes.
+// https://docs.audius.org/developers/api
+type Audius struct {
+	*GenericService
+	host  string
+	mutex sync.Mutex
+}
+
+// NewAudiusService returns an initialized Audius service object.
+func NewAudiusService() *Audius {
+	return &Audius{
+		GenericService: &GenericService{
+			ReadableName: "Audius",
+			Format:       "best",
+			TrackRegex: []*regexp.Regexp{
+				regexp.MustCompile(`^https?:\/\/(www\.)?audius\.co\/(?P<id>[\w.-]+\/[\w.-]+)\/?([?#]\S*)?$`),
+			},
+			PlaylistRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/(www\.)?audius\.co\/(?P<id>[\w.-]+\/(playlist|album)\/[\w.-]+)`),
+			},
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the API key
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (au *Audius) CheckAPIKey() error {
+	// Audius does not require an API key, so we can just return nil.
+	return nil
+}
+
+// GetTracks uses the passed URL to find and return
+// tracks associated with the URL. An error is returned
+// if any error occurs during the API call.
+func (au *Audius) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	id, err := au.getID(url)
+	if err != nil {
+		return nil, err
+	}
+	v, err := au.call("/v1/resolve", neturl.Values{"url": {"https://audius.co/" + id}})
+	if err != nil {
+		return nil, err
+	}
+
+	if !au.isPlaylist(url) {
+		track, err := v.GetObject("data")
+		if err != nil {
+			return nil, &bot.TrackError{
+				Service: au.ReadableName,
+				TrackID: id,
+				Message: "This Audius track does not exist",
+			}
+		}
+		return []interfaces.Track{au.getTrack(track, submitter)}, nil
+	}
+
+	// Playlists are resolved to a list holding the playlist.
+	results, _ := v.GetObjectArray("data")
+	if len(results) == 0 {
+		return nil, &bot.TrackError{
+			Service: au.ReadableName,
+			TrackID: id,
+			Message: "This Audius playlist does not exist",
+		}
+	}
+	playlistID, _ := results[0].GetString("id")
+	title, _ := results[0].GetString("playlist_name")
+	playlist := &bot.Playlist{
+		ID:        playlistID,
+		Title:     title,
+		Submitter: submitter.Name,
+		Service:   au.ReadableName,
+	}
+
+	v, err = au.call("/v1/playlists/"+playlistID+"/tracks", nil)
+	if err != nil {
+		return nil, err
+	}
+	items, _ := v.GetObjectArray("data")
+
+	maxItems := math.MaxInt32
+	if viper.GetInt("queue.max_tracks_per_playlist") > 0 {
+		maxItems = viper.GetInt("queue.max_tracks_per_playlist")
+	}
+
+	var tracks []interfaces.Track
+	for _, item := range items {
+		// Tracks that were deleted or made private stay in playlists.
+		if streamable, err := item.GetBoolean("is_streamable"); err == nil && !streamable {
+			continue
+		}
+		track := au.getTrack(item, submitter)
+		track.Playlist = playlist
+		tracks = append(tracks, track)
+
+		if len(tracks) >= maxItems {
+			break
+		}
+	}
+
+	if len(tracks) == 0 {
+		return nil, errors.New("Invalid playlist. No tracks were added")
+	}
+	return tracks, nil
+}
+
+// GetStreamURL returns the URL that `t` is downloaded from, which is served
+// by a discovery node.
+func (au *Audius) GetStreamURL(t interfaces.Track) string {
+	return au.getHost() + "/v1/tracks/" + t.GetID() + "/stream?app_name=" + audiusAppName
+}
+
+func (au *Audius) getTrack(obj *jason.Object, submitter *gumble.User) bot.Track {
+	id, _ := obj.GetString("id")
+	title, _ := obj.GetString("title")
+	author, _ := obj.GetString("user", "name")
+	handle, _ := obj.GetString("user", "handle")
+	permalink, _ := obj.GetString("permalink")
+	seconds, _ := obj.GetInt64("duration")
+	thumbnail := getFirstString(obj, []string{"artwork", "480x480"}, []string{"artwork", "150x150"})
+	offset, _ := time.ParseDuration("0s")
+
+	return bot.Track{
+		ID:             id,
+		URL:            "https://audius.co" + permalink,
+		Title:          title,
+		Author:         author,
+		AuthorURL:      "https://audius.co/" + handle,
+		Submitter:      submitter.Name,
+		Service:        au.ReadableName,
+		Filename:       "audius-" + id + ".track",
+		ThumbnailURL:   thumbnail,
+		Duration:       time.Duration(seconds) * time.Second,
+		PlaybackOffset: offset,
+		Playlist:       nil,
+	}
+}
+
+// call performs a request against the API endpoint `endpoint` of a discovery
+// node with the query parameters `params`.
+func (au *Audius) call(endpoint string, params neturl.Values) (*jason.Object, error) {
+	if params == nil {
+		params = neturl.Values{}
+	}
+	params.Set("app_name", audiusAppName)
+	return au.getJSON(au.getHost() + endpoint + "?" + params.Encode())
+}
+
+// getHost returns the address of the discovery node that requests are sent
+// to. A node is chosen once from those listed by the gateway.
+func (au *Audius) getHost() string {
+	au.mutex.Lock()
+	defer au.mutex.Unlock()
+	if au.host != "" {
+		return au.host
+	}
+	au.host = audiusGateway
+	if v, err := au.getJSON(audiusGateway); err == nil {
+		if hosts, err := v.GetStringArray("data"); err == nil && len(hosts) > 0 {
+			au.host = hosts[0]
+		}
+	}
+	return au.host
+}
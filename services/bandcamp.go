@@ -0,0 +1,193 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/bandcamp.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/antonholmquist/jason"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// tralbumRegex matches the attribute in which Bandcamp pages embed the track
+// or album metadata as HTML-escaped JSON.
+var tralbumRegex = regexp.MustCompile(`data-tralbum="([^"]*)"`)
+
+// Bandcamp is a wrapper around the metadata embedded in Bandcamp pages.
+// Bandcamp does not offer a public API.
+type Bandcamp struct {
+	*GenericService
+}
+
+// NewBandcampService returns an initialized Bandcamp service object.
+func NewBandcampService() *Bandcamp {
+	return &Bandcamp{
+		&GenericService{
+			ReadableName: "Bandcamp",
+			Format:       "bestaudio",
+			TrackRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/([\w-]+)\.bandcamp\.com\/track\/([\w-]+)`),
+			},
+			PlaylistRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/([\w-]+)\.bandcamp\.com\/album\/([\w-]+)`),
+			},
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the API key
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (bc *Bandcamp) CheckAPIKey() error {
+	// Bandcamp does not require an API key, so we can just return nil.
+	return nil
+}
+
+// GetTracks uses the passed URL to find and return
+// tracks associated with the URL. An error is returned
+// if any error occurs while retrieving the page.
+func (bc *Bandcamp) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	var tracks []interfaces.Track
+
+	if i := strings.IndexAny(url, "?#"); i != -1 {
+		url = url[:i]
+	}
+	v, err := bc.getTralbum(url)
+	if err != nil {
+		return nil, err
+	}
+
+	artist, _ := v.GetString("artist")
+	artID, _ := v.GetInt64("art_id")
+	thumbnail := ""
+	if artID != 0 {
+		thumbnail = fmt.Sprintf("https://f4.bcbits.com/img/a%d_10.jpg", artID)
+	}
+	// Track links in the metadata are relative to the artist's subdomain.
+	baseURL := url[:strings.Index(url, ".bandcamp.com")+len(".bandcamp.com")]
+
+	var playlist *bot.Playlist
+	if bc.isPlaylist(url) {
+		// Submitter has added an album!
+		title, _ := v.GetString("current", "title")
+		playlist = &bot.Playlist{
+			ID:        url,
+			Title:     title,
+			Submitter: submitter.Name,
+			Service:   bc.ReadableName,
+		}
+	}
+
+	maxItems := math.MaxInt32
+	if playlist != nil && viper.GetInt("queue.max_tracks_per_playlist") > 0 {
+		maxItems = viper.GetInt("queue.max_tracks_per_playlist")
+	}
+
+	trackInfo, err := v.GetObjectArray("trackinfo")
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range trackInfo {
+		track, err := bc.getTrack(info, baseURL, artist, thumbnail, submitter)
+		if err != nil {
+			// Unreleased or unstreamable tracks are skipped.
+			logrus.WithFields(logrus.Fields{
+				"url":   url,
+				"error": err.Error(),
+			}).Infoln("Skipping a Bandcamp track.")
+			continue
+		}
+		track.Playlist = playlist
+		tracks = append(tracks, track)
+
+		if len(tracks) >= maxItems {
+			break
+		}
+	}
+
+	if len(tracks) == 0 {
+		if playlist != nil {
+			return nil, errors.New("Invalid album. No tracks were added")
+		}
+		return nil, errors.New("This Bandcamp track cannot be streamed")
+	}
+	return tracks, nil
+}
+
+// getTralbum retrieves the Bandcamp page at `url` and returns the track or
+// album metadata embedded in it.
+func (bc *Bandcamp) getTralbum(url string) (*jason.Object, error) {
+	response, err := bot.HTTPGet(url)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, &bot.APIError{
+			Service:    bc.ReadableName,
+			StatusCode: response.StatusCode,
+			Status:     response.Status,
+		}
+	}
+
+	page, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	match := tralbumRegex.FindSubmatch(page)
+	if match == nil {
+		return nil, errors.New("No track information was found on the Bandcamp page")
+	}
+	return jason.NewObjectFromBytes([]byte(html.UnescapeString(string(match[1]))))
+}
+
+func (bc *Bandcamp) getTrack(obj *jason.Object, baseURL, artist, thumbnail string, submitter *gumble.User) (bot.Track, error) {
+	file, _ := obj.GetObject("file")
+	if file == nil {
+		return bot.Track{}, errors.New("The track has no audio available for streaming")
+	}
+
+	idInt, _ := obj.GetInt64("track_id")
+	if idInt == 0 {
+		idInt, _ = obj.GetInt64("id")
+	}
+	id := strconv.FormatInt(idInt, 10)
+	title, _ := obj.GetString("title")
+	link, _ := obj.GetString("title_link")
+	durationSecs, _ := obj.GetFloat64("duration")
+	duration, _ := time.ParseDuration(fmt.Sprintf("%fs", durationSecs))
+	offset, _ := time.ParseDuration("0s")
+
+	return bot.Track{
+		ID:             id,
+		URL:            baseURL + link,
+		Title:          title,
+		Author:         artist,
+		AuthorURL:      baseURL,
+		Submitter:      submitter.Name,
+		Service:        bc.ReadableName,
+		Filename:       "bandcamp-" + id + ".track",
+		ThumbnailURL:   thumbnail,
+		Duration:       duration,
+		PlaybackOffset: offset,
+		Playlist:       nil,
+	}, nil
+}
@@ -0,0 +1,226 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/deezer.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/antonholmquist/jason"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// deezerSearchResults is the number of YouTube search results compared against
+// the duration of a Deezer track to pick the best match.
+const deezerSearchResults = 3
+
+// Deezer is a wrapper around the Deezer API. Deezer does not allow its audio to
+// be downloaded, so the metadata of Deezer tracks is used to find and play the
+// same song on YouTube.
+// https://developers.deezer.com/api
+type Deezer struct {
+	*GenericService
+}
+
+// NewDeezerService returns an initialized Deezer service object.
+func NewDeezerService() *Deezer {
+	return &Deezer{
+		&GenericService{
+			ReadableName: "Deezer",
+			Format:       "bestaudio",
+			TrackRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/(www\.)?deezer\.com\/([a-z]{2}\/)?track\/(?P<id>\d+)`),
+			},
+			PlaylistRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/(www\.)?deezer\.com\/([a-z]{2}\/)?(playlist|album)\/(?P<id>\d+)`),
+			},
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the API key
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (dz *Deezer) CheckAPIKey() error {
+	// The Deezer API does not require an API key, but Deezer tracks are played
+	// from YouTube.
+	if viper.GetString("api_keys.youtube") == "" {
+		return errors.New("Deezer tracks are played from YouTube, so a YouTube API key is required. Add your key to " +
+			"api_keys.youtube in the configuration file, see https://github.com/matthieugrieger/mumbledj#youtube-api-key for instructions")
+	}
+	return nil
+}
+
+// GetTracks uses the passed URL to find and return
+// tracks associated with the URL. An error is returned
+// if any error occurs during the API call.
+func (dz *Deezer) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	youtube := dz.getYouTube()
+	if youtube == nil {
+		return nil, errors.New("Deezer tracks cannot be played as the YouTube service is disabled")
+	}
+
+	id, err := dz.getID(url)
+	if err != nil {
+		return nil, err
+	}
+
+	if !dz.isPlaylist(url) {
+		v, err := dz.call("https://api.deezer.com/track/" + id)
+		if err != nil {
+			return nil, err
+		}
+		track, err := dz.findOnYouTube(youtube, v, submitter, bot.QuotaHigh)
+		if err != nil {
+			return nil, err
+		}
+		return []interfaces.Track{track}, nil
+	}
+
+	kind := "playlist"
+	if strings.Contains(url, "/album/") {
+		kind = "album"
+	}
+	v, err := dz.call(fmt.Sprintf("https://api.deezer.com/%s/%s", kind, id))
+	if err != nil {
+		return nil, err
+	}
+
+	title, _ := v.GetString("title")
+	playlist := &bot.Playlist{
+		ID:        id,
+		Title:     title,
+		Submitter: submitter.Name,
+		Service:   dz.ReadableName,
+	}
+
+	maxItems := math.MaxInt32
+	if viper.GetInt("queue.max_tracks_per_playlist") > 0 {
+		maxItems = viper.GetInt("queue.max_tracks_per_playlist")
+	}
+
+	items, _ := v.GetObjectArray("tracks", "data")
+	var tracks []interfaces.Track
+	for i, item := range items {
+		// Only the first track is needed to honor the request; finding the
+		// rest is deferred once the YouTube API budget runs low.
+		priority := bot.QuotaLow
+		if i == 0 {
+			priority = bot.QuotaHigh
+		}
+		track, err := dz.findOnYouTube(youtube, item, submitter, priority)
+		if err == bot.ErrQuotaDeferred || err == bot.ErrQuotaExhausted {
+			logrus.WithFields(bot.ErrorFields(err)).Warnln("Stopped retrieving a Deezer playlist to save API budget.")
+			break
+		} else if err != nil {
+			logrus.WithFields(bot.ErrorFields(err)).Infoln("Skipping a Deezer playlist item.")
+			continue
+		}
+		track.Playlist = playlist
+		tracks = append(tracks, track)
+
+		if len(tracks) >= maxItems {
+			break
+		}
+	}
+
+	if len(tracks) == 0 {
+		return nil, errors.New("Invalid playlist. No tracks were added")
+	}
+	return tracks, nil
+}
+
+// findOnYouTube searches YouTube for the Deezer track described by `item` and
+// returns the result closest in duration, carrying the Deezer metadata.
+func (dz *Deezer) findOnYouTube(youtube *YouTube, item *jason.Object, submitter *gumble.User, priority int) (bot.Track, error) {
+	idInt, _ := item.GetInt64("id")
+	title, _ := item.GetString("title")
+	artist, _ := item.GetString("artist", "name")
+	artistURL, _ := item.GetString("artist", "link")
+	seconds, _ := item.GetInt64("duration")
+	duration := time.Duration(seconds) * time.Second
+	if title == "" {
+		return bot.Track{}, &bot.TrackError{
+			Service: dz.ReadableName,
+			TrackID: strconv.FormatInt(idInt, 10),
+			Message: "This Deezer track is not available",
+		}
+	}
+
+	results, err := youtube.search(strings.TrimSpace(artist+" "+title), submitter, deezerSearchResults, priority)
+	if err != nil {
+		if err == bot.ErrQuotaDeferred || err == bot.ErrQuotaExhausted {
+			return bot.Track{}, err
+		}
+		return bot.Track{}, &bot.TrackError{
+			Service: dz.ReadableName,
+			TrackID: strconv.FormatInt(idInt, 10),
+			Message: fmt.Sprintf("\"%s\" could not be found on YouTube", title),
+		}
+	}
+
+	best := results[0].(bot.Track)
+	for _, result := range results[1:] {
+		if durationDistance(result.GetDuration(), duration) < durationDistance(best.Duration, duration) {
+			best = result.(bot.Track)
+		}
+	}
+	best.Title = title
+	best.Author = artist
+	best.AuthorURL = artistURL
+	if cover := getFirstString(item, []string{"album", "cover_big"}, []string{"album", "cover"}); cover != "" {
+		best.ThumbnailURL = cover
+	}
+	return best, nil
+}
+
+// call performs a Deezer API request. The Deezer API reports errors in the
+// body of successful responses, so those are turned into errors here.
+func (dz *Deezer) call(url string) (*jason.Object, error) {
+	v, err := dz.getJSON(url)
+	if err != nil {
+		return nil, err
+	}
+	if message, err := v.GetString("error", "message"); err == nil {
+		code, _ := v.GetInt64("error", "code")
+		return nil, &bot.APIError{
+			Service:    dz.ReadableName,
+			StatusCode: int(code),
+			Status:     "error",
+			Message:    message,
+		}
+	}
+	return v, nil
+}
+
+// getYouTube returns the YouTube service if it is enabled, or nil otherwise.
+func (dz *Deezer) getYouTube() *YouTube {
+	for _, service := range DJ.AvailableServices {
+		if youtube, ok := service.(*YouTube); ok {
+			return youtube
+		}
+	}
+	return nil
+}
+
+// durationDistance returns how far apart `a` and `b` are.
+func durationDistance(a, b time.Duration) time.Duration {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
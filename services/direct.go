@@ -0,0 +1,153 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/direct.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"mime"
+	"net/http"
+	neturl "net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+)
+
+// errNotAudioFile is returned when a link to an audio file leads to a web page
+// instead.
+var errNotAudioFile = errors.New("The linked file is not an audio file")
+
+// Direct plays audio files that are linked to directly, such as
+// https://example.com/song.mp3. The title, artist and duration announced for
+// these tracks are read from the tags of the file once it is downloaded.
+type Direct struct {
+	*GenericService
+}
+
+// NewDirectService returns an initialized Direct service object.
+func NewDirectService() *Direct {
+	return &Direct{
+		&GenericService{
+			ReadableName: "Direct",
+			Format:       "best",
+			TrackRegex: []*regexp.Regexp{
+				regexp.MustCompile(`(?i)^https?:\/\/[^\s?#]+\.(mp3|ogg|m4a|flac)([?#]\S*)?$`),
+			},
+			// Direct links always point to a single file.
+			PlaylistRegex: nil,
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the API key
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (d *Direct) CheckAPIKey() error {
+	// Direct links do not require an API key, so we can just return nil.
+	return nil
+}
+
+// GetTracks uses the passed URL to find and return
+// tracks associated with the URL. An error is returned
+// if the file cannot be reached or is not an audio file.
+func (d *Direct) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	// Station streams often end in ".mp3" as well.
+	if radio, ok := DJ.ProbeService(url, submitter); ok {
+		return radio.GetTracks(url, submitter)
+	}
+
+	parsed, err := neturl.Parse(url)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := checkAudioFile(d.ReadableName, url); err != nil {
+		return nil, err
+	}
+
+	hash := sha1.Sum([]byte(url))
+	id := hex.EncodeToString(hash[:])[:16]
+	// The filename stands in as the title until the tags of the file are read.
+	title, err := neturl.QueryUnescape(path.Base(parsed.Path))
+	if err != nil {
+		title = path.Base(parsed.Path)
+	}
+	offset, _ := time.ParseDuration("0s")
+
+	track := bot.Track{
+		ID:             id,
+		URL:            url,
+		Title:          title,
+		Author:         parsed.Host,
+		AuthorURL:      parsed.Scheme + "://" + parsed.Host,
+		Submitter:      submitter.Name,
+		Service:        d.ReadableName,
+		Filename:       "direct-" + id + ".track",
+		ThumbnailURL:   "",
+		Duration:       0,
+		PlaybackOffset: offset,
+		Playlist:       nil,
+		TagsFromFile:   true,
+	}
+	if track, err = withProbedTags(track, url); err != nil {
+		return nil, err
+	}
+	return []interfaces.Track{track}, nil
+}
+
+// withProbedTags returns `track` with the tags of the file at `url`, so that
+// its duration is known before it is queued and the duration limits apply to
+// it. A file that cannot be probed is only rejected while a duration limit is
+// set. Nothing is probed in replay mode.
+func withProbedTags(track bot.Track, url string) (bot.Track, error) {
+	if bot.IsReplayMode() {
+		return track, nil
+	}
+	tags, err := bot.ReadTags(url)
+	if err == nil && tags.Duration > 0 {
+		return track.WithTags(tags), nil
+	}
+	if bot.DurationLimited(track.Service) {
+		return track, errors.New("The length of the file could not be determined")
+	}
+	return track, nil
+}
+
+// checkAudioFile checks that `url` can be reached and does not point to a web
+// page, and returns the filename the server suggests for it, if any. Nothing
+// is checked in replay mode.
+func checkAudioFile(service, url string) (string, error) {
+	if bot.IsReplayMode() {
+		return "", nil
+	}
+	response, err := http.Head(url)
+	if err != nil {
+		return "", err
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", &bot.APIError{
+			Service:    service,
+			StatusCode: response.StatusCode,
+			Status:     response.Status,
+		}
+	}
+	if strings.HasPrefix(response.Header.Get("Content-Type"), "text/") {
+		return "", errNotAudioFile
+	}
+	if _, params, err := mime.ParseMediaType(response.Header.Get("Content-Disposition")); err == nil {
+		return params["filename"], nil
+	}
+	return "", nil
+}
@@ -0,0 +1,124 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/dropbox.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	neturl "net/url"
+	"path"
+	"regexp"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+)
+
+// Dropbox plays audio files shared from Dropbox with a link. Like direct
+// links, the title, artist and duration announced for these tracks are read
+// from the tags of the file once it is downloaded.
+type Dropbox struct {
+	*GenericService
+}
+
+// NewDropboxService returns an initialized Dropbox service object.
+func NewDropboxService() *Dropbox {
+	return &Dropbox{
+		&GenericService{
+			ReadableName: "Dropbox",
+			Format:       "best",
+			TrackRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/(www\.)?dropbox\.com\/(s|scl\/fi)\/[\w-]+\/[^\s?#]+`),
+			},
+			// Shared folders are not supported.
+			PlaylistRegex: nil,
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the API key
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (db *Dropbox) CheckAPIKey() error {
+	// Files shared with a link do not require an API key, so we can just return nil.
+	return nil
+}
+
+// GetTracks uses the passed URL to find and return
+// tracks associated with the URL. An error is returned
+// if the file cannot be reached or is not an audio file.
+func (db *Dropbox) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	parsed, err := neturl.Parse(url)
+	if err != nil {
+		return nil, err
+	}
+	// Links are the same file whether they lead to the preview page or to
+	// the download.
+	query := parsed.Query()
+	query.Del("dl")
+	query.Del("raw")
+	parsed.RawQuery = query.Encode()
+	parsed.Fragment = ""
+	url = parsed.String()
+
+	if _, err := checkAudioFile(db.ReadableName, dropboxDownloadURL(url)); err == errNotAudioFile {
+		return nil, errors.New("The linked file is not an audio file or its link has been disabled")
+	} else if err != nil {
+		return nil, err
+	}
+
+	hash := sha1.Sum([]byte(url))
+	id := hex.EncodeToString(hash[:])[:16]
+	// The filename stands in as the title until the tags of the file are read.
+	title, err := neturl.QueryUnescape(path.Base(parsed.Path))
+	if err != nil {
+		title = path.Base(parsed.Path)
+	}
+	offset, _ := time.ParseDuration("0s")
+
+	track := bot.Track{
+		ID:             id,
+		URL:            url,
+		Title:          title,
+		Author:         db.ReadableName,
+		AuthorURL:      "https://www.dropbox.com",
+		Submitter:      submitter.Name,
+		Service:        db.ReadableName,
+		Filename:       "dropbox-" + id + ".track",
+		ThumbnailURL:   "",
+		Duration:       0,
+		PlaybackOffset: offset,
+		Playlist:       nil,
+		TagsFromFile:   true,
+	}
+	if track, err = withProbedTags(track, dropboxDownloadURL(url)); err != nil {
+		return nil, err
+	}
+	return []interfaces.Track{track}, nil
+}
+
+// GetStreamURL returns the URL that `t` is downloaded from, which serves the
+// file itself rather than the Dropbox page that previews it.
+func (db *Dropbox) GetStreamURL(t interfaces.Track) string {
+	return dropboxDownloadURL(t.GetURL())
+}
+
+// dropboxDownloadURL returns the direct download URL of the shared link `url`.
+// Other query parameters, such as the rlkey of newer links, must be kept.
+func dropboxDownloadURL(url string) string {
+	parsed, err := neturl.Parse(url)
+	if err != nil {
+		return url
+	}
+	query := parsed.Query()
+	query.Set("dl", "1")
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
@@ -0,0 +1,371 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/funkwhale.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	neturl "net/url"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/antonholmquist/jason"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// funkwhaleFederationRegex matches the ActivityPub URLs of tracks and uploads,
+// which pods share with each other and which are shown as "federation URL".
+var funkwhaleFederationRegex = regexp.MustCompile(`https?:\/\/[^\/\s]+\/federation\/music\/(tracks|uploads)\/(?P<id>[0-9a-f-]{36})`)
+
+// Funkwhale plays music from Funkwhale pods. Tracks are added with their page
+// or their federation URL, and albums and channels with their page. Pods often
+// only share their music with their users, so the bot may use an account on
+// the pod configured in the funkwhale section of the configuration file.
+// https://docs.funkwhale.audio/developer/api/
+type Funkwhale struct {
+	*GenericService
+	listenToken string
+	mutex       sync.Mutex
+}
+
+// NewFunkwhaleService returns an initialized Funkwhale service object.
+func NewFunkwhaleService() *Funkwhale {
+	return &Funkwhale{
+		GenericService: &GenericService{
+			ReadableName: "Funkwhale",
+			Format:       "best",
+			TrackRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/[^\/\s]+\/library\/tracks\/(?P<id>\d+)`),
+				funkwhaleFederationRegex,
+			},
+			PlaylistRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/[^\/\s]+\/library\/albums\/(?P<id>\d+)`),
+				regexp.MustCompile(`https?:\/\/[^\/\s]+\/channels\/(?P<id>[\w.@-]+)`),
+			},
+		},
+	}
+}
+
+// CheckURL matches federation URLs from any pod. Links to pages are only
+// matched on the pods set in funkwhale.url and funkwhale.pods, as paths such
+// as "/channels/" are common on other sites too.
+func (fw *Funkwhale) CheckURL(url string) bool {
+	if funkwhaleFederationRegex.MatchString(url) {
+		return true
+	}
+	return fw.GenericService.CheckURL(url) && fw.isKnownPod(url)
+}
+
+// isKnownPod returns true if `url` belongs to one of the configured pods.
+func (fw *Funkwhale) isKnownPod(url string) bool {
+	host := funkwhaleHost(url)
+	for _, pod := range append([]string{fw.address()}, viper.GetStringSlice("funkwhale.pods")...) {
+		if pod != "" && funkwhaleHost(pod) == host {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckAPIKey performs a test API call with the API key
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (fw *Funkwhale) CheckAPIKey() error {
+	// Public music can be played without an account, so the service is always
+	// enabled. A configured account must work, though.
+	if viper.GetString("funkwhale.url") == "" || viper.GetString("funkwhale.token") == "" {
+		return nil
+	}
+	if _, err := fw.get(fw.address()+"/api/v1/users/me/", ""); err != nil {
+		return fmt.Errorf("The Funkwhale pod rejected the configured token (%s)", err.Error())
+	}
+	return nil
+}
+
+// GetTracks uses the passed URL to find and return
+// tracks associated with the URL. Albums and channels
+// return all of their tracks. An error is returned
+// if any error occurs during the API call.
+func (fw *Funkwhale) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	id, err := fw.getID(url)
+	if err != nil {
+		return nil, err
+	}
+	pod := funkwhalePod(url)
+
+	if !fw.isPlaylist(url) {
+		if funkwhaleFederationRegex.MatchString(url) {
+			track, err := fw.getFederatedTrack(funkwhaleFederationRegex.FindString(url), submitter)
+			if err != nil {
+				return nil, err
+			}
+			return []interfaces.Track{track}, nil
+		}
+		v, err := fw.get(pod+"/api/v1/tracks/"+id+"/", "")
+		if err != nil {
+			return nil, err
+		}
+		return []interfaces.Track{fw.getTrack(v, pod, submitter)}, nil
+	}
+
+	params := neturl.Values{}
+	var title string
+	if strings.Contains(url, "/library/albums/") {
+		album, err := fw.get(pod+"/api/v1/albums/"+id+"/", "")
+		if err != nil {
+			return nil, err
+		}
+		title, _ = album.GetString("title")
+		params.Set("album", id)
+		params.Set("ordering", "disc_number,position")
+	} else {
+		channel, err := fw.get(pod+"/api/v1/channels/"+id+"/", "")
+		if err != nil {
+			return nil, err
+		}
+		title, _ = channel.GetString("artist", "name")
+		uuid, _ := channel.GetString("uuid")
+		params.Set("channel", uuid)
+		// Channels are listed like feeds, newest first.
+		params.Set("ordering", "-creation_date")
+	}
+	playlist := &bot.Playlist{
+		ID:        id,
+		Title:     title,
+		Submitter: submitter.Name,
+		Service:   fw.ReadableName,
+	}
+
+	maxItems := math.MaxInt32
+	if viper.GetInt("queue.max_tracks_per_playlist") > 0 {
+		maxItems = viper.GetInt("queue.max_tracks_per_playlist")
+	}
+
+	var tracks []interfaces.Track
+	params.Set("page_size", "50")
+	next := pod + "/api/v1/tracks/?" + params.Encode()
+	for next != "" && len(tracks) < maxItems {
+		v, err := fw.get(next, "")
+		if err != nil {
+			return nil, err
+		}
+		results, _ := v.GetObjectArray("results")
+		for _, result := range results {
+			track := fw.getTrack(result, pod, submitter)
+			track.Playlist = playlist
+			tracks = append(tracks, track)
+
+			if len(tracks) >= maxItems {
+				break
+			}
+		}
+		next, _ = v.GetString("next")
+	}
+
+	if len(tracks) == 0 {
+		return nil, errors.New("Invalid playlist. No tracks were added")
+	}
+	return tracks, nil
+}
+
+// GetStreamURL returns the URL that `t` is downloaded from. Tracks on the
+// configured pod are downloaded with the listen token of the bot's account.
+func (fw *Funkwhale) GetStreamURL(t interfaces.Track) string {
+	pod := funkwhalePod(t.GetURL())
+	url := pod + "/api/v1/listen/" + t.GetID() + "/"
+	if token := fw.getListenToken(pod); token != "" {
+		url += "?token=" + neturl.QueryEscape(token)
+	}
+	return url
+}
+
+func (fw *Funkwhale) getTrack(obj *jason.Object, pod string, submitter *gumble.User) bot.Track {
+	id, _ := obj.GetInt64("id")
+	uuid, _ := obj.GetString("uuid")
+	title, _ := obj.GetString("title")
+	author, _ := obj.GetString("artist", "name")
+	artistID, _ := obj.GetInt64("artist", "id")
+	// Newer pods credit tracks to several artists instead.
+	if credits, err := obj.GetObjectArray("artist_credit"); err == nil && len(credits) > 0 {
+		author = ""
+		for _, credit := range credits {
+			name, _ := credit.GetString("credit")
+			joinPhrase, _ := credit.GetString("joinphrase")
+			author += name + joinPhrase
+		}
+		artistID, _ = credits[0].GetInt64("artist", "id")
+	}
+	var seconds int64
+	if uploads, err := obj.GetObjectArray("uploads"); err == nil && len(uploads) > 0 {
+		seconds, _ = uploads[0].GetInt64("duration")
+	}
+	thumbnail := getFirstString(obj, []string{"cover", "urls", "medium_square_crop"},
+		[]string{"album", "cover", "urls", "medium_square_crop"})
+	offset, _ := time.ParseDuration("0s")
+
+	return bot.Track{
+		ID:             uuid,
+		URL:            fmt.Sprintf("%s/library/tracks/%d", pod, id),
+		Title:          title,
+		Author:         strings.TrimSpace(author),
+		AuthorURL:      fmt.Sprintf("%s/library/artists/%d", pod, artistID),
+		Submitter:      submitter.Name,
+		Service:        fw.ReadableName,
+		Filename:       "funkwhale-" + uuid + ".track",
+		ThumbnailURL:   thumbnail,
+		Duration:       time.Duration(seconds) * time.Second,
+		PlaybackOffset: offset,
+		Playlist:       nil,
+	}
+}
+
+// getFederatedTrack returns the track at the federation URL `url`, which
+// describes either a track or an upload of one as an ActivityPub object.
+func (fw *Funkwhale) getFederatedTrack(url string, submitter *gumble.User) (bot.Track, error) {
+	v, err := fw.get(url, "application/activity+json")
+	if err != nil {
+		return bot.Track{}, err
+	}
+	var seconds int64
+	if objectType, _ := v.GetString("type"); objectType == "Audio" {
+		seconds, _ = v.GetInt64("duration")
+		if v, err = v.GetObject("track"); err != nil {
+			return bot.Track{}, errors.New("This Funkwhale upload does not belong to a track")
+		}
+	}
+
+	fid, _ := v.GetString("id")
+	uuid := path.Base(fid)
+	title, _ := v.GetString("name")
+	author, authorURL := "", ""
+	if artists, err := v.GetObjectArray("artists"); err == nil && len(artists) > 0 {
+		author, _ = artists[0].GetString("name")
+		authorURL, _ = artists[0].GetString("id")
+	}
+	thumbnail := getFirstString(v, []string{"album", "cover", "href"}, []string{"album", "image", "href"})
+	offset, _ := time.ParseDuration("0s")
+
+	return bot.Track{
+		ID:             uuid,
+		URL:            fid,
+		Title:          title,
+		Author:         author,
+		AuthorURL:      authorURL,
+		Submitter:      submitter.Name,
+		Service:        fw.ReadableName,
+		Filename:       "funkwhale-" + uuid + ".track",
+		ThumbnailURL:   thumbnail,
+		Duration:       time.Duration(seconds) * time.Second,
+		PlaybackOffset: offset,
+		Playlist:       nil,
+	}, nil
+}
+
+// get performs a GET request on `url` and parses the response body as a JSON
+// object. Requests to the configured pod are made with the bot's token, and
+// `accept`, if set, is sent as the Accept header.
+func (fw *Funkwhale) get(url, accept string) (*jason.Object, error) {
+	token := fw.token(url)
+	if token == "" && accept == "" {
+		return fw.getJSON(url)
+	}
+
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+	resp, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v, jsonErr := jason.NewObjectFromReader(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		apiErr := &bot.APIError{
+			Service:    fw.ReadableName,
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+		}
+		if jsonErr == nil {
+			apiErr.Message, _ = v.GetString("detail")
+		}
+		return nil, apiErr
+	}
+	if jsonErr != nil {
+		return nil, jsonErr
+	}
+	return v, nil
+}
+
+// getListenToken returns the token that tracks on `pod` are downloaded with,
+// which is only known for the configured pod. It is retrieved once with the
+// bot's token.
+func (fw *Funkwhale) getListenToken(pod string) string {
+	if fw.token(pod) == "" {
+		return ""
+	}
+	fw.mutex.Lock()
+	defer fw.mutex.Unlock()
+	if fw.listenToken == "" {
+		if v, err := fw.get(pod+"/api/v1/users/me/", ""); err == nil {
+			fw.listenToken, _ = v.GetString("tokens", "listen")
+		}
+	}
+	return fw.listenToken
+}
+
+// token returns the configured token if `url` is on the configured pod.
+func (fw *Funkwhale) token(url string) string {
+	if fw.address() == "" || funkwhalePod(url) != fw.address() {
+		return ""
+	}
+	return viper.GetString("funkwhale.token")
+}
+
+// address returns the address of the configured pod without a trailing slash.
+func (fw *Funkwhale) address() string {
+	return strings.TrimSuffix(viper.GetString("funkwhale.url"), "/")
+}
+
+// funkwhalePod returns the address of the pod that `url` belongs to, e.g.
+// "https://open.audio".
+func funkwhalePod(url string) string {
+	parsed, err := neturl.Parse(url)
+	if err != nil {
+		return ""
+	}
+	return parsed.Scheme + "://" + parsed.Host
+}
+
+// funkwhaleHost returns the host of the pod address or link `url` in lower
+// case. The scheme may be left out, as in "open.audio".
+func funkwhaleHost(url string) string {
+	if !strings.Contains(url, "://") {
+		url = "https://" + url
+	}
+	parsed, err := neturl.Parse(url)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(parsed.Host)
+}
@@ -0,0 +1,171 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/generic.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/antonholmquist/jason"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// Generic plays media from any site supported by youtube-dl, or the fork set
+// in downloads.command, that no other service recognizes. Titles, durations
+// and thumbnails are those reported by youtube-dl.
+type Generic struct {
+	*GenericService
+}
+
+// NewGenericService returns an initialized Generic service object.
+func NewGenericService() *Generic {
+	return &Generic{
+		&GenericService{
+			ReadableName: "Generic",
+			Format:       "bestaudio",
+			// Whether a link is a playlist is only known once it has been
+			// retrieved.
+			TrackRegex: []*regexp.Regexp{
+				regexp.MustCompile(`^https?:\/\/\S+$`),
+			},
+			PlaylistRegex: nil,
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the API key
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (g *Generic) CheckAPIKey() error {
+	// youtube-dl does not require an API key, so we can just return nil.
+	return nil
+}
+
+// CheckURL matches any link if downloads.generic_fallback is enabled. It must
+// come after every other service.
+func (g *Generic) CheckURL(url string) bool {
+	return viper.GetBool("downloads.generic_fallback") && g.GenericService.CheckURL(url)
+}
+
+// GetTracks uses the passed URL to find and return
+// tracks associated with the URL. An error is returned
+// if youtube-dl cannot retrieve the URL.
+func (g *Generic) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	// Playlists are listed flat, so that entries past the limit are never
+	// retrieved.
+	maxItems := viper.GetInt("queue.max_tracks_per_playlist")
+	v, err := DJ.YouTubeDL.GetPlaylistInfo(g.ReadableName, url, maxItems)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := v.GetObjectArray("entries")
+	if err != nil {
+		track := g.getTrack(v, url, submitter)
+		if !hasKnownLength(track) {
+			return nil, errors.New("The length of the media is unknown, so it cannot be added")
+		}
+		return []interfaces.Track{track}, nil
+	}
+
+	id, _ := v.GetString("id")
+	title, _ := v.GetString("title")
+	playlist := &bot.Playlist{
+		ID:        id,
+		Title:     title,
+		Submitter: submitter.Name,
+		Service:   g.ReadableName,
+	}
+
+	var tracks []interfaces.Track
+	for _, entry := range entries {
+		if maxItems > 0 && len(tracks) >= maxItems {
+			break
+		}
+		track, ok := g.getEntryTrack(entry, submitter)
+		if !ok {
+			continue
+		}
+		track.Playlist = playlist
+		tracks = append(tracks, track)
+	}
+
+	if len(tracks) == 0 {
+		return nil, errors.New("Invalid playlist. No tracks were added")
+	}
+	return tracks, nil
+}
+
+// getEntryTrack returns the track of a playlist entry listed flat. Entries
+// that are listed without their duration are retrieved one by one. false is
+// returned if the entry cannot be played or its length is unknown.
+func (g *Generic) getEntryTrack(entry *jason.Object, submitter *gumble.User) (bot.Track, bool) {
+	url, _ := entry.GetString("url")
+	track := g.getTrack(entry, url, submitter)
+	if !strings.HasPrefix(track.URL, "http") {
+		return track, false
+	}
+	if !hasKnownLength(track) {
+		info, err := DJ.YouTubeDL.GetInfo(g.ReadableName, track.URL)
+		if err != nil {
+			return track, false
+		}
+		track = g.getTrack(info, track.URL, submitter)
+	}
+	return track, hasKnownLength(track)
+}
+
+// hasKnownLength returns true if the length of `track` is known, or if it is
+// a live stream, which has none. Tracks of unknown length would get around the
+// duration limits of the queue.
+func hasKnownLength(track bot.Track) bool {
+	return track.Duration > 0 || track.Live
+}
+
+// getTrack returns the track described by the youtube-dl metadata `obj`. Its
+// link is the page youtube-dl reports, or `url` if it reports none.
+func (g *Generic) getTrack(obj *jason.Object, url string, submitter *gumble.User) bot.Track {
+	if page := getFirstString(obj, []string{"webpage_url"}); page != "" {
+		url = page
+	}
+	// Entries listed flat name their extractor in ie_key instead.
+	extractor := getFirstString(obj, []string{"extractor_key"}, []string{"ie_key"})
+	mediaID, _ := obj.GetString("id")
+	title, _ := obj.GetString("title")
+	author := getFirstString(obj, []string{"artist"}, []string{"creator"}, []string{"uploader"}, []string{"channel"})
+	authorURL := getFirstString(obj, []string{"uploader_url"}, []string{"channel_url"})
+	thumbnail, _ := obj.GetString("thumbnail")
+	seconds, _ := obj.GetFloat64("duration")
+	live, _ := obj.GetBoolean("is_live")
+	hash := sha1.Sum([]byte(extractor + ":" + mediaID + ":" + url))
+	id := hex.EncodeToString(hash[:])[:16]
+	offset, _ := time.ParseDuration("0s")
+
+	return bot.Track{
+		ID:             id,
+		URL:            url,
+		Title:          title,
+		Author:         author,
+		AuthorURL:      authorURL,
+		Submitter:      submitter.Name,
+		Service:        g.ReadableName,
+		Filename:       "generic-" + id + ".track",
+		ThumbnailURL:   thumbnail,
+		Duration:       time.Duration(seconds * float64(time.Second)),
+		PlaybackOffset: offset,
+		Playlist:       nil,
+		Live:           live,
+	}
+}
@@ -9,7 +9,11 @@ package services
 
 import (
 	"errors"
+	"net/http"
 	"regexp"
+
+	"github.com/antonholmquist/jason"
+	"github.com/matthieugrieger/mumbledj/bot"
 )
 
 // GenericService is a generic struct that should be embedded
@@ -42,6 +46,16 @@ func (gs *GenericService) CheckURL(url string) bool {
 	return false
 }
 
+// GetTrackID returns the ID of the track that the passed URL links to. ok is
+// false if the URL links to a playlist or has no ID.
+func (gs *GenericService) GetTrackID(url string) (id string, ok bool) {
+	if !gs.isTrack(url) || gs.isPlaylist(url) {
+		return "", false
+	}
+	id, err := gs.getID(url)
+	return id, err == nil && id != ""
+}
+
 func (gs *GenericService) isTrack(url string) bool {
 	for _, regex := range gs.TrackRegex {
 		if regex.MatchString(url) {
@@ -86,3 +100,47 @@ func (gs *GenericService) getID(url string) (string, error) {
 	}
 	return "", errors.New("No match found for URL")
 }
+
+// getJSON performs a GET request on the provided URL and parses the response
+// body as a JSON object. If the response has a non-200 status code, a
+// *bot.APIError is returned that contains the error message from the response
+// body if one is present.
+func (gs *GenericService) getJSON(url string) (*jason.Object, error) {
+	resp, err := bot.HTTPGet(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	v, jsonErr := jason.NewObjectFromReader(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		apiErr := &bot.APIError{
+			Service:    gs.ReadableName,
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+		}
+		if jsonErr == nil {
+			if message, err := v.GetString("error", "message"); err == nil {
+				apiErr.Message = message
+			} else if errs, err := v.GetObjectArray("errors"); err == nil && len(errs) > 0 {
+				apiErr.Message, _ = errs[0].GetString("error_message")
+			}
+		}
+		return nil, apiErr
+	}
+	if jsonErr != nil {
+		return nil, jsonErr
+	}
+	return v, nil
+}
+
+// getFirstString returns the first non-empty string found at the given key
+// paths of the JSON object. An empty string is returned if none is found.
+func getFirstString(v *jason.Object, paths ...[]string) string {
+	for _, path := range paths {
+		if value, err := v.GetString(path...); err == nil && value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,106 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/googledrive.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+)
+
+// GoogleDrive plays audio files shared from Google Drive with a link. Like
+// direct links, the title, artist and duration announced for these tracks are
+// read from the tags of the file once it is downloaded.
+type GoogleDrive struct {
+	*GenericService
+}
+
+// NewGoogleDriveService returns an initialized GoogleDrive service object.
+func NewGoogleDriveService() *GoogleDrive {
+	return &GoogleDrive{
+		&GenericService{
+			ReadableName: "Google Drive",
+			Format:       "best",
+			TrackRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/drive\.google\.com\/file\/d\/(?P<id>[\w-]{20,})`),
+				regexp.MustCompile(`https?:\/\/drive\.google\.com\/(open|uc)\?(\S*&)?id=(?P<id>[\w-]{20,})`),
+			},
+			// Shared folders are not supported.
+			PlaylistRegex: nil,
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the API key
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (gd *GoogleDrive) CheckAPIKey() error {
+	// Files shared with a link do not require an API key, so we can just return nil.
+	return nil
+}
+
+// GetTracks uses the passed URL to find and return
+// tracks associated with the URL. An error is returned
+// if the file is not shared publicly or is not an audio file.
+func (gd *GoogleDrive) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	id, err := gd.getID(url)
+	if err != nil {
+		return nil, err
+	}
+
+	// Google Drive answers with a sign-in page for files that are not shared
+	// with anyone who has the link.
+	filename, err := checkAudioFile(gd.ReadableName, googleDriveDownloadURL(id))
+	if err == errNotAudioFile {
+		return nil, errors.New("The linked file is not an audio file or is not shared with anyone who has the link")
+	} else if err != nil {
+		return nil, err
+	}
+	// The filename stands in as the title until the tags of the file are read.
+	if filename == "" {
+		filename = id
+	}
+	offset, _ := time.ParseDuration("0s")
+
+	track := bot.Track{
+		ID:             id,
+		URL:            "https://drive.google.com/file/d/" + id + "/view",
+		Title:          filename,
+		Author:         gd.ReadableName,
+		AuthorURL:      "https://drive.google.com",
+		Submitter:      submitter.Name,
+		Service:        gd.ReadableName,
+		Filename:       "googledrive-" + id + ".track",
+		ThumbnailURL:   "",
+		Duration:       0,
+		PlaybackOffset: offset,
+		Playlist:       nil,
+		TagsFromFile:   true,
+	}
+	if track, err = withProbedTags(track, googleDriveDownloadURL(id)); err != nil {
+		return nil, err
+	}
+	return []interfaces.Track{track}, nil
+}
+
+// GetStreamURL returns the URL that `t` is downloaded from, which serves the
+// file itself rather than the Google Drive page that shows it.
+func (gd *GoogleDrive) GetStreamURL(t interfaces.Track) string {
+	return googleDriveDownloadURL(t.GetID())
+}
+
+// googleDriveDownloadURL returns the direct download URL of the file `id`.
+// Larger files are otherwise held back by a page warning that they cannot be
+// scanned for viruses, which confirm=t skips.
+func googleDriveDownloadURL(id string) string {
+	return "https://drive.usercontent.google.com/download?id=" + id + "&export=download&confirm=t"
+}
@@ -0,0 +1,167 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/hearthis.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/antonholmquist/jason"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// HearThis is a wrapper around the hearthis.at API, which hosts mostly DJ
+// mixes. Tracks are downloaded by youtube-dl.
+// https://hearthis.at/api-v2/
+type HearThis struct {
+	*GenericService
+}
+
+// NewHearThisService returns an initialized HearThis service object.
+func NewHearThisService() *HearThis {
+	return &HearThis{
+		&GenericService{
+			ReadableName: "hearthis.at",
+			Format:       "bestaudio",
+			TrackRegex: []*regexp.Regexp{
+				regexp.MustCompile(`^https?:\/\/(www\.)?hearthis\.at\/(?P<id>[\w.-]+\/[\w.-]+)\/?([?#]\S*)?$`),
+			},
+			PlaylistRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/(www\.)?hearthis\.at\/[\w.-]+\/set\/(?P<id>[\w.-]+)`),
+			},
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the API key
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (ht *HearThis) CheckAPIKey() error {
+	// The hearthis.at API does not require an API key, so we can just return nil.
+	return nil
+}
+
+// GetTracks uses the passed URL to find and return
+// tracks associated with the URL. An error is returned
+// if any error occurs during the API call.
+func (ht *HearThis) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	id, err := ht.getID(url)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ht.isPlaylist(url) {
+		v, err := ht.getJSON("https://api-v2.hearthis.at/" + id + "/")
+		if err != nil {
+			return nil, err
+		}
+		if _, err := v.GetString("title"); err != nil {
+			return nil, &bot.TrackError{
+				Service: ht.ReadableName,
+				TrackID: id,
+				Message: "This hearthis.at track does not exist",
+			}
+		}
+		return []interfaces.Track{ht.getTrack(v, submitter)}, nil
+	}
+
+	maxItems := math.MaxInt32
+	if viper.GetInt("queue.max_tracks_per_playlist") > 0 {
+		maxItems = viper.GetInt("queue.max_tracks_per_playlist")
+	}
+
+	// Sets are returned as a list of their tracks, a page at a time.
+	var tracks []interfaces.Track
+	var playlist *bot.Playlist
+	for page := 1; len(tracks) < maxItems; page++ {
+		resp, err := bot.HTTPGet(fmt.Sprintf("https://api-v2.hearthis.at/set/%s/?page=%d&count=20", id, page))
+		if err != nil {
+			return nil, err
+		}
+		v, err := jason.NewValueFromReader(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			break
+		}
+		values, err := v.Array()
+		if err != nil || len(values) == 0 {
+			break
+		}
+		if playlist == nil {
+			// The API does not describe the set itself, so its name is
+			// taken from its link.
+			playlist = &bot.Playlist{
+				ID:        id,
+				Title:     strings.Replace(id, "-", " ", -1),
+				Submitter: submitter.Name,
+				Service:   ht.ReadableName,
+			}
+		}
+		for _, value := range values {
+			item, err := value.Object()
+			if err != nil {
+				continue
+			}
+			track := ht.getTrack(item, submitter)
+			track.Playlist = playlist
+			tracks = append(tracks, track)
+
+			if len(tracks) >= maxItems {
+				break
+			}
+		}
+	}
+
+	if len(tracks) == 0 {
+		return nil, errors.New("Invalid playlist. No tracks were added")
+	}
+	return tracks, nil
+}
+
+func (ht *HearThis) getTrack(obj *jason.Object, submitter *gumble.User) bot.Track {
+	id, err := obj.GetString("id")
+	if err != nil {
+		number, _ := obj.GetInt64("id")
+		id = strconv.FormatInt(number, 10)
+	}
+	title, _ := obj.GetString("title")
+	url, _ := obj.GetString("permalink_url")
+	author, _ := obj.GetString("user", "username")
+	authorURL, _ := obj.GetString("user", "permalink_url")
+	thumbnail := getFirstString(obj, []string{"artwork_url"}, []string{"thumb"})
+	// Durations are given in seconds, usually as a string.
+	seconds, err := obj.GetInt64("duration")
+	if err != nil {
+		duration, _ := obj.GetString("duration")
+		seconds, _ = strconv.ParseInt(duration, 10, 64)
+	}
+	offset, _ := time.ParseDuration("0s")
+
+	return bot.Track{
+		ID:             id,
+		URL:            url,
+		Title:          title,
+		Author:         author,
+		AuthorURL:      authorURL,
+		Submitter:      submitter.Name,
+		Service:        ht.ReadableName,
+		Filename:       "hearthis-" + id + ".track",
+		ThumbnailURL:   thumbnail,
+		Duration:       time.Duration(seconds) * time.Second,
+		PlaybackOffset: offset,
+		Playlist:       nil,
+	}
+}
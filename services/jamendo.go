@@ -0,0 +1,190 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/jamendo.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"time"
+
+	"github.com/antonholmquist/jason"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// Jamendo is a wrapper around the Jamendo API. All music on Jamendo is
+// published under Creative Commons licenses.
+// https://developer.jamendo.com/v3.0
+type Jamendo struct {
+	*GenericService
+}
+
+// NewJamendoService returns an initialized Jamendo service object.
+func NewJamendoService() *Jamendo {
+	return &Jamendo{
+		&GenericService{
+			ReadableName: "Jamendo",
+			Format:       "bestaudio",
+			TrackRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/(www\.)?jamendo\.com\/([a-z]{2}\/)?track\/(?P<id>\d+)`),
+			},
+			PlaylistRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/(www\.)?jamendo\.com\/([a-z]{2}\/)?album\/(?P<id>\d+)`),
+			},
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the API key
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (j *Jamendo) CheckAPIKey() error {
+	if viper.GetString("api_keys.jamendo") == "" {
+		return errors.New("No Jamendo API key has been provided. Add your client ID to api_keys.jamendo in the configuration file, see " +
+			"https://github.com/matthieugrieger/mumbledj#jamendo-api-key for instructions")
+	}
+	if _, err := j.call("tracks", "&id=1"); err != nil {
+		return fmt.Errorf("The Jamendo API rejected the provided API key (%s). "+
+			"Make sure api_keys.jamendo contains your client ID, see "+
+			"https://github.com/matthieugrieger/mumbledj#jamendo-api-key for instructions", err.Error())
+	}
+	return nil
+}
+
+// GetTracks uses the passed URL to find and return
+// tracks associated with the URL. An error is returned
+// if any error occurs during the API call.
+func (j *Jamendo) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	id, err := j.getID(url)
+	if err != nil {
+		return nil, err
+	}
+
+	if !j.isPlaylist(url) {
+		results, err := j.call("tracks", "&id="+id)
+		if err != nil {
+			return nil, err
+		}
+		if len(results) == 0 {
+			return nil, &bot.TrackError{
+				Service: j.ReadableName,
+				TrackID: id,
+				Message: "This Jamendo track does not exist",
+			}
+		}
+		return []interfaces.Track{j.getTrack(results[0], "", submitter)}, nil
+	}
+
+	results, err := j.call("albums/tracks", "&id="+id)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, &bot.TrackError{
+			Service: j.ReadableName,
+			TrackID: id,
+			Message: "This Jamendo album does not exist",
+		}
+	}
+	album := results[0]
+
+	title, _ := album.GetString("name")
+	playlist := &bot.Playlist{
+		ID:        id,
+		Title:     title,
+		Submitter: submitter.Name,
+		Service:   j.ReadableName,
+	}
+	artist, _ := album.GetString("artist_name")
+	image, _ := album.GetString("image")
+
+	maxItems := math.MaxInt32
+	if viper.GetInt("queue.max_tracks_per_playlist") > 0 {
+		maxItems = viper.GetInt("queue.max_tracks_per_playlist")
+	}
+
+	var tracks []interfaces.Track
+	items, _ := album.GetObjectArray("tracks")
+	for _, item := range items {
+		track := j.getTrack(item, image, submitter)
+		// Tracks listed with an album do not repeat its artist.
+		if track.Author == "" {
+			track.Author = artist
+		}
+		track.Playlist = playlist
+		tracks = append(tracks, track)
+
+		if len(tracks) >= maxItems {
+			break
+		}
+	}
+
+	if len(tracks) == 0 {
+		return nil, errors.New("Invalid playlist. No tracks were added")
+	}
+	return tracks, nil
+}
+
+func (j *Jamendo) getTrack(obj *jason.Object, thumbnail string, submitter *gumble.User) bot.Track {
+	id, _ := obj.GetString("id")
+	title, _ := obj.GetString("name")
+	author, _ := obj.GetString("artist_name")
+	authorID, _ := obj.GetString("artist_id")
+	seconds, _ := obj.GetInt64("duration")
+	if image := getFirstString(obj, []string{"album_image"}, []string{"image"}); image != "" {
+		thumbnail = image
+	}
+	authorURL := ""
+	if authorID != "" {
+		authorURL = "https://www.jamendo.com/artist/" + authorID
+	}
+	offset, _ := time.ParseDuration("0s")
+
+	return bot.Track{
+		ID:             id,
+		URL:            "https://www.jamendo.com/track/" + id,
+		Title:          title,
+		Author:         author,
+		AuthorURL:      authorURL,
+		Submitter:      submitter.Name,
+		Service:        j.ReadableName,
+		Filename:       "jamendo-" + id + ".track",
+		ThumbnailURL:   thumbnail,
+		Duration:       time.Duration(seconds) * time.Second,
+		PlaybackOffset: offset,
+		Playlist:       nil,
+	}
+}
+
+// call performs a request against the Jamendo API endpoint `endpoint` with the
+// extra query parameters `params` and returns its results. The Jamendo API
+// reports errors in the headers of successful responses, so those are turned
+// into errors here.
+func (j *Jamendo) call(endpoint, params string) ([]*jason.Object, error) {
+	url := fmt.Sprintf("https://api.jamendo.com/v3.0/%s/?client_id=%s&format=json%s",
+		endpoint, viper.GetString("api_keys.jamendo"), params)
+	v, err := j.getJSON(url)
+	if err != nil {
+		return nil, err
+	}
+	if status, _ := v.GetString("headers", "status"); status != "success" {
+		message, _ := v.GetString("headers", "error_message")
+		code, _ := v.GetInt64("headers", "code")
+		return nil, &bot.APIError{
+			Service:    j.ReadableName,
+			StatusCode: int(code),
+			Status:     status,
+			Message:    message,
+		}
+	}
+	return v.GetObjectArray("results")
+}
@@ -0,0 +1,240 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/jellyfin.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	neturl "net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/antonholmquist/jason"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// jellyfinTicksPerSecond is the number of ticks in a second. Jellyfin reports
+// durations in ticks of 100 nanoseconds.
+const jellyfinTicksPerSecond = 10000000
+
+// Jellyfin plays music from the libraries of a Jellyfin server, configured in
+// the jellyfin section of the configuration file. Tracks, albums, artists and
+// playlists are added with links from the Jellyfin web client or by item ID,
+// e.g. "jellyfin:<item ID>", and tracks may be found by name with
+// "jellyfin:search terms".
+// https://api.jellyfin.org
+type Jellyfin struct {
+	*GenericService
+}
+
+// NewJellyfinService returns an initialized Jellyfin service object.
+func NewJellyfinService() *Jellyfin {
+	return &Jellyfin{
+		&GenericService{
+			ReadableName: "Jellyfin",
+			Format:       "best",
+			// Whether an item is a track or holds several is only known once
+			// it has been retrieved.
+			TrackRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/\S+\/web\/(index\.html)?#!?\/details\?id=(?P<id>[0-9a-f]{32})`),
+				regexp.MustCompile(`(?i)^jellyfin:(?P<id>[0-9a-f]{32})$`),
+			},
+			PlaylistRegex: nil,
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the API key
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (jf *Jellyfin) CheckAPIKey() error {
+	if viper.GetString("jellyfin.url") == "" || viper.GetString("jellyfin.api_key") == "" {
+		return errors.New("No Jellyfin server has been configured. Set jellyfin.url and jellyfin.api_key in the configuration file, see " +
+			"https://github.com/matthieugrieger/mumbledj#jellyfin-server for instructions")
+	}
+	if _, err := jf.getJSON(jf.endpoint("/System/Info", nil)); err != nil {
+		return fmt.Errorf("The Jellyfin server could not be reached with the configured API key (%s)", err.Error())
+	}
+	return nil
+}
+
+// GetTracks uses the passed URL to find and return
+// tracks associated with the URL. Albums, artists and
+// playlists return all of their tracks. An error is returned
+// if any error occurs during the API call.
+func (jf *Jellyfin) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	id, err := jf.getID(url)
+	if err != nil {
+		return nil, err
+	}
+	id = strings.ToLower(id)
+
+	params := neturl.Values{}
+	params.Set("Ids", id)
+	items, err := jf.getItems(params)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, &bot.TrackError{
+			Service: jf.ReadableName,
+			TrackID: id,
+			Message: "This Jellyfin item does not exist",
+		}
+	}
+	item := items[0]
+	itemType, _ := item.GetString("Type")
+	if itemType == "Audio" {
+		return []interfaces.Track{jf.getTrack(item, submitter)}, nil
+	}
+
+	params = neturl.Values{}
+	params.Set("IncludeItemTypes", "Audio")
+	params.Set("Recursive", "true")
+	switch itemType {
+	case "MusicArtist":
+		params.Set("ArtistIds", id)
+		params.Set("SortBy", "Album,ParentIndexNumber,IndexNumber")
+	case "MusicAlbum":
+		params.Set("ParentId", id)
+		params.Set("SortBy", "ParentIndexNumber,IndexNumber")
+	case "Playlist":
+		// Playlist entries are returned in the order of the playlist.
+		params.Set("ParentId", id)
+	default:
+		return nil, errors.New("Only tracks, albums, artists and playlists can be added from Jellyfin")
+	}
+	children, err := jf.getItems(params)
+	if err != nil {
+		return nil, err
+	}
+
+	title, _ := item.GetString("Name")
+	playlist := &bot.Playlist{
+		ID:        id,
+		Title:     title,
+		Submitter: submitter.Name,
+		Service:   jf.ReadableName,
+	}
+
+	maxItems := math.MaxInt32
+	if viper.GetInt("queue.max_tracks_per_playlist") > 0 {
+		maxItems = viper.GetInt("queue.max_tracks_per_playlist")
+	}
+
+	var tracks []interfaces.Track
+	for _, child := range children {
+		track := jf.getTrack(child, submitter)
+		track.Playlist = playlist
+		tracks = append(tracks, track)
+
+		if len(tracks) >= maxItems {
+			break
+		}
+	}
+
+	if len(tracks) == 0 {
+		return nil, errors.New("Invalid playlist. No tracks were added")
+	}
+	return tracks, nil
+}
+
+// SearchTracks searches the music libraries of the Jellyfin server for tracks
+// whose name matches the query and returns up to `limit` of them.
+func (jf *Jellyfin) SearchTracks(query string, submitter *gumble.User, limit int) ([]interfaces.Track, error) {
+	params := neturl.Values{}
+	params.Set("SearchTerm", query)
+	params.Set("IncludeItemTypes", "Audio")
+	params.Set("Recursive", "true")
+	params.Set("Limit", fmt.Sprintf("%d", limit))
+	items, err := jf.getItems(params)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks := make([]interfaces.Track, 0, len(items))
+	for _, item := range items {
+		tracks = append(tracks, jf.getTrack(item, submitter))
+	}
+	return tracks, nil
+}
+
+// GetStreamURL returns the URL that `t` is downloaded from, which carries the
+// API key for the Jellyfin server.
+func (jf *Jellyfin) GetStreamURL(t interfaces.Track) string {
+	params := neturl.Values{}
+	params.Set("static", "true")
+	return jf.endpoint("/Audio/"+t.GetID()+"/stream", params)
+}
+
+func (jf *Jellyfin) getTrack(item *jason.Object, submitter *gumble.User) bot.Track {
+	id, _ := item.GetString("Id")
+	title, _ := item.GetString("Name")
+	artists, _ := item.GetStringArray("Artists")
+	author := strings.Join(artists, ", ")
+	if author == "" {
+		author, _ = item.GetString("AlbumArtist")
+	}
+	ticks, _ := item.GetInt64("RunTimeTicks")
+	offset, _ := time.ParseDuration("0s")
+
+	// Images are served without the API key.
+	thumbnail := ""
+	if _, err := item.GetString("ImageTags", "Primary"); err == nil {
+		thumbnail = jf.address() + "/Items/" + id + "/Images/Primary?maxWidth=300"
+	} else if albumID, err := item.GetString("AlbumId"); err == nil && albumID != "" {
+		if _, err := item.GetString("AlbumPrimaryImageTag"); err == nil {
+			thumbnail = jf.address() + "/Items/" + albumID + "/Images/Primary?maxWidth=300"
+		}
+	}
+
+	return bot.Track{
+		ID:             id,
+		URL:            jf.address() + "/web/index.html#!/details?id=" + id,
+		Title:          title,
+		Author:         author,
+		Submitter:      submitter.Name,
+		Service:        jf.ReadableName,
+		Filename:       "jellyfin-" + id + ".track",
+		ThumbnailURL:   thumbnail,
+		Duration:       time.Duration(ticks/jellyfinTicksPerSecond) * time.Second,
+		PlaybackOffset: offset,
+		Playlist:       nil,
+	}
+}
+
+// getItems queries the items of the Jellyfin server with the query parameters
+// `params`.
+func (jf *Jellyfin) getItems(params neturl.Values) ([]*jason.Object, error) {
+	v, err := jf.getJSON(jf.endpoint("/Items", params))
+	if err != nil {
+		return nil, err
+	}
+	items, _ := v.GetObjectArray("Items")
+	return items, nil
+}
+
+// endpoint returns the URL of `path` on the Jellyfin server with the query
+// parameters `params` and the API key.
+func (jf *Jellyfin) endpoint(path string, params neturl.Values) string {
+	if params == nil {
+		params = neturl.Values{}
+	}
+	params.Set("api_key", viper.GetString("jellyfin.api_key"))
+	return jf.address() + path + "?" + params.Encode()
+}
+
+// address returns the address of the Jellyfin server without a trailing slash.
+func (jf *Jellyfin) address() string {
+	return strings.TrimSuffix(viper.GetString("jellyfin.url"), "/")
+}
@@ -9,7 +9,6 @@ package services
 
 import (
 	"fmt"
-	"net/http"
 	"regexp"
 	"strings"
 	"time"
@@ -57,7 +56,6 @@ func (mc *Mixcloud) GetTracks(url string, submitter *gumble.User) ([]interfaces.
 	var (
 		apiURL string
 		err    error
-		resp   *http.Response
 		v      *jason.Object
 		tracks []interfaces.Track
 	)
@@ -69,13 +67,7 @@ func (mc *Mixcloud) GetTracks(url string, submitter *gumble.User) ([]interfaces.
 	// a playback offset in the URL.
 	offset, _ := time.ParseDuration("0s")
 
-	resp, err = http.Get(apiURL)
-	defer resp.Body.Close()
-	if err != nil {
-		return nil, err
-	}
-
-	v, err = jason.NewObjectFromReader(resp.Body)
+	v, err = mc.getJSON(apiURL)
 	if err != nil {
 		return nil, err
 	}
@@ -87,11 +79,8 @@ func (mc *Mixcloud) GetTracks(url string, submitter *gumble.User) ([]interfaces.
 	authorURL, _ := v.GetString("user", "url")
 	durationSecs, _ := v.GetInt64("audio_length")
 	duration, _ := time.ParseDuration(fmt.Sprintf("%ds", durationSecs))
-	thumbnail, err := v.GetString("pictures", "large")
-	if err != nil {
-		// Track has no artwork, using profile avatar instead.
-		thumbnail, _ = v.GetString("user", "pictures", "large")
-	}
+	// If the track has no artwork, the profile avatar is used instead.
+	thumbnail := getFirstString(v, []string{"pictures", "large"}, []string{"user", "pictures", "large"})
 
 	track := bot.Track{
 		ID:             id,
@@ -0,0 +1,107 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/niconico.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+)
+
+// NicoNico plays videos from niconico (nicovideo.jp). Metadata is retrieved
+// through youtube-dl, which logs in with the account set in logins.niconico as
+// many videos can only be watched by logged in users.
+type NicoNico struct {
+	*GenericService
+}
+
+// NewNicoNicoService returns an initialized NicoNico service object.
+func NewNicoNicoService() *NicoNico {
+	return &NicoNico{
+		&GenericService{
+			ReadableName: "NicoNico",
+			Format:       "bestaudio",
+			TrackRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/(www\.|sp\.)?nicovideo\.jp\/watch\/(?P<id>[a-z]{2}\d+)(\?from=(?P<offset>\d+))?`),
+				regexp.MustCompile(`https?:\/\/nico\.ms\/(?P<id>[a-z]{2}\d+)(\?from=(?P<offset>\d+))?`),
+			},
+			// Mylists are currently unsupported.
+			PlaylistRegex: nil,
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the API key
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (nn *NicoNico) CheckAPIKey() error {
+	// Metadata is retrieved through youtube-dl, so no API key is required.
+	// Videos that require an account fail to be added if logins.niconico is
+	// not set.
+	return nil
+}
+
+// GetTracks uses the passed URL to find and return
+// tracks associated with the URL. An error is returned
+// if youtube-dl cannot retrieve information about the URL.
+func (nn *NicoNico) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	id, err := nn.getID(url)
+	if err != nil {
+		return nil, err
+	}
+
+	// Playback offsets are given in seconds, e.g. "?from=90".
+	offset, _ := time.ParseDuration("0s")
+	for _, regex := range nn.TrackRegex {
+		match := regex.FindStringSubmatch(url)
+		for i, name := range regex.SubexpNames() {
+			if match != nil && name == "offset" && match[i] != "" {
+				seconds, _ := strconv.Atoi(match[i])
+				offset = time.Duration(seconds) * time.Second
+			}
+		}
+	}
+
+	trackURL := "https://www.nicovideo.jp/watch/" + id
+	v, err := DJ.YouTubeDL.GetInfo(nn.ReadableName, trackURL)
+	if err != nil {
+		return nil, err
+	}
+
+	title, _ := v.GetString("title")
+	author, _ := v.GetString("uploader")
+	authorID, _ := v.GetString("uploader_id")
+	durationSecs, _ := v.GetFloat64("duration")
+	thumbnail, _ := v.GetString("thumbnail")
+
+	authorURL := ""
+	if authorID != "" {
+		authorURL = "https://www.nicovideo.jp/user/" + authorID
+	}
+
+	return []interfaces.Track{
+		bot.Track{
+			ID:             id,
+			URL:            trackURL,
+			Title:          title,
+			Author:         author,
+			AuthorURL:      authorURL,
+			Submitter:      submitter.Name,
+			Service:        nn.ReadableName,
+			Filename:       "niconico-" + id + ".track",
+			ThumbnailURL:   thumbnail,
+			Duration:       time.Duration(durationSecs * float64(time.Second)),
+			PlaybackOffset: offset,
+			Playlist:       nil,
+		},
+	}, nil
+}
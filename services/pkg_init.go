@@ -19,9 +19,30 @@ var DJ *bot.MumbleDJ
 var Services []interfaces.Service
 
 func init() {
+	// Services are matched against URLs in this order. The radio service
+	// connects to URLs that no other service recognizes, including direct
+	// links, since many station streams end in ".mp3". The generic service
+	// recognizes every link, so it comes last.
 	Services = []interfaces.Service{
+		NewArchiveService(),
+		NewAudiusService(),
+		NewBandcampService(),
+		NewDeezerService(),
+		NewDropboxService(),
+		NewFunkwhaleService(),
+		NewGoogleDriveService(),
+		NewHearThisService(),
+		NewJamendoService(),
+		NewJellyfinService(),
 		NewMixcloudService(),
+		NewNicoNicoService(),
+		NewPlexService(),
 		NewSoundCloudService(),
+		NewSubsonicService(),
+		NewTwitchService(),
 		NewYouTubeService(),
+		NewRadioService(),
+		NewDirectService(),
+		NewGenericService(),
 	}
 }
@@ -0,0 +1,300 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/plex.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	neturl "net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// plexPrefix starts searches of the Plex server, such as "plex:search terms".
+const plexPrefix = "plex:"
+
+// plexTrackType is the Plex metadata type of tracks, used to limit searches to
+// music.
+const plexTrackType = "10"
+
+// Plex plays music from the libraries of a Plex Media Server, configured in
+// the plex section of the configuration file. Tracks, albums, artists and
+// playlists are added with links from Plex Web, and tracks may be found by
+// searching the server.
+type Plex struct {
+	*GenericService
+}
+
+// plexContainer is the MediaContainer element that Plex wraps every response
+// in.
+type plexContainer struct {
+	Title       string          `xml:"title,attr"`
+	Tracks      []plexTrack     `xml:"Track"`
+	Directories []plexDirectory `xml:"Directory"`
+	Playlists   []plexDirectory `xml:"Playlist"`
+}
+
+// plexTrack is a track in a Plex music library.
+type plexTrack struct {
+	RatingKey        string      `xml:"ratingKey,attr"`
+	Title            string      `xml:"title,attr"`
+	OriginalTitle    string      `xml:"originalTitle,attr"`
+	GrandparentTitle string      `xml:"grandparentTitle,attr"`
+	Duration         int64       `xml:"duration,attr"`
+	Media            []plexMedia `xml:"Media"`
+}
+
+// plexMedia is a version of a track, stored in one or more files (parts).
+type plexMedia struct {
+	Parts []struct {
+		Key string `xml:"key,attr"`
+	} `xml:"Part"`
+}
+
+// partKey returns the path of the first file of `t` on the Plex server, or an
+// empty string if it has none.
+func (t plexTrack) partKey() string {
+	for _, media := range t.Media {
+		for _, part := range media.Parts {
+			if part.Key != "" {
+				return part.Key
+			}
+		}
+	}
+	return ""
+}
+
+// plexDirectory is an album, artist or playlist in a Plex library.
+type plexDirectory struct {
+	RatingKey string `xml:"ratingKey,attr"`
+	Type      string `xml:"type,attr"`
+	Title     string `xml:"title,attr"`
+}
+
+// NewPlexService returns an initialized Plex service object.
+func NewPlexService() *Plex {
+	return &Plex{
+		&GenericService{
+			ReadableName: "Plex",
+			Format:       "best",
+			TrackRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/\S+#!\/server\/\w+\/details\?key=%2Flibrary%2Fmetadata%2F(?P<id>\d+)`),
+			},
+			PlaylistRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/\S+#!\/server\/\w+\/playlist\?key=%2Fplaylists%2F(?P<id>\d+)`),
+			},
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the token
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (p *Plex) CheckAPIKey() error {
+	if viper.GetString("plex.url") == "" || viper.GetString("plex.token") == "" {
+		return errors.New("No Plex server has been configured. Set plex.url and plex.token in the configuration file, see " +
+			"https://github.com/matthieugrieger/mumbledj#plex-media-server for instructions")
+	}
+	if _, err := p.get("/library/sections", nil); err != nil {
+		return fmt.Errorf("The Plex server could not be reached with the configured token (%s)", err.Error())
+	}
+	return nil
+}
+
+// CheckURL matches the passed URL with the patterns for links from Plex Web,
+// and also accepts searches of the Plex server such as "plex:search terms".
+func (p *Plex) CheckURL(url string) bool {
+	return p.GenericService.CheckURL(url) || strings.HasPrefix(strings.ToLower(url), plexPrefix)
+}
+
+// GetTracks uses the passed URL to find and return
+// tracks associated with the URL. Links to albums and artists
+// return all of their tracks. An error is returned
+// if any error occurs during the API call.
+func (p *Plex) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	if strings.HasPrefix(strings.ToLower(url), plexPrefix) {
+		query := strings.TrimSpace(url[len(plexPrefix):])
+		tracks, err := p.SearchTracks(query, submitter, 1)
+		if err == nil && len(tracks) == 0 {
+			err = fmt.Errorf("No tracks on the Plex server match \"%s\"", query)
+		}
+		return tracks, err
+	}
+
+	id, err := p.getID(url)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		title string
+		items *plexContainer
+	)
+	if p.isPlaylist(url) {
+		if items, err = p.get("/playlists/"+id+"/items", nil); err != nil {
+			return nil, err
+		}
+		title = items.Title
+	} else {
+		item, err := p.get("/library/metadata/"+id, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(item.Tracks) > 0 && item.Tracks[0].partKey() != "" {
+			return []interfaces.Track{p.getTrack(item.Tracks[0], submitter)}, nil
+		}
+		if len(item.Directories) == 0 {
+			return nil, &bot.TrackError{
+				Service: p.ReadableName,
+				TrackID: id,
+				Message: "This Plex item does not exist",
+			}
+		}
+		title = item.Directories[0].Title
+		// Albums hold their tracks directly, artists in their albums.
+		switch item.Directories[0].Type {
+		case "album":
+			items, err = p.get("/library/metadata/"+id+"/children", nil)
+		case "artist":
+			items, err = p.get("/library/metadata/"+id+"/allLeaves", nil)
+		default:
+			return nil, errors.New("Only tracks, albums, artists and playlists can be added from Plex")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	playlist := &bot.Playlist{
+		ID:        id,
+		Title:     title,
+		Submitter: submitter.Name,
+		Service:   p.ReadableName,
+	}
+
+	maxItems := math.MaxInt32
+	if viper.GetInt("queue.max_tracks_per_playlist") > 0 {
+		maxItems = viper.GetInt("queue.max_tracks_per_playlist")
+	}
+
+	var tracks []interfaces.Track
+	for _, item := range items.Tracks {
+		if item.partKey() == "" {
+			continue
+		}
+		track := p.getTrack(item, submitter)
+		track.Playlist = playlist
+		tracks = append(tracks, track)
+
+		if len(tracks) >= maxItems {
+			break
+		}
+	}
+
+	if len(tracks) == 0 {
+		return nil, errors.New("Invalid playlist. No tracks were added")
+	}
+	return tracks, nil
+}
+
+// SearchTracks searches the music libraries of the Plex server for the query
+// and returns up to `limit` tracks.
+func (p *Plex) SearchTracks(query string, submitter *gumble.User, limit int) ([]interfaces.Track, error) {
+	params := neturl.Values{}
+	params.Set("query", query)
+	params.Set("type", plexTrackType)
+	results, err := p.get("/search", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var tracks []interfaces.Track
+	for _, item := range results.Tracks {
+		if item.partKey() == "" {
+			continue
+		}
+		tracks = append(tracks, p.getTrack(item, submitter))
+		if len(tracks) >= limit {
+			break
+		}
+	}
+	return tracks, nil
+}
+
+// GetStreamURL returns the URL that `t` is downloaded from, which carries the
+// token for the Plex server.
+func (p *Plex) GetStreamURL(t interfaces.Track) string {
+	return t.GetURL() + "?X-Plex-Token=" + neturl.QueryEscape(viper.GetString("plex.token"))
+}
+
+func (p *Plex) getTrack(item plexTrack, submitter *gumble.User) bot.Track {
+	// Tracks on compilations name their own artist in the original title.
+	author := item.OriginalTitle
+	if author == "" {
+		author = item.GrandparentTitle
+	}
+	offset, _ := time.ParseDuration("0s")
+
+	return bot.Track{
+		ID: item.RatingKey,
+		// The token is added when the track is downloaded, so that it is
+		// never shown to users.
+		URL:       p.address() + item.partKey(),
+		Title:     item.Title,
+		Author:    author,
+		Submitter: submitter.Name,
+		Service:   p.ReadableName,
+		Filename:  "plex-" + item.RatingKey + ".track",
+		// Artwork requires the token as well, so none is shown.
+		ThumbnailURL:   "",
+		Duration:       time.Duration(item.Duration) * time.Millisecond,
+		PlaybackOffset: offset,
+		Playlist:       nil,
+	}
+}
+
+// get performs a request against the Plex server at `path` with the extra
+// query parameters `params` and parses the MediaContainer it responds with.
+func (p *Plex) get(path string, params neturl.Values) (*plexContainer, error) {
+	if params == nil {
+		params = neturl.Values{}
+	}
+	params.Set("X-Plex-Token", viper.GetString("plex.token"))
+	resp, err := bot.HTTPGet(p.address() + path + "?" + params.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, &bot.APIError{
+			Service:    p.ReadableName,
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+		}
+	}
+
+	container := new(plexContainer)
+	if err := xml.NewDecoder(resp.Body).Decode(container); err != nil {
+		return nil, err
+	}
+	return container, nil
+}
+
+// address returns the address of the Plex server without a trailing slash.
+func (p *Plex) address() string {
+	return strings.TrimSuffix(viper.GetString("plex.url"), "/")
+}
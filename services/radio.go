@@ -0,0 +1,144 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/radio.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+)
+
+// Radio relays internet radio stations served by Icecast or Shoutcast. Links
+// to .pls and .m3u station playlists are recognized by their extension, while
+// other links are probed for the ICY headers the server responds with once no
+// other service has recognized them.
+// Only admins may queue stations, as they play until they are skipped or
+// stopped. The bot itself may queue the station set in queue.fallback_stream.
+type Radio struct {
+	*GenericService
+}
+
+// NewRadioService returns an initialized Radio service object.
+func NewRadioService() *Radio {
+	return &Radio{
+		&GenericService{
+			ReadableName: "Radio",
+			Format:       "",
+			TrackRegex: []*regexp.Regexp{
+				regexp.MustCompile(`(?i)^https?:\/\/[^\s?#]+\.(pls|m3u)([?#]\S*)?$`),
+			},
+			PlaylistRegex: nil,
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the API key
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (r *Radio) CheckAPIKey() error {
+	// Radio stations do not require an API key, so we can just return nil.
+	return nil
+}
+
+// ProbeURL returns true if `url` responds as an internet radio stream. Only
+// admins may queue stations, so nothing is probed for other users.
+func (r *Radio) ProbeURL(url string, submitter *gumble.User) bool {
+	if !r.mayQueue(submitter) || bot.IsReplayMode() || !strings.HasPrefix(url, "http") {
+		return false
+	}
+	_, err := bot.ProbeRadio(url)
+	return err == nil
+}
+
+// GetTracks uses the passed URL to find and return the station associated
+// with the URL as a live track. An error is returned if the submitter is not
+// an admin or the URL is not an internet radio stream.
+func (r *Radio) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	if !r.mayQueue(submitter) {
+		return nil, errors.New("Only admins can add internet radio stations")
+	}
+
+	streamURL := url
+	if r.GenericService.CheckURL(url) {
+		var err error
+		if streamURL, err = r.getStreamURL(url); err != nil {
+			return nil, err
+		}
+	}
+	station, err := bot.ProbeRadio(streamURL)
+	if err != nil {
+		return nil, err
+	}
+
+	title := station.Name
+	if title == "" {
+		title = streamURL
+	}
+	hash := sha1.Sum([]byte(streamURL))
+	id := hex.EncodeToString(hash[:])[:16]
+
+	return []interfaces.Track{
+		bot.Track{
+			ID:        id,
+			URL:       streamURL,
+			Title:     title,
+			Author:    station.Description,
+			AuthorURL: station.Homepage,
+			Submitter: submitter.Name,
+			Service:   r.ReadableName,
+			Playlist:  nil,
+			Live:      true,
+		},
+	}, nil
+}
+
+// mayQueue returns true if `submitter` may queue stations: admins, and the
+// bot itself when it adds the fallback stream.
+func (r *Radio) mayQueue(submitter *gumble.User) bool {
+	return DJ.IsAdmin(submitter) || bot.IsBotUser(submitter)
+}
+
+// getStreamURL returns the first stream listed in the .pls or .m3u station
+// playlist at `url`.
+func (r *Radio) getStreamURL(url string) (string, error) {
+	response, err := bot.HTTPGet(url)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", &bot.APIError{
+			Service:    r.ReadableName,
+			StatusCode: response.StatusCode,
+			Status:     response.Status,
+		}
+	}
+
+	scanner := bufio.NewScanner(response.Body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		// .pls playlists list streams as "File1=<url>".
+		if strings.HasPrefix(strings.ToLower(line), "file") {
+			if i := strings.Index(line, "="); i != -1 {
+				line = strings.TrimSpace(line[i+1:])
+			}
+		}
+		if strings.HasPrefix(line, "http://") || strings.HasPrefix(line, "https://") {
+			return line, nil
+		}
+	}
+	return "", errors.New("The station playlist does not list any streams")
+}
@@ -10,12 +10,15 @@ package services
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	neturl "net/url"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Sirupsen/logrus"
 	"github.com/antonholmquist/jason"
 	"github.com/layeh/gumble/gumble"
 	"github.com/matthieugrieger/mumbledj/bot"
@@ -36,10 +39,10 @@ func NewSoundCloudService() *SoundCloud {
 			ReadableName: "SoundCloud",
 			Format:       "bestaudio",
 			TrackRegex: []*regexp.Regexp{
-				regexp.MustCompile(`https?:\/\/(www\.)?soundcloud\.com\/([\w-]+)\/([\w-]+)(#t=\n\n?(:\n\n)*)?`),
+				regexp.MustCompile(`https?:\/\/(www\.|m\.)?soundcloud\.com\/([\w-]+)\/([\w-]+)(#t=\d\d?(:\d\d)*)?`),
 			},
 			PlaylistRegex: []*regexp.Regexp{
-				regexp.MustCompile(`https?:\/\/(www\.)?soundcloud\.com\/([\w-]+)\/sets\/([\w-]+)`),
+				regexp.MustCompile(`https?:\/\/(www\.|m\.)?soundcloud\.com\/([\w-]+)\/sets\/([\w-]+)`),
 			},
 		},
 	}
@@ -50,16 +53,19 @@ func NewSoundCloudService() *SoundCloud {
 // service should be enabled.
 func (sc *SoundCloud) CheckAPIKey() error {
 	if viper.GetString("api_keys.soundcloud") == "" {
-		return errors.New("No SoundCloud API key has been provided")
+		return errors.New("No SoundCloud API key has been provided. Add your client ID to api_keys.soundcloud in the configuration file, see " +
+			"https://github.com/matthieugrieger/mumbledj#soundcloud-api-key for instructions")
 	}
 	url := "http://api.soundcloud.com/tracks/13158665?client_id=%s"
 	response, err := http.Get(fmt.Sprintf(url, viper.GetString("api_keys.soundcloud")))
-	defer response.Body.Close()
 	if err != nil {
 		return err
 	}
+	defer response.Body.Close()
 	if response.StatusCode != 200 {
-		return errors.New(response.Status)
+		return fmt.Errorf("The SoundCloud API rejected the provided API key (%s). "+
+			"Make sure api_keys.soundcloud contains your client ID and not your client secret, see "+
+			"https://github.com/matthieugrieger/mumbledj#soundcloud-api-key for instructions", response.Status)
 	}
 	return nil
 }
@@ -71,25 +77,19 @@ func (sc *SoundCloud) GetTracks(url string, submitter *gumble.User) ([]interface
 	var (
 		apiURL string
 		err    error
-		resp   *http.Response
 		v      *jason.Object
 		track  bot.Track
 		tracks []interfaces.Track
 	)
 
 	urlSplit := strings.Split(url, "#t=")
+	urlSplit[0] = sc.resolvableURL(urlSplit[0])
 
 	apiURL = "http://api.soundcloud.com/resolve?url=%s&client_id=%s"
 
 	if sc.isPlaylist(url) {
 		// Submitter has added a playlist!
-		resp, err = http.Get(fmt.Sprintf(apiURL, urlSplit[0], viper.GetString("api_keys.soundcloud")))
-		defer resp.Body.Close()
-		if err != nil {
-			return nil, err
-		}
-
-		v, err = jason.NewObjectFromReader(resp.Body)
+		v, err = sc.getJSON(fmt.Sprintf(apiURL, urlSplit[0], viper.GetString("api_keys.soundcloud")))
 		if err != nil {
 			return nil, err
 		}
@@ -109,15 +109,29 @@ func (sc *SoundCloud) GetTracks(url string, submitter *gumble.User) ([]interface
 			return nil, err
 		}
 
+		maxItems := math.MaxInt32
+		if viper.GetInt("queue.max_tracks_per_playlist") > 0 {
+			maxItems = viper.GetInt("queue.max_tracks_per_playlist")
+		}
+
 		dummyOffset, _ := time.ParseDuration("0s")
 		for _, t := range scTracks {
 			track, err = sc.getTrack(t, dummyOffset, submitter)
-			if err != nil {
-				// Skip this track.
+			if err != nil || track.Title == "" {
+				// Skip tracks that are private, removed, or only partially
+				// described by the API.
+				logrus.WithFields(logrus.Fields{
+					"playlist": permalink,
+					"track":    track.ID,
+				}).Infoln("Skipping a SoundCloud set item.")
 				continue
 			}
 			track.Playlist = playlist
 			tracks = append(tracks, track)
+
+			if len(tracks) >= maxItems {
+				break
+			}
 		}
 
 		if len(tracks) == 0 {
@@ -141,25 +155,61 @@ func (sc *SoundCloud) GetTracks(url string, submitter *gumble.User) ([]interface
 	}
 	playbackOffset, _ := time.ParseDuration(fmt.Sprintf("%ds", offset))
 
-	resp, err = http.Get(fmt.Sprintf(apiURL, urlSplit[0], viper.GetString("api_keys.soundcloud")))
-	defer resp.Body.Close()
+	v, err = sc.getJSON(fmt.Sprintf(apiURL, urlSplit[0], viper.GetString("api_keys.soundcloud")))
 	if err != nil {
 		return nil, err
 	}
-
-	v, err = jason.NewObjectFromReader(resp.Body)
+	track, err = sc.getTrack(v, playbackOffset, submitter)
 	if err != nil {
 		return nil, err
 	}
-	track, err = sc.getTrack(v, playbackOffset, submitter)
+
+	tracks = append(tracks, track)
+	return tracks, nil
+}
+
+// SearchTracks searches SoundCloud for tracks matching the query and returns up
+// to `limit` of them, ordered by relevance. Tracks that cannot be streamed are
+// left out.
+func (sc *SoundCloud) SearchTracks(query string, submitter *gumble.User, limit int) ([]interfaces.Track, error) {
+	// With linked_partitioning the results are wrapped in an object rather
+	// than returned as a bare list.
+	searchURL := "http://api.soundcloud.com/tracks?q=%s&limit=%d&linked_partitioning=true&client_id=%s"
+	v, err := sc.getJSON(fmt.Sprintf(searchURL, neturl.QueryEscape(query), limit, viper.GetString("api_keys.soundcloud")))
 	if err != nil {
 		return nil, err
 	}
 
-	tracks = append(tracks, track)
+	tracks := make([]interfaces.Track, 0)
+	results, _ := v.GetObjectArray("collection")
+	dummyOffset, _ := time.ParseDuration("0s")
+	for _, result := range results {
+		if streamable, err := result.GetBoolean("streamable"); err == nil && !streamable {
+			continue
+		}
+		track, err := sc.getTrack(result, dummyOffset, submitter)
+		if err != nil || track.Title == "" {
+			continue
+		}
+		tracks = append(tracks, track)
+	}
+
+	if len(tracks) == 0 {
+		return nil, fmt.Errorf("No SoundCloud tracks were found for \"%s\"", query)
+	}
 	return tracks, nil
 }
 
+// resolvableURL converts links shared from the mobile site or app, which may
+// carry tracking parameters, into the canonical form accepted by the resolve
+// endpoint.
+func (sc *SoundCloud) resolvableURL(url string) string {
+	if i := strings.Index(url, "?"); i != -1 {
+		url = url[:i]
+	}
+	return strings.Replace(url, "://m.soundcloud.com", "://soundcloud.com", 1)
+}
+
 func (sc *SoundCloud) getTrack(obj *jason.Object, offset time.Duration, submitter *gumble.User) (bot.Track, error) {
 	title, _ := obj.GetString("title")
 	idInt, _ := obj.GetInt64("id")
@@ -169,11 +219,8 @@ func (sc *SoundCloud) getTrack(obj *jason.Object, offset time.Duration, submitte
 	authorURL, _ := obj.GetString("user", "permalink_url")
 	durationMS, _ := obj.GetInt64("duration")
 	duration, _ := time.ParseDuration(fmt.Sprintf("%dms", durationMS))
-	thumbnail, err := obj.GetString("artwork_url")
-	if err != nil {
-		// Track has no artwork, using profile avatar instead.
-		thumbnail, _ = obj.GetString("user", "avatar_url")
-	}
+	// If the track has no artwork, the profile avatar is used instead.
+	thumbnail := getFirstString(obj, []string{"artwork_url"}, []string{"user", "avatar_url"})
 
 	return bot.Track{
 		ID:             id,
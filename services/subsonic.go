@@ -0,0 +1,197 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/subsonic.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	"github.com/antonholmquist/jason"
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+	"github.com/spf13/viper"
+)
+
+// subsonicPrefix starts searches of the Subsonic server, such as
+// "subsonic:daft punk one more time".
+const subsonicPrefix = "subsonic:"
+
+// subsonicAPIVersion is the version of the Subsonic API that is requested. It
+// is the first version that supports token authentication.
+const subsonicAPIVersion = "1.13.0"
+
+// Subsonic plays music from a Subsonic-compatible server, such as Airsonic or
+// Navidrome, configured in the subsonic section of the configuration file.
+// Tracks are found by searching the server.
+// http://www.subsonic.org/pages/api.jsp
+type Subsonic struct {
+	*GenericService
+}
+
+// NewSubsonicService returns an initialized Subsonic service object.
+func NewSubsonicService() *Subsonic {
+	return &Subsonic{
+		&GenericService{
+			ReadableName: "Subsonic",
+			Format:       "best",
+			// Tracks are found by searching rather than by URL.
+			TrackRegex:    nil,
+			PlaylistRegex: nil,
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the credentials
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (ss *Subsonic) CheckAPIKey() error {
+	if viper.GetString("subsonic.url") == "" {
+		return errors.New("No Subsonic server has been configured. Set subsonic.url, subsonic.username and subsonic.password in the configuration file")
+	}
+	if _, err := ss.call("ping", nil); err != nil {
+		return fmt.Errorf("The Subsonic server could not be reached with the configured credentials (%s)", err.Error())
+	}
+	return nil
+}
+
+// CheckURL returns true if the passed URL is a search of the Subsonic server,
+// such as "subsonic:search terms".
+func (ss *Subsonic) CheckURL(url string) bool {
+	return strings.HasPrefix(strings.ToLower(url), subsonicPrefix)
+}
+
+// GetTracks returns the best match on the Subsonic server for a search such as
+// "subsonic:search terms". An error is returned if nothing matches.
+func (ss *Subsonic) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	query := strings.TrimSpace(url[len(subsonicPrefix):])
+	tracks, err := ss.SearchTracks(query, submitter, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(tracks) == 0 {
+		return nil, fmt.Errorf("No songs on the Subsonic server match \"%s\"", query)
+	}
+	return tracks, nil
+}
+
+// SearchTracks searches the songs on the Subsonic server for the query and
+// returns up to `limit` of them.
+func (ss *Subsonic) SearchTracks(query string, submitter *gumble.User, limit int) ([]interfaces.Track, error) {
+	params := neturl.Values{}
+	params.Set("query", query)
+	params.Set("songCount", fmt.Sprintf("%d", limit))
+	params.Set("artistCount", "0")
+	params.Set("albumCount", "0")
+	v, err := ss.call("search3", params)
+	if err != nil {
+		return nil, err
+	}
+
+	songs, _ := v.GetObjectArray("searchResult3", "song")
+	tracks := make([]interfaces.Track, 0, len(songs))
+	for _, song := range songs {
+		tracks = append(tracks, ss.getTrack(song, submitter))
+	}
+	return tracks, nil
+}
+
+// GetStreamURL returns the URL that `t` is downloaded from, which carries the
+// credentials for the Subsonic server.
+func (ss *Subsonic) GetStreamURL(t interfaces.Track) string {
+	params := ss.authParams()
+	params.Set("id", t.GetID())
+	return ss.endpoint("stream") + "?" + params.Encode()
+}
+
+func (ss *Subsonic) getTrack(song *jason.Object, submitter *gumble.User) bot.Track {
+	id, _ := song.GetString("id")
+	title, _ := song.GetString("title")
+	artist, _ := song.GetString("artist")
+	seconds, _ := song.GetInt64("duration")
+	hash := sha1.Sum([]byte(viper.GetString("subsonic.url") + "/" + id))
+	fileID := hex.EncodeToString(hash[:])[:16]
+	offset, _ := time.ParseDuration("0s")
+
+	return bot.Track{
+		ID: id,
+		// The credentials are added when the track is downloaded, so that
+		// they are never shown to users.
+		URL:       ss.endpoint("stream") + "?id=" + neturl.QueryEscape(id),
+		Title:     title,
+		Author:    artist,
+		Submitter: submitter.Name,
+		Service:   ss.ReadableName,
+		Filename:  "subsonic-" + fileID + ".track",
+		// Cover art requires the credentials as well, so none is shown.
+		ThumbnailURL:   "",
+		Duration:       time.Duration(seconds) * time.Second,
+		PlaybackOffset: offset,
+		Playlist:       nil,
+	}
+}
+
+// call performs a request against the Subsonic API method `method` with the
+// extra query parameters `params`, and returns the "subsonic-response" object.
+// The Subsonic API reports errors in successful responses, so those are turned
+// into errors here.
+func (ss *Subsonic) call(method string, params neturl.Values) (*jason.Object, error) {
+	query := ss.authParams()
+	query.Set("f", "json")
+	for key, values := range params {
+		query[key] = values
+	}
+	v, err := ss.getJSON(ss.endpoint(method) + "?" + query.Encode())
+	if err != nil {
+		return nil, err
+	}
+	response, err := v.GetObject("subsonic-response")
+	if err != nil {
+		return nil, errors.New("The server did not respond like a Subsonic server")
+	}
+	if status, _ := response.GetString("status"); status != "ok" {
+		message, _ := response.GetString("error", "message")
+		code, _ := response.GetInt64("error", "code")
+		return nil, &bot.APIError{
+			Service:    ss.ReadableName,
+			StatusCode: int(code),
+			Status:     status,
+			Message:    message,
+		}
+	}
+	return response, nil
+}
+
+// endpoint returns the URL of the Subsonic API method `method`.
+func (ss *Subsonic) endpoint(method string) string {
+	return strings.TrimSuffix(viper.GetString("subsonic.url"), "/") + "/rest/" + method
+}
+
+// authParams returns the query parameters that authenticate a request. A new
+// salt is used for every request, so the password itself is never sent.
+func (ss *Subsonic) authParams() neturl.Values {
+	salt := make([]byte, 8)
+	rand.Read(salt)
+	saltHex := hex.EncodeToString(salt)
+	token := md5.Sum([]byte(viper.GetString("subsonic.password") + saltHex))
+
+	params := neturl.Values{}
+	params.Set("u", viper.GetString("subsonic.username"))
+	params.Set("t", hex.EncodeToString(token[:]))
+	params.Set("s", saltHex)
+	params.Set("v", subsonicAPIVersion)
+	params.Set("c", "mumbledj")
+	return params
+}
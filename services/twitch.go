@@ -0,0 +1,154 @@
+/*
+ * MumbleDJ
+ * By Matthieu Grieger
+ * services/twitch.go
+ * Copyright (c) 2016 Matthieu Grieger (MIT License)
+ */
+
+package services
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/layeh/gumble/gumble"
+	"github.com/matthieugrieger/mumbledj/bot"
+	"github.com/matthieugrieger/mumbledj/interfaces"
+)
+
+// twitchLiveRegex matches links to Twitch channels, which are relayed live
+// while the channel is broadcasting.
+var twitchLiveRegex = regexp.MustCompile(`^https?:\/\/(www\.|m\.)?twitch\.tv\/(?P<channel>\w+)\/?$`)
+
+// Twitch plays the audio of past broadcasts (VODs) on Twitch and relays the
+// audio of live channels. Metadata is retrieved through youtube-dl, as the
+// Twitch API requires OAuth credentials.
+type Twitch struct {
+	*GenericService
+}
+
+// NewTwitchService returns an initialized Twitch service object.
+func NewTwitchService() *Twitch {
+	return &Twitch{
+		&GenericService{
+			ReadableName: "Twitch",
+			Format:       "Audio_Only/audio_only/bestaudio/worst",
+			TrackRegex: []*regexp.Regexp{
+				regexp.MustCompile(`https?:\/\/(www\.|m\.)?twitch\.tv\/videos\/(?P<id>\d+)(\?t=(?P<offset>(\d+h)?(\d+m)?(\d+s)?))?`),
+				regexp.MustCompile(`https?:\/\/(www\.|m\.)?twitch\.tv\/[\w-]+\/v\/(?P<id>\d+)(\?t=(?P<offset>(\d+h)?(\d+m)?(\d+s)?))?`),
+			},
+			// Twitch collections are currently unsupported.
+			PlaylistRegex: nil,
+		},
+	}
+}
+
+// CheckAPIKey performs a test API call with the API key
+// provided in the configuration file to determine if the
+// service should be enabled.
+func (tw *Twitch) CheckAPIKey() error {
+	// Metadata is retrieved through youtube-dl, so no API key is required.
+	return nil
+}
+
+// CheckURL matches the passed URL with the patterns for Twitch VODs and live
+// channels. Returns true if a match is found, false otherwise.
+func (tw *Twitch) CheckURL(url string) bool {
+	return tw.GenericService.CheckURL(url) || twitchLiveRegex.MatchString(url)
+}
+
+// GetTracks uses the passed URL to find and return
+// tracks associated with the URL. An error is returned
+// if youtube-dl cannot retrieve information about the URL.
+func (tw *Twitch) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
+	if match := twitchLiveRegex.FindStringSubmatch(url); match != nil {
+		return tw.getLiveTrack(match[2], submitter)
+	}
+
+	id, err := tw.getID(url)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, _ := time.ParseDuration("0s")
+	for _, regex := range tw.TrackRegex {
+		match := regex.FindStringSubmatch(url)
+		for i, name := range regex.SubexpNames() {
+			if match != nil && name == "offset" && match[i] != "" {
+				offset, _ = time.ParseDuration(match[i])
+			}
+		}
+	}
+
+	v, err := DJ.YouTubeDL.GetInfo(tw.ReadableName, fmt.Sprintf("https://www.twitch.tv/videos/%s", id))
+	if err != nil {
+		return nil, err
+	}
+
+	title, _ := v.GetString("title")
+	author, _ := v.GetString("uploader")
+	authorID, _ := v.GetString("uploader_id")
+	durationSecs, _ := v.GetFloat64("duration")
+	duration, _ := time.ParseDuration(fmt.Sprintf("%fs", durationSecs))
+	thumbnail, _ := v.GetString("thumbnail")
+
+	authorURL := ""
+	if authorID != "" {
+		authorURL = "https://www.twitch.tv/" + authorID
+	}
+
+	return []interfaces.Track{
+		bot.Track{
+			ID:             id,
+			URL:            fmt.Sprintf("https://www.twitch.tv/videos/%s", id),
+			Title:          title,
+			Author:         author,
+			AuthorURL:      authorURL,
+			Submitter:      submitter.Name,
+			Service:        tw.ReadableName,
+			Filename:       "twitch-" + id + ".track",
+			ThumbnailURL:   thumbnail,
+			Duration:       duration,
+			PlaybackOffset: offset,
+			Playlist:       nil,
+		},
+	}, nil
+}
+
+// getLiveTrack returns a live track that relays the current broadcast of
+// `channel`. An error is returned if the channel is not live.
+func (tw *Twitch) getLiveTrack(channel string, submitter *gumble.User) ([]interfaces.Track, error) {
+	url := "https://www.twitch.tv/" + channel
+	v, err := DJ.YouTubeDL.GetInfo(tw.ReadableName, url)
+	if err != nil {
+		return nil, err
+	}
+	if isLive, _ := v.GetBoolean("is_live"); !isLive {
+		return nil, errors.New("This Twitch channel is not currently live")
+	}
+
+	// youtube-dl reports the stream title as the description of live streams.
+	title, _ := v.GetString("description")
+	if title == "" {
+		title, _ = v.GetString("title")
+	}
+	author, _ := v.GetString("uploader")
+	thumbnail, _ := v.GetString("thumbnail")
+
+	return []interfaces.Track{
+		bot.Track{
+			ID:           channel,
+			URL:          url,
+			Title:        title,
+			Author:       author,
+			AuthorURL:    url,
+			Submitter:    submitter.Name,
+			Service:      tw.ReadableName,
+			ThumbnailURL: thumbnail,
+			Playlist:     nil,
+			Live:         true,
+		},
+	}, nil
+}
@@ -12,11 +12,14 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/ChannelMeter/iso8601duration"
+	"github.com/Sirupsen/logrus"
 	"github.com/antonholmquist/jason"
 	"github.com/layeh/gumble/gumble"
 	"github.com/matthieugrieger/mumbledj/bot"
@@ -24,6 +27,14 @@ import (
 	"github.com/spf13/viper"
 )
 
+// youtubeChannelRegex matches links to YouTube channels by their ID, handle or
+// legacy username.
+var youtubeChannelRegex = regexp.MustCompile(`^https?:\/\/(www\.|m\.)?youtube\.com\/(channel\/(?P<id>UC[\w-]+)|@(?P<handle>[\w.-]+)|user\/(?P<user>[\w-]+))`)
+
+// youtubeMixRegex matches links to videos played from a YouTube mix, such as
+// "watch?v=<id>&list=RD<id>", on YouTube and YouTube Music.
+var youtubeMixRegex = regexp.MustCompile(`^https?:\/\/(www\.|m\.|music\.)?youtube\.com\/watch\?(\S*&)?list=(?P<id>RD[\w-]+)`)
+
 // YouTube is a wrapper around the YouTube Data API.
 // https://developers.google.com/youtube/v3/docs/
 type YouTube struct {
@@ -42,9 +53,12 @@ func NewYouTubeService() *YouTube {
 				regexp.MustCompile(`https?:\/\/youtu.be\/(?P<id>[\w-]+)(?P<timestamp>\?t=\d*m?\d*s?)?`),
 				regexp.MustCompile(`https?:\/\/youtube.com\/v\/(?P<id>[\w-]+)(?P<timestamp>\?t=\d*m?\d*s?)?`),
 				regexp.MustCompile(`https?:\/\/www.youtube.com\/v\/(?P<id>[\w-]+)(?P<timestamp>\?t=\d*m?\d*s?)?`),
+				regexp.MustCompile(`https?:\/\/music\.youtube\.com\/watch\?v=(?P<id>[\w-]+)`),
 			},
 			PlaylistRegex: []*regexp.Regexp{
 				regexp.MustCompile(`https?:\/\/www\.youtube\.com\/playlist\?list=(?P<id>[\w-]+)`),
+				// YouTube Music albums and curated playlists are regular playlists.
+				regexp.MustCompile(`https?:\/\/music\.youtube\.com\/playlist\?list=(?P<id>[\w-]+)`),
 			},
 		},
 	}
@@ -60,15 +74,22 @@ func (yt *YouTube) CheckAPIKey() error {
 		err      error
 	)
 
+	if viper.GetString("api_keys.youtube") == "" && viper.GetBool("downloads.search_fallback") {
+		// YouTube is searched with downloads.command instead, and links are
+		// left to the generic service.
+		logrus.Infoln("No YouTube API key has been provided. YouTube will be searched with " + viper.GetString("downloads.command") + ".")
+		return nil
+	}
 	if viper.GetString("api_keys.youtube") == "" {
-		return errors.New("No YouTube API key has been provided")
+		return errors.New("No YouTube API key has been provided. Add your key to api_keys.youtube in the configuration file, see " +
+			"https://github.com/matthieugrieger/mumbledj#youtube-api-key for instructions")
 	}
 	url := "https://www.googleapis.com/youtube/v3/videos?part=snippet&id=KQY9zrjPBjo&key=%s"
 	response, err = http.Get(fmt.Sprintf(url, viper.GetString("api_keys.youtube")))
-	defer response.Body.Close()
 	if err != nil {
 		return err
 	}
+	defer response.Body.Close()
 
 	if v, err = jason.NewObjectFromReader(response.Body); err != nil {
 		return err
@@ -77,10 +98,14 @@ func (yt *YouTube) CheckAPIKey() error {
 	if v, err = v.GetObject("error"); err == nil {
 		message, _ := v.GetString("message")
 		code, _ := v.GetInt64("code")
-		errArray, _ := v.GetObjectArray("errors")
-		reason, _ := errArray[0].GetString("reason")
+		reason := "unknown"
+		if errArray, _ := v.GetObjectArray("errors"); len(errArray) > 0 {
+			reason, _ = errArray[0].GetString("reason")
+		}
 
-		return fmt.Errorf("%d: %s (reason: %s)", code, message, reason)
+		return fmt.Errorf("The YouTube API rejected the provided API key with error %d: %s (reason: %s). "+
+			"Make sure api_keys.youtube is correct and that the YouTube Data API is enabled for your key, see "+
+			"https://github.com/matthieugrieger/mumbledj#youtube-api-key for instructions", code, message, reason)
 	}
 	return nil
 }
@@ -90,39 +115,43 @@ func (yt *YouTube) CheckAPIKey() error {
 // if any error occurs during the API call.
 func (yt *YouTube) GetTracks(url string, submitter *gumble.User) ([]interfaces.Track, error) {
 	var (
-		playlistURL      string
-		playlistItemsURL string
-		id               string
-		err              error
-		resp             *http.Response
-		v                *jason.Object
-		track            bot.Track
-		tracks           []interfaces.Track
+		playlistURL string
+		id          string
+		err         error
+		v           *jason.Object
+		track       bot.Track
+		tracks      []interfaces.Track
 	)
 
-	dummyOffset, _ := time.ParseDuration("0s")
-	urlSplit := strings.Split(url, "?t=")
+	// A video played from a mix queues the mix, which the track patterns
+	// would otherwise take for the video alone.
+	if mixID, ok := youtubeMixID(url); ok {
+		return yt.mixTracks(mixID, url, submitter)
+	}
 
 	playlistURL = "https://www.googleapis.com/youtube/v3/playlists?part=snippet&id=%s&key=%s"
-	playlistItemsURL = "https://www.googleapis.com/youtube/v3/playlistItems?part=snippet,contentDetails&playlistId=%s&maxResults=%d&key=%s&pageToken=%s"
-	id, err = yt.getID(urlSplit[0])
+	id, err = yt.getID(url)
 	if err != nil {
 		return nil, err
 	}
 
 	if yt.isPlaylist(url) {
-		resp, err = http.Get(fmt.Sprintf(playlistURL, id, viper.GetString("api_keys.youtube")))
-		defer resp.Body.Close()
-		if err != nil {
-			return nil, err
-		}
-
-		v, err = jason.NewObjectFromReader(resp.Body)
+		v, err = yt.call(fmt.Sprintf(playlistURL, id, viper.GetString("api_keys.youtube")), bot.QuotaHigh)
 		if err != nil {
 			return nil, err
 		}
 
 		items, _ := v.GetObjectArray("items")
+		if len(items) == 0 && strings.HasPrefix(id, "RD") && !strings.HasPrefix(id, "RDCLAK") {
+			return yt.mixTracks(id, "", submitter)
+		}
+		if len(items) == 0 {
+			return nil, &bot.TrackError{
+				Service: yt.ReadableName,
+				TrackID: id,
+				Message: "This YouTube playlist does not exist or is private",
+			}
+		}
 		item := items[0]
 
 		title, _ := item.GetString("snippet", "title")
@@ -139,97 +168,632 @@ func (yt *YouTube) GetTracks(url string, submitter *gumble.User) ([]interfaces.T
 			maxItems = viper.GetInt("queue.max_tracks_per_playlist")
 		}
 
-		// YouTube playlist searches return a max of 50 results per page
-		maxResults := 50
-		if maxResults > maxItems {
-			maxResults = maxItems
+		tracks, next := yt.playlistTracks(id, playlist, submitter, maxItems, "")
+		yt.rememberContinuation(submitter, playlist, next)
+		if len(tracks) == 0 {
+			return nil, errors.New("Invalid playlist. No tracks were added")
 		}
+		return tracks, nil
+	}
 
-		pageToken := ""
-		for len(tracks) < maxItems {
-			curResp, curErr := http.Get(fmt.Sprintf(playlistItemsURL, id, maxResults, viper.GetString("api_keys.youtube"), pageToken))
-			defer curResp.Body.Close()
-			if curErr != nil {
-				// An error occurred, simply skip this track.
-				continue
-			}
+	// Submitter added a track!
+	track, err = yt.getTrack(id, submitter, bot.LinkOffset(url), bot.QuotaHigh)
+	if err != nil {
+		return nil, err
+	}
+	// A timestamp past the end of the video, or in a live stream, is ignored.
+	if track.Live || track.PlaybackOffset >= track.Duration {
+		track.PlaybackOffset = 0
+	}
+	tracks = append(tracks, track)
+	return tracks, nil
+}
+
+// CheckURL matches YouTube links if an API key is set. Without one, YouTube is
+// only searched, and links are left to the generic service.
+func (yt *YouTube) CheckURL(url string) bool {
+	return yt.hasAPIKey() && yt.GenericService.CheckURL(url)
+}
+
+// GetTrackID returns the ID of the video that the passed URL links to. ok is
+// false for playlists, including videos played from a mix.
+func (yt *YouTube) GetTrackID(url string) (string, bool) {
+	if _, ok := youtubeMixID(url); ok {
+		return "", false
+	}
+	return yt.GenericService.GetTrackID(url)
+}
+
+// CheckChannelURL returns true if `url` links to a YouTube channel and an API
+// key is set.
+func (yt *YouTube) CheckChannelURL(url string) bool {
+	return yt.hasAPIKey() && youtubeChannelRegex.MatchString(url)
+}
+
+// GetChannelTracks returns the newest `limit` uploads of the YouTube channel
+// at `channelURL` as a playlist, newest first.
+func (yt *YouTube) GetChannelTracks(channelURL string, submitter *gumble.User, limit int) ([]interfaces.Track, error) {
+	match := youtubeChannelRegex.FindStringSubmatch(channelURL)
+	if match == nil {
+		return nil, errors.New("The provided URL is not a YouTube channel")
+	}
+	params := url.Values{}
+	for i, name := range youtubeChannelRegex.SubexpNames() {
+		if match[i] == "" {
+			continue
+		}
+		switch name {
+		case "id":
+			params.Set("id", match[i])
+		case "handle":
+			params.Set("forHandle", "@"+match[i])
+		case "user":
+			params.Set("forUsername", match[i])
+		}
+	}
+	params.Set("part", "snippet,contentDetails")
+	params.Set("key", viper.GetString("api_keys.youtube"))
+
+	v, err := yt.call("https://www.googleapis.com/youtube/v3/channels?"+params.Encode(), bot.QuotaHigh)
+	if err != nil {
+		return nil, err
+	}
+	items, _ := v.GetObjectArray("items")
+	if len(items) == 0 {
+		return nil, errors.New("This YouTube channel does not exist")
+	}
+	channel := items[0]
+	uploads, err := channel.GetString("contentDetails", "relatedPlaylists", "uploads")
+	if err != nil {
+		return nil, errors.New("This YouTube channel has no uploads")
+	}
+	title, _ := channel.GetString("snippet", "title")
+
+	playlist := &bot.Playlist{
+		ID:        uploads,
+		Title:     title,
+		Submitter: submitter.Name,
+		Service:   yt.ReadableName,
+	}
+	if max := viper.GetInt("queue.max_tracks_per_playlist"); max > 0 && limit > max {
+		limit = max
+	}
+	// The uploads playlist of a channel lists its newest videos first.
+	tracks, next := yt.playlistTracks(uploads, playlist, submitter, limit, "")
+	yt.rememberContinuation(submitter, playlist, next)
+	if len(tracks) == 0 {
+		return nil, errors.New("This YouTube channel has no public uploads")
+	}
+	return tracks, nil
+}
 
-			v, err = jason.NewObjectFromReader(curResp.Body)
-			if err != nil {
-				// An error occurred, simply skip this track.
+// GetLikedTracks returns the `limit` videos that `submitter` liked most
+// recently on the YouTube account they linked with !addliked, as a playlist.
+// Liked videos are private, so they are listed with the user's own access
+// token rather than the API key, which also leaves the bot's quota alone.
+func (yt *YouTube) GetLikedTracks(submitter *gumble.User, limit int) ([]interfaces.Track, error) {
+	token, err := DJ.Accounts.AccessToken(submitter)
+	if err != nil {
+		return nil, err
+	}
+	if max := viper.GetInt("queue.max_tracks_per_playlist"); max > 0 && limit > max {
+		limit = max
+	}
+
+	playlist := &bot.Playlist{
+		ID:        "liked-" + submitter.Name,
+		Title:     fmt.Sprintf("Liked videos of %s", submitter.Name),
+		Submitter: submitter.Name,
+		Service:   yt.ReadableName,
+	}
+	var tracks []interfaces.Track
+	pageToken := ""
+	for len(tracks) < limit {
+		params := url.Values{}
+		params.Set("part", "snippet,contentDetails")
+		params.Set("myRating", "like")
+		params.Set("maxResults", strconv.Itoa(50))
+		if pageToken != "" {
+			params.Set("pageToken", pageToken)
+		}
+		v, err := yt.getAuthorizedJSON("https://www.googleapis.com/youtube/v3/videos?"+params.Encode(), token)
+		if err != nil {
+			return nil, err
+		}
+		items, _ := v.GetObjectArray("items")
+		for _, item := range items {
+			id, _ := item.GetString("id")
+			// Liked live streams and premieres cannot be queued like videos.
+			track, err := yt.trackFromVideo(id, item, submitter, 0)
+			if err != nil || track.Live {
 				continue
 			}
+			track.Playlist = playlist
+			tracks = append(tracks, track)
+			if len(tracks) >= limit {
+				break
+			}
+		}
+		pageToken, _ = v.GetString("nextPageToken")
+		if pageToken == "" {
+			break
+		}
+	}
+	if len(tracks) == 0 {
+		return nil, errors.New("You have not liked any videos that can be played")
+	}
+	return tracks, nil
+}
 
-			curTracks, _ := v.GetObjectArray("items")
-			for _, track := range curTracks {
-				videoID, _ := track.GetString("snippet", "resourceId", "videoId")
+// youtubeMixID returns the ID of the mix that the watch page `url` plays a
+// video from. Album playlists, whose IDs start with "RDCLAK", are not mixes.
+func youtubeMixID(url string) (string, bool) {
+	match := youtubeMixRegex.FindStringSubmatch(url)
+	if match == nil || strings.HasPrefix(match[3], "RDCLAK") {
+		return "", false
+	}
+	return match[3], true
+}
 
-				// Unfortunately we have to execute another API call for each video as the YouTube API does not
-				// return video durations from the playlistItems endpoint...
-				newTrack, _ := yt.getTrack(videoID, submitter, dummyOffset)
-				newTrack.Playlist = playlist
-				tracks = append(tracks, newTrack)
+// mixTracks returns the videos of the YouTube mix `id`, listed from the watch
+// page `mixURL` if the mix was linked to from one. Mixes are generated by
+// YouTube and cannot be retrieved with the Data API, so their videos are
+// listed with downloads.command and then retrieved with the API one by one.
+func (yt *YouTube) mixTracks(id, mixURL string, submitter *gumble.User) ([]interfaces.Track, error) {
+	if mixURL == "" {
+		mixURL = "https://www.youtube.com/playlist?list=" + id
+		// Mixes based on a video are named after it, e.g. "RD" followed by the
+		// ID of the video, and are listed from its watch page.
+		if len(id) == 13 {
+			mixURL = "https://www.youtube.com/watch?v=" + id[2:] + "&list=" + id
+		}
+	}
 
-				if len(tracks) >= maxItems {
-					break
-				}
+	maxItems := viper.GetInt("queue.max_tracks_per_playlist")
+	v, err := DJ.YouTubeDL.GetPlaylistInfo(yt.ReadableName, mixURL, maxItems)
+	if err != nil {
+		return nil, &bot.TrackError{
+			Service: yt.ReadableName,
+			TrackID: id,
+			Message: "This YouTube mix could not be retrieved. Mixes generated for a YouTube or YouTube Music user " +
+				"cannot be retrieved, please link to an album or a regular playlist instead",
+		}
+	}
+
+	title, _ := v.GetString("title")
+	if title == "" {
+		title = "YouTube Mix"
+	}
+	playlist := &bot.Playlist{
+		ID:        id,
+		Title:     title,
+		Submitter: submitter.Name,
+		Service:   yt.ReadableName,
+	}
+
+	var tracks []interfaces.Track
+	entries, _ := v.GetObjectArray("entries")
+	for _, entry := range entries {
+		videoID, _ := entry.GetString("id")
+		track, err := yt.getTrack(videoID, submitter, 0, bot.QuotaHigh)
+		if err == bot.ErrQuotaDeferred || err == bot.ErrQuotaExhausted {
+			logrus.WithFields(bot.ErrorFields(err)).Warnln("Stopped retrieving a YouTube mix to save API budget.")
+			break
+		} else if err != nil {
+			logrus.WithFields(bot.ErrorFields(err)).Infoln("Skipping a YouTube mix item.")
+			continue
+		}
+		track.Playlist = playlist
+		tracks = append(tracks, track)
+	}
+
+	if len(tracks) == 0 {
+		return nil, errors.New("Invalid playlist. No tracks were added")
+	}
+	return tracks, nil
+}
+
+// playlistTracks returns up to `maxItems` tracks of the playlist `id` in the
+// order of the playlist, starting at `start`, a token returned by an earlier
+// call, or at the beginning if it is empty. The returned token is where the
+// playlist continues, or empty if it has no more tracks. The tracks retrieved
+// so far are returned if an error occurs.
+func (yt *YouTube) playlistTracks(id string, playlist *bot.Playlist, submitter *gumble.User, maxItems int, start string) ([]interfaces.Track, string) {
+	playlistItemsURL := "https://www.googleapis.com/youtube/v3/playlistItems?part=snippet,contentDetails&playlistId=%s&maxResults=%d&key=%s&pageToken=%s"
+	dummyOffset, _ := time.ParseDuration("0s")
+
+	var tracks []interfaces.Track
+	// YouTube playlist searches return a max of 50 results per page
+	maxResults := 50
+	if maxResults > maxItems {
+		maxResults = maxItems
+	}
+
+	// Tokens are the token of a page followed by the number of items on it
+	// that were already added, such as "EAAaBlBUOkNESQ:5".
+	pageToken, skip := start, 0
+	if i := strings.LastIndex(start, ":"); i != -1 {
+		pageToken = start[:i]
+		skip, _ = strconv.Atoi(start[i+1:])
+	}
+
+	for page := 0; len(tracks) < maxItems; page++ {
+		// Only the first page of a playlist is needed to honor the request.
+		priority := bot.QuotaLow
+		if page == 0 {
+			priority = bot.QuotaHigh
+		}
+		v, err := yt.call(fmt.Sprintf(playlistItemsURL, id, maxResults, viper.GetString("api_keys.youtube"), pageToken), priority)
+		if err != nil {
+			// An error occurred, queue the tracks that have been retrieved so far.
+			logrus.WithFields(bot.ErrorFields(err)).Warnln("An error occurred while retrieving a page of a YouTube playlist.")
+			return tracks, ""
+		}
+
+		curTracks, _ := v.GetObjectArray("items")
+		nextPageToken, _ := v.GetString("nextPageToken")
+		for i := skip; i < len(curTracks); i++ {
+			videoID, _ := curTracks[i].GetString("snippet", "resourceId", "videoId")
+
+			// Unfortunately we have to execute another API call for each video as the YouTube API does not
+			// return video durations from the playlistItems endpoint...
+			newTrack, err := yt.getTrack(videoID, submitter, dummyOffset, priority)
+			if err == bot.ErrQuotaDeferred || err == bot.ErrQuotaExhausted {
+				// Queue the tracks that have been retrieved so far; the rest
+				// can be added later.
+				logrus.WithFields(bot.ErrorFields(err)).Warnln("Stopped retrieving a YouTube playlist to save API budget.")
+				return tracks, fmt.Sprintf("%s:%d", pageToken, i)
+			} else if err != nil {
+				// Private or deleted videos are skipped.
+				logrus.WithFields(bot.ErrorFields(err)).Infoln("Skipping a YouTube playlist item.")
+				continue
 			}
+			newTrack.Playlist = playlist
+			tracks = append(tracks, newTrack)
 
-			pageToken, _ = v.GetString("nextPageToken")
-			if pageToken == "" {
-				break
+			if len(tracks) >= maxItems {
+				if i+1 < len(curTracks) {
+					return tracks, fmt.Sprintf("%s:%d", pageToken, i+1)
+				}
+				return tracks, nextPageToken
 			}
 		}
 
-		if len(tracks) == 0 {
-			return nil, errors.New("Invalid playlist. No tracks were added")
+		pageToken, skip = nextPageToken, 0
+		if pageToken == "" {
+			break
 		}
-		return tracks, nil
 	}
+	return tracks, ""
+}
 
-	// Submitter added a track!
-	offset := dummyOffset
-	if len(urlSplit) == 2 {
-		offset, _ = time.ParseDuration(urlSplit[1])
+// ContinuePlaylist returns up to `limit` more tracks of `playlist`, starting
+// at `token`, and remembers where it continues after them.
+func (yt *YouTube) ContinuePlaylist(playlist interfaces.Playlist, token string, limit int, submitter *gumble.User) ([]interfaces.Track, error) {
+	continued := &bot.Playlist{
+		ID:        playlist.GetID(),
+		Title:     playlist.GetTitle(),
+		Submitter: submitter.Name,
+		Service:   yt.ReadableName,
+	}
+	tracks, next := yt.playlistTracks(continued.ID, continued, submitter, limit, token)
+	yt.rememberContinuation(submitter, continued, next)
+	if len(tracks) == 0 {
+		return nil, errors.New("No more tracks of this playlist could be retrieved")
+	}
+	return tracks, nil
+}
+
+// rememberContinuation remembers that `playlist`, added by `submitter`,
+// continues at `token`, so that more of it can be added with the more command.
+// The playlist is forgotten if `token` is empty.
+func (yt *YouTube) rememberContinuation(submitter *gumble.User, playlist *bot.Playlist, token string) {
+	if token == "" {
+		if continuation, ok := DJ.Continuations.Get(submitter.Name); ok && continuation.Playlist.GetID() == playlist.ID {
+			DJ.Continuations.Clear(submitter.Name)
+		}
+		return
 	}
+	DJ.Continuations.Set(submitter.Name, bot.Continuation{
+		Playlist: playlist,
+		Token:    token,
+	})
+}
 
-	track, err = yt.getTrack(id, submitter, offset)
+// SearchTracks searches YouTube for videos matching the query and returns up to
+// `limit` tracks, ordered by relevance.
+func (yt *YouTube) SearchTracks(query string, submitter *gumble.User, limit int) ([]interfaces.Track, error) {
+	return yt.search(query, submitter, limit, bot.QuotaHigh)
+}
+
+// search searches YouTube like SearchTracks, spending API budget with the
+// given priority. Without an API key, the search is made with downloads.command
+// instead.
+func (yt *YouTube) search(query string, submitter *gumble.User, limit, priority int) ([]interfaces.Track, error) {
+	if !yt.hasAPIKey() {
+		return yt.searchWithDownloader(query, submitter, limit)
+	}
+	searchURL := "https://www.googleapis.com/youtube/v3/search?part=snippet&type=video&maxResults=%d&q=%s&key=%s"
+	v, err := yt.call(fmt.Sprintf(searchURL, limit, url.QueryEscape(query), viper.GetString("api_keys.youtube")), priority)
 	if err != nil {
 		return nil, err
 	}
-	tracks = append(tracks, track)
+
+	tracks := make([]interfaces.Track, 0)
+	items, _ := v.GetObjectArray("items")
+	for _, item := range items {
+		videoID, _ := item.GetString("id", "videoId")
+		track, err := yt.getTrack(videoID, submitter, 0, priority)
+		if err != nil {
+			logrus.WithFields(bot.ErrorFields(err)).Infoln("Skipping a YouTube search result.")
+			continue
+		}
+		tracks = append(tracks, track)
+	}
+
+	if len(tracks) == 0 {
+		return nil, fmt.Errorf("No YouTube videos were found for \"%s\"", query)
+	}
 	return tracks, nil
 }
 
-func (yt *YouTube) getTrack(id string, submitter *gumble.User, offset time.Duration) (bot.Track, error) {
-	var (
-		resp *http.Response
-		err  error
-		v    *jason.Object
-	)
+// searchWithDownloader searches YouTube with the "ytsearchN:" pseudo-URL of
+// downloads.command, which costs no API budget.
+func (yt *YouTube) searchWithDownloader(query string, submitter *gumble.User, limit int) ([]interfaces.Track, error) {
+	v, err := DJ.YouTubeDL.GetInfo(yt.ReadableName, fmt.Sprintf("ytsearch%d:%s", limit, query))
+	if err != nil {
+		return nil, err
+	}
+
+	tracks := make([]interfaces.Track, 0)
+	entries, _ := v.GetObjectArray("entries")
+	for _, entry := range entries {
+		if id, _ := entry.GetString("id"); id == "" {
+			continue
+		}
+		tracks = append(tracks, yt.trackFromInfo(entry, submitter))
+	}
+
+	if len(tracks) == 0 {
+		return nil, fmt.Errorf("No YouTube videos were found for \"%s\"", query)
+	}
+	return tracks, nil
+}
+
+// trackFromInfo returns the track described by `info`, the metadata that
+// downloads.command reports for a YouTube video.
+func (yt *YouTube) trackFromInfo(info *jason.Object, submitter *gumble.User) bot.Track {
+	id, _ := info.GetString("id")
+	title, _ := info.GetString("title")
+	author := getFirstString(info, []string{"channel"}, []string{"uploader"})
+	authorURL := getFirstString(info, []string{"channel_url"}, []string{"uploader_url"})
+	thumbnail, _ := info.GetString("thumbnail")
+	seconds, _ := info.GetFloat64("duration")
+	live, _ := info.GetBoolean("is_live")
+	description, _ := info.GetString("description")
+
+	track := bot.Track{
+		ID:           id,
+		URL:          "https://youtube.com/watch?v=" + id,
+		Title:        title,
+		Author:       author,
+		AuthorURL:    authorURL,
+		Submitter:    submitter.Name,
+		Service:      yt.ReadableName,
+		ThumbnailURL: thumbnail,
+		Playlist:     nil,
+		Live:         live,
+	}
+	if !live {
+		track.Filename = id + ".track"
+		track.Duration = time.Duration(seconds * float64(time.Second))
+		track.Tracklist = bot.ParseTracklist(description, track.Duration)
+	}
+	return track
+}
+
+// hasAPIKey returns true if a YouTube API key is set.
+func (yt *YouTube) hasAPIKey() bool {
+	return viper.GetString("api_keys.youtube") != ""
+}
+
+// Metadata preferences, set in downloads.metadata_preference.
+const (
+	// metadataAPI retrieves videos with the Data API, and falls back to
+	// downloads.command if that fails.
+	metadataAPI = "api"
+	// metadataDownloader retrieves videos with downloads.command, and falls
+	// back to the Data API if that fails.
+	metadataDownloader = "downloader"
+	// metadataRace asks both at the same time and uses the first answer.
+	metadataRace = "race"
+)
+
+// trackLookup retrieves a single YouTube video.
+type trackLookup func() (bot.Track, error)
+
+// getTrack retrieves the video `id`, which starts playing `offset` into it.
+// The Data API and downloads.command are asked in the order set in
+// downloads.metadata_preference, or at the same time, so that a single flaky
+// response from one of them, such as a video without contentDetails, does not
+// reject a valid video. Without an API key, only downloads.command is asked.
+func (yt *YouTube) getTrack(id string, submitter *gumble.User, offset time.Duration, priority int) (bot.Track, error) {
+	fromAPI := func() (bot.Track, error) {
+		return yt.getTrackFromAPI(id, submitter, offset, priority)
+	}
+	fromDownloader := func() (bot.Track, error) {
+		return yt.getTrackFromDownloader(id, submitter, offset)
+	}
+	if !yt.hasAPIKey() {
+		return fromDownloader()
+	}
+
+	switch viper.GetString("downloads.metadata_preference") {
+	case metadataDownloader:
+		return fallBack(id, fromDownloader, fromAPI)
+	case metadataRace:
+		return raceLookups(fromAPI, fromDownloader)
+	}
+	return fallBack(id, fromAPI, fromDownloader)
+}
+
+// fallBack returns the result of `primary`, or of `secondary` if `primary`
+// fails. The error of `primary` is returned if both fail. When the Data API
+// comes first, running out of API budget is not a failure, so that playlists
+// stop where the budget ran out rather than being retrieved video by video
+// with downloads.command. Neither is a video that has not started yet.
+func fallBack(id string, primary, secondary trackLookup) (bot.Track, error) {
+	track, err := primary()
+	if err == nil || err == bot.ErrQuotaDeferred || err == bot.ErrQuotaExhausted {
+		return track, err
+	}
+	if _, ok := err.(*bot.UpcomingError); ok {
+		return track, err
+	}
+	fields := bot.ErrorFields(err)
+	fields["id"] = id
+	logrus.WithFields(fields).Infoln("Could not retrieve a YouTube video, trying the other metadata source...")
+	if track, fallbackErr := secondary(); fallbackErr == nil {
+		return track, nil
+	}
+	return bot.Track{}, err
+}
+
+// raceLookups runs `lookups` at the same time and returns the first track
+// retrieved, or the first error if every lookup fails.
+func raceLookups(lookups ...trackLookup) (bot.Track, error) {
+	type result struct {
+		track bot.Track
+		err   error
+	}
+	results := make(chan result, len(lookups))
+	for _, lookup := range lookups {
+		go func(lookup trackLookup) {
+			track, err := lookup()
+			results <- result{track, err}
+		}(lookup)
+	}
+
+	var firstErr error
+	for range lookups {
+		r := <-results
+		if r.err == nil {
+			return r.track, nil
+		}
+		if firstErr == nil {
+			firstErr = r.err
+		}
+	}
+	return bot.Track{}, firstErr
+}
 
-	videoURL := "https://www.googleapis.com/youtube/v3/videos?part=snippet,contentDetails&id=%s&key=%s"
-	resp, err = http.Get(fmt.Sprintf(videoURL, id, viper.GetString("api_keys.youtube")))
-	defer resp.Body.Close()
+// getTrackFromDownloader retrieves the video `id` with downloads.command,
+// which costs no API budget.
+func (yt *YouTube) getTrackFromDownloader(id string, submitter *gumble.User, offset time.Duration) (bot.Track, error) {
+	v, err := DJ.YouTubeDL.GetInfo(yt.ReadableName, "https://www.youtube.com/watch?v="+id)
 	if err != nil {
 		return bot.Track{}, err
 	}
+	if status, _ := v.GetString("live_status"); status == "is_upcoming" {
+		title, _ := v.GetString("title")
+		upcoming := &bot.UpcomingError{
+			Service: yt.ReadableName,
+			TrackID: id,
+			Title:   title,
+		}
+		if timestamp, err := v.GetInt64("release_timestamp"); err == nil {
+			upcoming.StartsAt = time.Unix(timestamp, 0)
+		}
+		return bot.Track{}, upcoming
+	}
+	track := yt.trackFromInfo(v, submitter)
+	if track.ID == "" {
+		return bot.Track{}, &bot.TrackError{
+			Service: yt.ReadableName,
+			TrackID: id,
+			Message: "No information was found about this YouTube video",
+		}
+	}
+	if !track.Live && track.Duration == 0 {
+		return bot.Track{}, &bot.TrackError{
+			Service: yt.ReadableName,
+			TrackID: id,
+			Message: "This YouTube video has no duration yet. It may be a live stream that has just ended or a video that is still being processed",
+		}
+	}
+	if !track.Live {
+		track.PlaybackOffset = offset
+	}
+	return track, nil
+}
 
-	v, err = jason.NewObjectFromReader(resp.Body)
+// getTrackFromAPI retrieves the video `id` with the Data API.
+func (yt *YouTube) getTrackFromAPI(id string, submitter *gumble.User, offset time.Duration, priority int) (bot.Track, error) {
+	videoURL := "https://www.googleapis.com/youtube/v3/videos?part=snippet,contentDetails,liveStreamingDetails&id=%s&key=%s"
+	v, err := yt.call(fmt.Sprintf(videoURL, id, viper.GetString("api_keys.youtube")), priority)
 	if err != nil {
 		return bot.Track{}, err
 	}
 	items, _ := v.GetObjectArray("items")
 	if len(items) == 0 {
-		return bot.Track{}, errors.New("This YouTube video is private")
+		return bot.Track{}, &bot.TrackError{
+			Service: yt.ReadableName,
+			TrackID: id,
+			Message: "This YouTube video is private or does not exist",
+		}
 	}
-	item := items[0]
+	return yt.trackFromVideo(id, items[0], submitter, offset)
+}
+
+// trackFromVideo returns the track of the video resource `item` of the Data
+// API, which must include its snippet and contentDetails.
+func (yt *YouTube) trackFromVideo(id string, item *jason.Object, submitter *gumble.User, offset time.Duration) (bot.Track, error) {
 	title, _ := item.GetString("snippet", "title")
-	thumbnail, _ := item.GetString("snippet", "thumbnails", "high", "url")
+	// Not every video has every thumbnail size, so fall back to smaller ones.
+	thumbnail := getFirstString(item,
+		[]string{"snippet", "thumbnails", "high", "url"},
+		[]string{"snippet", "thumbnails", "medium", "url"},
+		[]string{"snippet", "thumbnails", "default", "url"})
 	author, _ := item.GetString("snippet", "channelTitle")
 	durationString, _ := item.GetString("contentDetails", "duration")
 	durationConverted, _ := duration.FromString(durationString)
 	duration := durationConverted.ToDuration()
+	description, _ := item.GetString("snippet", "description")
+
+	// Live broadcasts have no fixed duration, so they are relayed as they are
+	// broadcast instead of being downloaded.
+	switch broadcast, _ := item.GetString("snippet", "liveBroadcastContent"); broadcast {
+	case "live":
+		return bot.Track{
+			ID:           id,
+			URL:          "https://youtube.com/watch?v=" + id,
+			Title:        title,
+			Author:       author,
+			Submitter:    submitter.Name,
+			Service:      yt.ReadableName,
+			ThumbnailURL: thumbnail,
+			Playlist:     nil,
+			Live:         true,
+		}, nil
+	case "upcoming":
+		scheduled, _ := item.GetString("liveStreamingDetails", "scheduledStartTime")
+		startsAt, _ := time.Parse(time.RFC3339, scheduled)
+		return bot.Track{}, &bot.UpcomingError{
+			Service:  yt.ReadableName,
+			TrackID:  id,
+			Title:    title,
+			StartsAt: startsAt,
+		}
+	}
+	// The API occasionally leaves out the contentDetails of a video, and live
+	// streams that have just ended have no duration until they are processed.
+	if duration == 0 {
+		return bot.Track{}, &bot.TrackError{
+			Service: yt.ReadableName,
+			TrackID: id,
+			Message: "This YouTube video has no duration yet. It may be a live stream that has just ended or a video that is still being processed",
+		}
+	}
 
 	return bot.Track{
 		ID:             id,
@@ -243,5 +807,49 @@ func (yt *YouTube) getTrack(id string, submitter *gumble.User, offset time.Durat
 		Duration:       duration,
 		PlaybackOffset: offset,
 		Playlist:       nil,
+		Tracklist:      bot.ParseTracklist(description, duration),
 	}, nil
 }
+
+// getAuthorizedJSON performs a Data API request on behalf of the user whose
+// access token is `token`.
+func (yt *YouTube) getAuthorizedJSON(url, token string) (*jason.Object, error) {
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	v, jsonErr := jason.NewObjectFromReader(response.Body)
+	if response.StatusCode != http.StatusOK {
+		apiErr := &bot.APIError{
+			Service:    yt.ReadableName,
+			StatusCode: response.StatusCode,
+			Status:     response.Status,
+		}
+		if jsonErr == nil {
+			apiErr.Message, _ = v.GetString("error", "message")
+		}
+		return nil, apiErr
+	}
+	return v, jsonErr
+}
+
+// call performs a YouTube Data API request after spending its estimated cost
+// from the daily budget. Searches cost 100 units and all other requests made
+// by the bot cost 1 unit.
+func (yt *YouTube) call(url string, priority int) (*jason.Object, error) {
+	units := 1
+	if strings.Contains(url, "/youtube/v3/search?") {
+		units = 100
+	}
+	if err := DJ.Quota.Spend(units, priority); err != nil {
+		return nil, err
+	}
+	return yt.getJSON(url)
+}